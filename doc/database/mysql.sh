@@ -25,6 +25,24 @@ CREATE TABLE IF NOT EXISTS IM_SEQ_GEN_TAB(
     PRIMARY KEY(id)
     );
 
+################################################################################
+## 用户
+
+# 用户注册信息表
+CREATE TABLE IF NOT EXISTS IM_USR_REG_TAB(
+    uid bigint NOT NULL COMMENT '用户ID[主键]',
+    nation bigint NOT NULL DEFAULT 0 COMMENT '国家编号',
+    city bigint NOT NULL DEFAULT 0 COMMENT '地市编号',
+    town bigint NOT NULL DEFAULT 0 COMMENT '城镇编号',
+    sid_num int NOT NULL DEFAULT 0 COMMENT '累计分配的会话SID数',
+    last_sid bigint NOT NULL DEFAULT 0 COMMENT '最近分配的会话SID',
+    first_reg_time bigint NOT NULL DEFAULT 0 COMMENT '首次注册时间',
+    last_reg_time bigint NOT NULL DEFAULT 0 COMMENT '最近注册时间',
+
+    PRIMARY KEY(uid),
+    INDEX(nation, city, town)
+    );
+
 ################################################################################
 ## 聊天室
 
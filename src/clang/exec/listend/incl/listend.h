@@ -37,6 +37,16 @@ typedef enum
     , LSND_TYPE_WS                           /* 网络类型: WS */
 } lsnd_type_e;
 
+/* 结点状态 */
+typedef enum
+{
+    LSND_STATUS_EXIT                         /* 退出状态 */
+    , LSND_STATUS_EXEC                       /* 正常状态 */
+    , LSND_STATUS_BUSY                       /* 太忙状态 */
+} lsnd_status_e;
+
+#define LSND_BUSY_PERCENT       (90)        /* 繁忙阈值(在线连接数占最大并发数的百分比) */
+
 /* 输入参数 */
 typedef struct
 {
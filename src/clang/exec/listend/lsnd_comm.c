@@ -270,8 +270,11 @@ int lsnd_kick_add(lsnd_cntx_t *ctx, lsnd_conn_extra_t *conn)
  **         required string nation = 4;     // M|所属国家|字串|
  **         required string ip = 5;     // M|IP地址|字串|
  **         required uint32 port = 6;       // M|端口号|数字|
+ **         required uint32 connections = 7;   // M|在线连接数|数字|
+ **         optional string ipv6 = 8;       // O|IPv6地址(双栈结点)|字串|
+ **         optional uint32 status = 9;     // O|结点状态(1:正常 2:繁忙)|数字|
  **     }
- **注意事项: 
+ **注意事项: 在线连接数达到最大并发数的LSND_BUSY_PERCENT时, 上报繁忙状态.
  **作    者: # Qifeng.zou # 2016.12.06 23:23:51 #
  ******************************************************************************/
 void lsnd_timer_info_handler(void *_ctx)
@@ -291,9 +294,19 @@ void lsnd_timer_info_handler(void *_ctx)
     info.ip = conf->access.ipaddr;
     info.port = conf->access.port;
     info.connections = hash_tab_total(ctx->conn_list);
+    if ('\0' != conf->access.ipv6[0]) {
+        info.ipv6 = conf->access.ipv6; /* 双栈结点 */
+    }
+    info.has_status = true;
+    info.status = LSND_STATUS_EXEC;
+    if ((conf->access.connections.max > 0)
+        && ((uint64_t)info.connections * 100
+            >= (uint64_t)conf->access.connections.max * LSND_BUSY_PERCENT)) {
+        info.status = LSND_STATUS_BUSY;
+    }
 
-    log_debug(ctx->log, "Listen info! nid:%d nation:%s opid:%d ip:%s port:%d",
-            info.nid, info.nation, info.opid, info.ip, info.port);
+    log_debug(ctx->log, "Listen info! nid:%d nation:%s opid:%d ip:%s ipv6:%s port:%d status:%d",
+            info.nid, info.nation, info.opid, info.ip, conf->access.ipv6, info.port, info.status);
 
     /* > 组装PB协议 */
     len = mesg_lsnd_info__get_packed_size(&info);
@@ -307,6 +307,12 @@ static int lsnd_conf_load_access(xml_tree_t *xml, lsnd_conf_t *lcf, log_cycle_t
 
     snprintf(conf->ipaddr, sizeof(conf->ipaddr), "%s", node->value.str);
 
+    /* -> 外网IPv6(可选) */
+    node = xml_search(xml, fix, "IPV6");
+    if (NULL != node && 0 != strlen(node->value.str)) {
+        snprintf(conf->ipv6, sizeof(conf->ipv6), "%s", node->value.str);
+    }
+
     /* -> 端口号 */
     node = xml_search(xml, fix, "PORT");
     if (NULL == node) {
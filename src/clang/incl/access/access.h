@@ -22,6 +22,7 @@ typedef struct {
     int nid;                        /* 结点ID */
     char path[FILE_NAME_MAX_LEN];   /* 工作路径 */
     char ipaddr[IP_ADDR_MAX_LEN];   /* 外网IP */
+    char ipv6[IPV6_ADDR_MAX_LEN];   /* 外网IPv6地址(空:不支持IPv6) */
 
     int port;                       /* 侦听端口 */
 
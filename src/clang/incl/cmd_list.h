@@ -39,6 +39,12 @@ typedef enum
     , CMD_KICK                  = 0x0110    /* 踢人请求 */
     , CMD_KICK_ACK              = 0x0111    /* 踢人应答 */
 
+    , CMD_CONV_LIST             = 0x0112    /* 会话列表 */
+    , CMD_CONV_LIST_ACK         = 0x0113    /* 会话列表应答 */
+
+    , CMD_CONV_READ             = 0x0114    /* 会话已读 */
+    , CMD_CONV_READ_ACK         = 0x0115    /* 会话已读应答 */
+
     , CMD_ONLINE_NTF            = 0x0151    /* 上线通知 */
     , CMD_ONLINE_NTF_ACK        = 0x0152    /* 上线通知应答 */
 
@@ -64,6 +70,16 @@ typedef enum
     , CMD_MARK_ADD_ACK          = 0x0211    /* 设置备注应答 */
     , CMD_MARK_DEL              = 0x0212    /* 移除备注 */
     , CMD_MARK_DEL_ACK          = 0x0213    /* 移除备注应答 */
+    , CMD_FRIEND_REPLY          = 0x0214    /* 好友申请回复 */
+    , CMD_FRIEND_REPLY_ACK      = 0x0215    /* 好友申请回复应答 */
+    , CMD_FRIEND_LIST           = 0x0216    /* 好友列表 */
+    , CMD_FRIEND_LIST_ACK       = 0x0217    /* 好友列表应答 */
+    , CMD_CHAT_RECV             = 0x0218    /* 送达回执 */
+    , CMD_CHAT_RECV_ACK         = 0x0219    /* 送达回执应答 */
+    , CMD_CHAT_READ             = 0x021A    /* 已读回执 */
+    , CMD_CHAT_READ_ACK         = 0x021B    /* 已读回执应答 */
+    , CMD_CHAT_RECALL           = 0x021C    /* 私聊消息撤回 */
+    , CMD_CHAT_RECALL_ACK       = 0x021D    /* 私聊消息撤回应答 */
 
     /* 群聊消息 */
     , CMD_GROUP_CREAT           = 0x0301    /* 创建群组 */
@@ -108,6 +124,13 @@ typedef enum
     , CMD_GROUP_USR_LIST        = 0x031C    /* 群组成员列表 */
     , CMD_GROUP_USR_LIST_ACK    = 0x031D    /* 群组成员列表应答 */
 
+    , CMD_GROUP_JOIN_AUDIT      = 0x031E    /* 入群审核 */
+    , CMD_GROUP_JOIN_AUDIT_ACK  = 0x031F    /* 入群审核应答 */
+    , CMD_GROUP_RECALL          = 0x0320    /* 群聊消息撤回 */
+    , CMD_GROUP_RECALL_ACK      = 0x0321    /* 群聊消息撤回应答 */
+    , CMD_GROUP_SYNC            = 0x0322    /* 群聊消息同步 */
+    , CMD_GROUP_SYNC_ACK        = 0x0323    /* 群聊消息同步应答 */
+
     , CMD_GROUP_JOIN_NTF        = 0x0350    /* 入群通知 */
     , CMD_GROUP_JOIN_NTF_ACK    = 0x0351    /* 入群通知应答 */
 
@@ -135,6 +158,9 @@ typedef enum
     , CMD_GROUP_MGR_DEL_NTF     = 0x0366    /* 解除群组管理员通知 */
     , CMD_GROUP_MGR_DEL_NTF_ACK = 0x0367    /* 解除群组管理员通知应答 */
 
+    , CMD_GROUP_DISMISS_NTF     = 0x0368    /* 解散群组通知 */
+    , CMD_GROUP_DISMISS_NTF_ACK = 0x0369    /* 解散群组通知应答 */
+
     /* 聊天室消息 */
     , CMD_ROOM_CREAT            = 0x0401    /* 创建聊天室 */
     , CMD_ROOM_CREAT_ACK        = 0x0402    /* 创建聊天室应答 */
@@ -51,6 +51,7 @@
 #define FILE_PATH_MAX_LEN   FILE_NAME_MAX_LEN   /* 文件路径最大长度 */
 #define FILE_LINE_MAX_LEN   (1024)              /* 文件行最大长度 */
 #define IP_ADDR_MAX_LEN     (32)                /* IP地址最大长度 */
+#define IPV6_ADDR_MAX_LEN   (64)                /* IPv6地址最大长度 */
 #define CMD_LINE_MAX_LEN    (1024)              /* 命令行最大长度 */
 #define UDP_MAX_LEN         (1472)              /* UDP最大承载长度 */
 #define QUEUE_NAME_MAX_LEN  (64)                /* 队列名最大长度 */
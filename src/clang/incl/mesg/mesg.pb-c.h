@@ -25,6 +25,12 @@ typedef struct _MesgError MesgError;
 typedef struct _MesgSync MesgSync;
 typedef struct _MesgSyncAck MesgSyncAck;
 typedef struct _MesgKick MesgKick;
+typedef struct _MesgConvList MesgConvList;
+typedef struct _MesgConvListAck MesgConvListAck;
+typedef struct _MesgConvRead MesgConvRead;
+typedef struct _MesgConvReadAck MesgConvReadAck;
+typedef struct _MesgOnlineNtf MesgOnlineNtf;
+typedef struct _MesgOfflineNtf MesgOfflineNtf;
 typedef struct _MesgChat MesgChat;
 typedef struct _MesgChatAck MesgChatAck;
 typedef struct _MesgFriendAdd MesgFriendAdd;
@@ -43,6 +49,14 @@ typedef struct _MesgMarkAdd MesgMarkAdd;
 typedef struct _MesgMarkAddAck MesgMarkAddAck;
 typedef struct _MesgMarkDel MesgMarkDel;
 typedef struct _MesgMarkDelAck MesgMarkDelAck;
+typedef struct _MesgFriendReply MesgFriendReply;
+typedef struct _MesgFriendReplyAck MesgFriendReplyAck;
+typedef struct _MesgFriendList MesgFriendList;
+typedef struct _MesgFriendListAck MesgFriendListAck;
+typedef struct _MesgChatReceipt MesgChatReceipt;
+typedef struct _MesgChatReceiptAck MesgChatReceiptAck;
+typedef struct _MesgChatRecall MesgChatRecall;
+typedef struct _MesgChatRecallAck MesgChatRecallAck;
 typedef struct _MesgGroupCreat MesgGroupCreat;
 typedef struct _MesgGroupCreatAck MesgGroupCreatAck;
 typedef struct _MesgGroupDismiss MesgGroupDismiss;
@@ -71,6 +85,12 @@ typedef struct _MesgGroupMgrDel MesgGroupMgrDel;
 typedef struct _MesgGroupMgrDelAck MesgGroupMgrDelAck;
 typedef struct _MesgGroupUsrList MesgGroupUsrList;
 typedef struct _MesgGroupUsrListAck MesgGroupUsrListAck;
+typedef struct _MesgGroupJoinAudit MesgGroupJoinAudit;
+typedef struct _MesgGroupJoinAuditAck MesgGroupJoinAuditAck;
+typedef struct _MesgGroupRecall MesgGroupRecall;
+typedef struct _MesgGroupRecallAck MesgGroupRecallAck;
+typedef struct _MesgGroupSync MesgGroupSync;
+typedef struct _MesgGroupSyncAck MesgGroupSyncAck;
 typedef struct _MesgGroupJoinNtf MesgGroupJoinNtf;
 typedef struct _MesgGroupQuitNtf MesgGroupQuitNtf;
 typedef struct _MesgGroupKickNtf MesgGroupKickNtf;
@@ -80,6 +100,7 @@ typedef struct _MesgGroupBlAddNtf MesgGroupBlAddNtf;
 typedef struct _MesgGroupBlDelNtf MesgGroupBlDelNtf;
 typedef struct _MesgGroupMgrAddNtf MesgGroupMgrAddNtf;
 typedef struct _MesgGroupMgrDelNtf MesgGroupMgrDelNtf;
+typedef struct _MesgGroupDismissNtf MesgGroupDismissNtf;
 typedef struct _MesgRoomCreat MesgRoomCreat;
 typedef struct _MesgRoomCreatAck MesgRoomCreatAck;
 typedef struct _MesgRoomDismiss MesgRoomDismiss;
@@ -147,10 +168,12 @@ struct  _MesgSub
   ProtobufCMessage base;
   protobuf_c_boolean has_cmd;
   uint32_t cmd;
+  protobuf_c_boolean has_uid;
+  uint64_t uid;
 };
 #define MESG_SUB__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_sub__descriptor) \
-    , 0,0 }
+    , 0,0, 0,0 }
 
 
 struct  _MesgSubAck
@@ -169,10 +192,12 @@ struct  _MesgUnsub
 {
   ProtobufCMessage base;
   uint32_t cmd;
+  protobuf_c_boolean has_uid;
+  uint64_t uid;
 };
 #define MESG_UNSUB__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_unsub__descriptor) \
-    , 0 }
+    , 0, 0,0 }
 
 
 struct  _MesgUnsubAck
@@ -202,10 +227,16 @@ struct  _MesgSync
 {
   ProtobufCMessage base;
   uint64_t uid;
+  protobuf_c_boolean has_since;
+  uint64_t since;
+  protobuf_c_boolean has_num;
+  uint32_t num;
+  protobuf_c_boolean has_receipt_since;
+  uint64_t receipt_since;
 };
 #define MESG_SYNC__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_sync__descriptor) \
-    , 0 }
+    , 0, 0,0, 0,0, 0,0 }
 
 
 struct  _MesgSyncAck
@@ -214,10 +245,18 @@ struct  _MesgSyncAck
   uint64_t uid;
   uint32_t code;
   char *errmsg;
+  protobuf_c_boolean has_next;
+  uint64_t next;
+  protobuf_c_boolean has_more;
+  uint32_t more;
+  protobuf_c_boolean has_receipt_next;
+  uint64_t receipt_next;
+  protobuf_c_boolean has_receipt_more;
+  uint32_t receipt_more;
 };
 #define MESG_SYNC_ACK__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_sync_ack__descriptor) \
-    , 0, 0, NULL }
+    , 0, 0, NULL, 0,0, 0,0, 0,0, 0,0 }
 
 
 struct  _MesgKick
@@ -231,6 +270,87 @@ struct  _MesgKick
     , 0, NULL }
 
 
+struct  _MesgConvList
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  protobuf_c_boolean has_num;
+  uint32_t num;
+};
+#define MESG_CONV_LIST__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_conv_list__descriptor) \
+    , 0, 0,0 }
+
+
+struct  _MesgConvListAck
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  char *list;
+  protobuf_c_boolean has_code;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_CONV_LIST_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_conv_list_ack__descriptor) \
+    , 0, NULL, 0,0, NULL }
+
+
+struct  _MesgConvRead
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint32_t type;
+  uint64_t id;
+  protobuf_c_boolean has_num;
+  uint32_t num;
+  protobuf_c_boolean has_unread;
+  uint32_t unread;
+};
+#define MESG_CONV_READ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_conv_read__descriptor) \
+    , 0, 0, 0, 0,0, 0,0 }
+
+
+struct  _MesgConvReadAck
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint32_t type;
+  uint64_t id;
+  protobuf_c_boolean has_unread;
+  uint32_t unread;
+  protobuf_c_boolean has_code;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_CONV_READ_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_conv_read_ack__descriptor) \
+    , 0, 0, 0, 0,0, 0,0, NULL }
+
+
+struct  _MesgOnlineNtf
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint64_t time;
+};
+#define MESG_ONLINE_NTF__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_online_ntf__descriptor) \
+    , 0, 0 }
+
+
+struct  _MesgOfflineNtf
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint64_t time;
+};
+#define MESG_OFFLINE_NTF__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_offline_ntf__descriptor) \
+    , 0, 0 }
+
+
 struct  _MesgChat
 {
   ProtobufCMessage base;
@@ -241,10 +361,14 @@ struct  _MesgChat
   char *text;
   protobuf_c_boolean has_data;
   ProtobufCBinaryData data;
+  protobuf_c_boolean has_sid;
+  uint64_t sid;
+  protobuf_c_boolean has_msgid;
+  uint64_t msgid;
 };
 #define MESG_CHAT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_chat__descriptor) \
-    , 0, 0, 0, 0, NULL, 0,{0,NULL} }
+    , 0, 0, 0, 0, NULL, 0,{0,NULL}, 0,0, 0,0 }
 
 
 struct  _MesgChatAck
@@ -254,10 +378,12 @@ struct  _MesgChatAck
   uint64_t duid;
   uint32_t code;
   char *errmsg;
+  protobuf_c_boolean has_sid;
+  uint64_t sid;
 };
 #define MESG_CHAT_ACK__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_chat_ack__descriptor) \
-    , 0, 0, 0, NULL }
+    , 0, 0, 0, NULL, 0,0 }
 
 
 struct  _MesgFriendAdd
@@ -288,10 +414,12 @@ struct  _MesgFriendDel
   ProtobufCMessage base;
   uint64_t suid;
   uint64_t duid;
+  protobuf_c_boolean has_mutual;
+  uint32_t mutual;
 };
 #define MESG_FRIEND_DEL__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_friend_del__descriptor) \
-    , 0, 0 }
+    , 0, 0, 0,0 }
 
 
 struct  _MesgFriendDelAck
@@ -398,11 +526,13 @@ struct  _MesgMarkAdd
   ProtobufCMessage base;
   uint64_t suid;
   uint64_t duid;
+  protobuf_c_boolean has_mark;
   uint64_t mark;
+  char *name;
 };
 #define MESG_MARK_ADD__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_mark_add__descriptor) \
-    , 0, 0, 0 }
+    , 0, 0, 0,0, NULL }
 
 
 struct  _MesgMarkAddAck
@@ -438,6 +568,118 @@ struct  _MesgMarkDelAck
     , 0, NULL }
 
 
+struct  _MesgFriendReply
+{
+  ProtobufCMessage base;
+  uint64_t suid;
+  uint64_t duid;
+  uint32_t pass;
+};
+#define MESG_FRIEND_REPLY__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_friend_reply__descriptor) \
+    , 0, 0, 0 }
+
+
+struct  _MesgFriendReplyAck
+{
+  ProtobufCMessage base;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_FRIEND_REPLY_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_friend_reply_ack__descriptor) \
+    , 0, NULL }
+
+
+struct  _MesgFriendList
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint32_t num;
+  protobuf_c_boolean has_cursor;
+  uint64_t cursor;
+};
+#define MESG_FRIEND_LIST__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_friend_list__descriptor) \
+    , 0, 0, 0,0 }
+
+
+struct  _MesgFriendListAck
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  char *list;
+  protobuf_c_boolean has_next;
+  uint64_t next;
+  protobuf_c_boolean has_total;
+  uint32_t total;
+  protobuf_c_boolean has_code;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_FRIEND_LIST_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_friend_list_ack__descriptor) \
+    , 0, NULL, 0,0, 0,0, 0,0, NULL }
+
+
+struct  _MesgChatReceipt
+{
+  ProtobufCMessage base;
+  uint64_t suid;
+  uint64_t sid;
+  uint64_t msgid;
+  uint64_t duid;
+  protobuf_c_boolean has_time;
+  uint64_t time;
+};
+#define MESG_CHAT_RECEIPT__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_chat_receipt__descriptor) \
+    , 0, 0, 0, 0, 0,0 }
+
+
+struct  _MesgChatReceiptAck
+{
+  ProtobufCMessage base;
+  uint64_t suid;
+  uint64_t sid;
+  uint64_t msgid;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_CHAT_RECEIPT_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_chat_receipt_ack__descriptor) \
+    , 0, 0, 0, 0, NULL }
+
+
+struct  _MesgChatRecall
+{
+  ProtobufCMessage base;
+  uint64_t suid;
+  uint64_t sid;
+  uint64_t msgid;
+  uint64_t duid;
+  protobuf_c_boolean has_time;
+  uint64_t time;
+};
+#define MESG_CHAT_RECALL__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_chat_recall__descriptor) \
+    , 0, 0, 0, 0, 0,0 }
+
+
+struct  _MesgChatRecallAck
+{
+  ProtobufCMessage base;
+  uint64_t suid;
+  uint64_t sid;
+  uint64_t msgid;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_CHAT_RECALL_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_chat_recall_ack__descriptor) \
+    , 0, 0, 0, 0, NULL }
+
+
 struct  _MesgGroupCreat
 {
   ProtobufCMessage base;
@@ -561,10 +803,15 @@ struct  _MesgGroupChat
   char *text;
   protobuf_c_boolean has_data;
   ProtobufCBinaryData data;
+  protobuf_c_boolean has_msgid;
+  uint64_t msgid;
+  protobuf_c_boolean has_revoked;
+  uint32_t revoked;
+  char *at;
 };
 #define MESG_GROUP_CHAT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_group_chat__descriptor) \
-    , 0, 0, 0, 0, NULL, 0,{0,NULL} }
+    , 0, 0, 0, 0, NULL, 0,{0,NULL}, 0,0, 0,0, NULL }
 
 
 struct  _MesgGroupChatAck
@@ -572,10 +819,12 @@ struct  _MesgGroupChatAck
   ProtobufCMessage base;
   uint32_t code;
   char *errmsg;
+  protobuf_c_boolean has_msgid;
+  uint64_t msgid;
 };
 #define MESG_GROUP_CHAT_ACK__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_group_chat_ack__descriptor) \
-    , 0, NULL }
+    , 0, NULL, 0,0 }
 
 
 struct  _MesgGroupKick
@@ -737,10 +986,12 @@ struct  _MesgGroupUsrList
   ProtobufCMessage base;
   uint64_t gid;
   uint32_t num;
+  protobuf_c_boolean has_cursor;
+  uint64_t cursor;
 };
 #define MESG_GROUP_USR_LIST__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_group_usr_list__descriptor) \
-    , 0, 0 }
+    , 0, 0, 0,0 }
 
 
 struct  _MesgGroupUsrListAck
@@ -748,12 +999,100 @@ struct  _MesgGroupUsrListAck
   ProtobufCMessage base;
   uint64_t gid;
   char *list;
+  protobuf_c_boolean has_next;
+  uint64_t next;
+  protobuf_c_boolean has_total;
+  uint32_t total;
+  protobuf_c_boolean has_code;
+  uint32_t code;
+  char *errmsg;
 };
 #define MESG_GROUP_USR_LIST_ACK__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_group_usr_list_ack__descriptor) \
+    , 0, NULL, 0,0, 0,0, 0,0, NULL }
+
+
+struct  _MesgGroupJoinAudit
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint64_t gid;
+  uint64_t to;
+  uint32_t pass;
+};
+#define MESG_GROUP_JOIN_AUDIT__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_join_audit__descriptor) \
+    , 0, 0, 0, 0 }
+
+
+struct  _MesgGroupJoinAuditAck
+{
+  ProtobufCMessage base;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_GROUP_JOIN_AUDIT_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_join_audit_ack__descriptor) \
     , 0, NULL }
 
 
+struct  _MesgGroupRecall
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint64_t gid;
+  uint64_t msgid;
+  protobuf_c_boolean has_time;
+  uint64_t time;
+};
+#define MESG_GROUP_RECALL__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_recall__descriptor) \
+    , 0, 0, 0, 0,0 }
+
+
+struct  _MesgGroupRecallAck
+{
+  ProtobufCMessage base;
+  uint64_t gid;
+  uint64_t msgid;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_GROUP_RECALL_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_recall_ack__descriptor) \
+    , 0, 0, 0, NULL }
+
+
+struct  _MesgGroupSync
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  protobuf_c_boolean has_gid;
+  uint64_t gid;
+  protobuf_c_boolean has_since;
+  uint64_t since;
+  protobuf_c_boolean has_num;
+  uint32_t num;
+};
+#define MESG_GROUP_SYNC__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_sync__descriptor) \
+    , 0, 0,0, 0,0, 0,0 }
+
+
+struct  _MesgGroupSyncAck
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  char *list;
+  protobuf_c_boolean has_code;
+  uint32_t code;
+  char *errmsg;
+};
+#define MESG_GROUP_SYNC_ACK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_sync_ack__descriptor) \
+    , 0, NULL, 0,0, NULL }
+
+
 struct  _MesgGroupJoinNtf
 {
   ProtobufCMessage base;
@@ -853,6 +1192,17 @@ struct  _MesgGroupMgrDelNtf
     , 0, 0 }
 
 
+struct  _MesgGroupDismissNtf
+{
+  ProtobufCMessage base;
+  uint64_t uid;
+  uint64_t gid;
+};
+#define MESG_GROUP_DISMISS_NTF__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mesg_group_dismiss_ntf__descriptor) \
+    , 0, 0 }
+
+
 struct  _MesgRoomCreat
 {
   ProtobufCMessage base;
@@ -1098,10 +1448,13 @@ struct  _MesgLsndInfo
   char *ip;
   uint32_t port;
   uint32_t connections;
+  char *ipv6;
+  protobuf_c_boolean has_status;
+  uint32_t status;
 };
 #define MESG_LSND_INFO__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mesg_lsnd_info__descriptor) \
-    , 0, 0, 0, NULL, NULL, 0, 0 }
+    , 0, 0, 0, NULL, NULL, 0, 0, NULL, 0,0 }
 
 
 struct  _MesgFrwdInfo
@@ -1307,6 +1660,120 @@ MesgKick *
 void   mesg_kick__free_unpacked
                      (MesgKick *message,
                       ProtobufCAllocator *allocator);
+/* MesgConvList methods */
+void   mesg_conv_list__init
+                     (MesgConvList         *message);
+size_t mesg_conv_list__get_packed_size
+                     (const MesgConvList   *message);
+size_t mesg_conv_list__pack
+                     (const MesgConvList   *message,
+                      uint8_t             *out);
+size_t mesg_conv_list__pack_to_buffer
+                     (const MesgConvList   *message,
+                      ProtobufCBuffer     *buffer);
+MesgConvList *
+       mesg_conv_list__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_conv_list__free_unpacked
+                     (MesgConvList *message,
+                      ProtobufCAllocator *allocator);
+/* MesgConvListAck methods */
+void   mesg_conv_list_ack__init
+                     (MesgConvListAck         *message);
+size_t mesg_conv_list_ack__get_packed_size
+                     (const MesgConvListAck   *message);
+size_t mesg_conv_list_ack__pack
+                     (const MesgConvListAck   *message,
+                      uint8_t             *out);
+size_t mesg_conv_list_ack__pack_to_buffer
+                     (const MesgConvListAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgConvListAck *
+       mesg_conv_list_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_conv_list_ack__free_unpacked
+                     (MesgConvListAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgConvRead methods */
+void   mesg_conv_read__init
+                     (MesgConvRead         *message);
+size_t mesg_conv_read__get_packed_size
+                     (const MesgConvRead   *message);
+size_t mesg_conv_read__pack
+                     (const MesgConvRead   *message,
+                      uint8_t             *out);
+size_t mesg_conv_read__pack_to_buffer
+                     (const MesgConvRead   *message,
+                      ProtobufCBuffer     *buffer);
+MesgConvRead *
+       mesg_conv_read__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_conv_read__free_unpacked
+                     (MesgConvRead *message,
+                      ProtobufCAllocator *allocator);
+/* MesgConvReadAck methods */
+void   mesg_conv_read_ack__init
+                     (MesgConvReadAck         *message);
+size_t mesg_conv_read_ack__get_packed_size
+                     (const MesgConvReadAck   *message);
+size_t mesg_conv_read_ack__pack
+                     (const MesgConvReadAck   *message,
+                      uint8_t             *out);
+size_t mesg_conv_read_ack__pack_to_buffer
+                     (const MesgConvReadAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgConvReadAck *
+       mesg_conv_read_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_conv_read_ack__free_unpacked
+                     (MesgConvReadAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgOnlineNtf methods */
+void   mesg_online_ntf__init
+                     (MesgOnlineNtf         *message);
+size_t mesg_online_ntf__get_packed_size
+                     (const MesgOnlineNtf   *message);
+size_t mesg_online_ntf__pack
+                     (const MesgOnlineNtf   *message,
+                      uint8_t             *out);
+size_t mesg_online_ntf__pack_to_buffer
+                     (const MesgOnlineNtf   *message,
+                      ProtobufCBuffer     *buffer);
+MesgOnlineNtf *
+       mesg_online_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_online_ntf__free_unpacked
+                     (MesgOnlineNtf *message,
+                      ProtobufCAllocator *allocator);
+/* MesgOfflineNtf methods */
+void   mesg_offline_ntf__init
+                     (MesgOfflineNtf         *message);
+size_t mesg_offline_ntf__get_packed_size
+                     (const MesgOfflineNtf   *message);
+size_t mesg_offline_ntf__pack
+                     (const MesgOfflineNtf   *message,
+                      uint8_t             *out);
+size_t mesg_offline_ntf__pack_to_buffer
+                     (const MesgOfflineNtf   *message,
+                      ProtobufCBuffer     *buffer);
+MesgOfflineNtf *
+       mesg_offline_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_offline_ntf__free_unpacked
+                     (MesgOfflineNtf *message,
+                      ProtobufCAllocator *allocator);
 /* MesgChat methods */
 void   mesg_chat__init
                      (MesgChat         *message);
@@ -1649,6 +2116,158 @@ MesgMarkDelAck *
 void   mesg_mark_del_ack__free_unpacked
                      (MesgMarkDelAck *message,
                       ProtobufCAllocator *allocator);
+/* MesgFriendReply methods */
+void   mesg_friend_reply__init
+                     (MesgFriendReply         *message);
+size_t mesg_friend_reply__get_packed_size
+                     (const MesgFriendReply   *message);
+size_t mesg_friend_reply__pack
+                     (const MesgFriendReply   *message,
+                      uint8_t             *out);
+size_t mesg_friend_reply__pack_to_buffer
+                     (const MesgFriendReply   *message,
+                      ProtobufCBuffer     *buffer);
+MesgFriendReply *
+       mesg_friend_reply__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_friend_reply__free_unpacked
+                     (MesgFriendReply *message,
+                      ProtobufCAllocator *allocator);
+/* MesgFriendReplyAck methods */
+void   mesg_friend_reply_ack__init
+                     (MesgFriendReplyAck         *message);
+size_t mesg_friend_reply_ack__get_packed_size
+                     (const MesgFriendReplyAck   *message);
+size_t mesg_friend_reply_ack__pack
+                     (const MesgFriendReplyAck   *message,
+                      uint8_t             *out);
+size_t mesg_friend_reply_ack__pack_to_buffer
+                     (const MesgFriendReplyAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgFriendReplyAck *
+       mesg_friend_reply_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_friend_reply_ack__free_unpacked
+                     (MesgFriendReplyAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgFriendList methods */
+void   mesg_friend_list__init
+                     (MesgFriendList         *message);
+size_t mesg_friend_list__get_packed_size
+                     (const MesgFriendList   *message);
+size_t mesg_friend_list__pack
+                     (const MesgFriendList   *message,
+                      uint8_t             *out);
+size_t mesg_friend_list__pack_to_buffer
+                     (const MesgFriendList   *message,
+                      ProtobufCBuffer     *buffer);
+MesgFriendList *
+       mesg_friend_list__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_friend_list__free_unpacked
+                     (MesgFriendList *message,
+                      ProtobufCAllocator *allocator);
+/* MesgFriendListAck methods */
+void   mesg_friend_list_ack__init
+                     (MesgFriendListAck         *message);
+size_t mesg_friend_list_ack__get_packed_size
+                     (const MesgFriendListAck   *message);
+size_t mesg_friend_list_ack__pack
+                     (const MesgFriendListAck   *message,
+                      uint8_t             *out);
+size_t mesg_friend_list_ack__pack_to_buffer
+                     (const MesgFriendListAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgFriendListAck *
+       mesg_friend_list_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_friend_list_ack__free_unpacked
+                     (MesgFriendListAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgChatReceipt methods */
+void   mesg_chat_receipt__init
+                     (MesgChatReceipt         *message);
+size_t mesg_chat_receipt__get_packed_size
+                     (const MesgChatReceipt   *message);
+size_t mesg_chat_receipt__pack
+                     (const MesgChatReceipt   *message,
+                      uint8_t             *out);
+size_t mesg_chat_receipt__pack_to_buffer
+                     (const MesgChatReceipt   *message,
+                      ProtobufCBuffer     *buffer);
+MesgChatReceipt *
+       mesg_chat_receipt__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_chat_receipt__free_unpacked
+                     (MesgChatReceipt *message,
+                      ProtobufCAllocator *allocator);
+/* MesgChatReceiptAck methods */
+void   mesg_chat_receipt_ack__init
+                     (MesgChatReceiptAck         *message);
+size_t mesg_chat_receipt_ack__get_packed_size
+                     (const MesgChatReceiptAck   *message);
+size_t mesg_chat_receipt_ack__pack
+                     (const MesgChatReceiptAck   *message,
+                      uint8_t             *out);
+size_t mesg_chat_receipt_ack__pack_to_buffer
+                     (const MesgChatReceiptAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgChatReceiptAck *
+       mesg_chat_receipt_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_chat_receipt_ack__free_unpacked
+                     (MesgChatReceiptAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgChatRecall methods */
+void   mesg_chat_recall__init
+                     (MesgChatRecall         *message);
+size_t mesg_chat_recall__get_packed_size
+                     (const MesgChatRecall   *message);
+size_t mesg_chat_recall__pack
+                     (const MesgChatRecall   *message,
+                      uint8_t             *out);
+size_t mesg_chat_recall__pack_to_buffer
+                     (const MesgChatRecall   *message,
+                      ProtobufCBuffer     *buffer);
+MesgChatRecall *
+       mesg_chat_recall__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_chat_recall__free_unpacked
+                     (MesgChatRecall *message,
+                      ProtobufCAllocator *allocator);
+/* MesgChatRecallAck methods */
+void   mesg_chat_recall_ack__init
+                     (MesgChatRecallAck         *message);
+size_t mesg_chat_recall_ack__get_packed_size
+                     (const MesgChatRecallAck   *message);
+size_t mesg_chat_recall_ack__pack
+                     (const MesgChatRecallAck   *message,
+                      uint8_t             *out);
+size_t mesg_chat_recall_ack__pack_to_buffer
+                     (const MesgChatRecallAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgChatRecallAck *
+       mesg_chat_recall_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_chat_recall_ack__free_unpacked
+                     (MesgChatRecallAck *message,
+                      ProtobufCAllocator *allocator);
 /* MesgGroupCreat methods */
 void   mesg_group_creat__init
                      (MesgGroupCreat         *message);
@@ -2181,6 +2800,120 @@ MesgGroupUsrListAck *
 void   mesg_group_usr_list_ack__free_unpacked
                      (MesgGroupUsrListAck *message,
                       ProtobufCAllocator *allocator);
+/* MesgGroupJoinAudit methods */
+void   mesg_group_join_audit__init
+                     (MesgGroupJoinAudit         *message);
+size_t mesg_group_join_audit__get_packed_size
+                     (const MesgGroupJoinAudit   *message);
+size_t mesg_group_join_audit__pack
+                     (const MesgGroupJoinAudit   *message,
+                      uint8_t             *out);
+size_t mesg_group_join_audit__pack_to_buffer
+                     (const MesgGroupJoinAudit   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupJoinAudit *
+       mesg_group_join_audit__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_join_audit__free_unpacked
+                     (MesgGroupJoinAudit *message,
+                      ProtobufCAllocator *allocator);
+/* MesgGroupJoinAuditAck methods */
+void   mesg_group_join_audit_ack__init
+                     (MesgGroupJoinAuditAck         *message);
+size_t mesg_group_join_audit_ack__get_packed_size
+                     (const MesgGroupJoinAuditAck   *message);
+size_t mesg_group_join_audit_ack__pack
+                     (const MesgGroupJoinAuditAck   *message,
+                      uint8_t             *out);
+size_t mesg_group_join_audit_ack__pack_to_buffer
+                     (const MesgGroupJoinAuditAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupJoinAuditAck *
+       mesg_group_join_audit_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_join_audit_ack__free_unpacked
+                     (MesgGroupJoinAuditAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgGroupRecall methods */
+void   mesg_group_recall__init
+                     (MesgGroupRecall         *message);
+size_t mesg_group_recall__get_packed_size
+                     (const MesgGroupRecall   *message);
+size_t mesg_group_recall__pack
+                     (const MesgGroupRecall   *message,
+                      uint8_t             *out);
+size_t mesg_group_recall__pack_to_buffer
+                     (const MesgGroupRecall   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupRecall *
+       mesg_group_recall__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_recall__free_unpacked
+                     (MesgGroupRecall *message,
+                      ProtobufCAllocator *allocator);
+/* MesgGroupRecallAck methods */
+void   mesg_group_recall_ack__init
+                     (MesgGroupRecallAck         *message);
+size_t mesg_group_recall_ack__get_packed_size
+                     (const MesgGroupRecallAck   *message);
+size_t mesg_group_recall_ack__pack
+                     (const MesgGroupRecallAck   *message,
+                      uint8_t             *out);
+size_t mesg_group_recall_ack__pack_to_buffer
+                     (const MesgGroupRecallAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupRecallAck *
+       mesg_group_recall_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_recall_ack__free_unpacked
+                     (MesgGroupRecallAck *message,
+                      ProtobufCAllocator *allocator);
+/* MesgGroupSync methods */
+void   mesg_group_sync__init
+                     (MesgGroupSync         *message);
+size_t mesg_group_sync__get_packed_size
+                     (const MesgGroupSync   *message);
+size_t mesg_group_sync__pack
+                     (const MesgGroupSync   *message,
+                      uint8_t             *out);
+size_t mesg_group_sync__pack_to_buffer
+                     (const MesgGroupSync   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupSync *
+       mesg_group_sync__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_sync__free_unpacked
+                     (MesgGroupSync *message,
+                      ProtobufCAllocator *allocator);
+/* MesgGroupSyncAck methods */
+void   mesg_group_sync_ack__init
+                     (MesgGroupSyncAck         *message);
+size_t mesg_group_sync_ack__get_packed_size
+                     (const MesgGroupSyncAck   *message);
+size_t mesg_group_sync_ack__pack
+                     (const MesgGroupSyncAck   *message,
+                      uint8_t             *out);
+size_t mesg_group_sync_ack__pack_to_buffer
+                     (const MesgGroupSyncAck   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupSyncAck *
+       mesg_group_sync_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_sync_ack__free_unpacked
+                     (MesgGroupSyncAck *message,
+                      ProtobufCAllocator *allocator);
 /* MesgGroupJoinNtf methods */
 void   mesg_group_join_ntf__init
                      (MesgGroupJoinNtf         *message);
@@ -2352,6 +3085,25 @@ MesgGroupMgrDelNtf *
 void   mesg_group_mgr_del_ntf__free_unpacked
                      (MesgGroupMgrDelNtf *message,
                       ProtobufCAllocator *allocator);
+/* MesgGroupDismissNtf methods */
+void   mesg_group_dismiss_ntf__init
+                     (MesgGroupDismissNtf         *message);
+size_t mesg_group_dismiss_ntf__get_packed_size
+                     (const MesgGroupDismissNtf   *message);
+size_t mesg_group_dismiss_ntf__pack
+                     (const MesgGroupDismissNtf   *message,
+                      uint8_t             *out);
+size_t mesg_group_dismiss_ntf__pack_to_buffer
+                     (const MesgGroupDismissNtf   *message,
+                      ProtobufCBuffer     *buffer);
+MesgGroupDismissNtf *
+       mesg_group_dismiss_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mesg_group_dismiss_ntf__free_unpacked
+                     (MesgGroupDismissNtf *message,
+                      ProtobufCAllocator *allocator);
 /* MesgRoomCreat methods */
 void   mesg_room_creat__init
                      (MesgRoomCreat         *message);
@@ -2783,6 +3535,24 @@ typedef void (*MesgSyncAck_Closure)
 typedef void (*MesgKick_Closure)
                  (const MesgKick *message,
                   void *closure_data);
+typedef void (*MesgConvList_Closure)
+                 (const MesgConvList *message,
+                  void *closure_data);
+typedef void (*MesgConvListAck_Closure)
+                 (const MesgConvListAck *message,
+                  void *closure_data);
+typedef void (*MesgConvRead_Closure)
+                 (const MesgConvRead *message,
+                  void *closure_data);
+typedef void (*MesgConvReadAck_Closure)
+                 (const MesgConvReadAck *message,
+                  void *closure_data);
+typedef void (*MesgOnlineNtf_Closure)
+                 (const MesgOnlineNtf *message,
+                  void *closure_data);
+typedef void (*MesgOfflineNtf_Closure)
+                 (const MesgOfflineNtf *message,
+                  void *closure_data);
 typedef void (*MesgChat_Closure)
                  (const MesgChat *message,
                   void *closure_data);
@@ -2837,6 +3607,30 @@ typedef void (*MesgMarkDel_Closure)
 typedef void (*MesgMarkDelAck_Closure)
                  (const MesgMarkDelAck *message,
                   void *closure_data);
+typedef void (*MesgFriendReply_Closure)
+                 (const MesgFriendReply *message,
+                  void *closure_data);
+typedef void (*MesgFriendReplyAck_Closure)
+                 (const MesgFriendReplyAck *message,
+                  void *closure_data);
+typedef void (*MesgFriendList_Closure)
+                 (const MesgFriendList *message,
+                  void *closure_data);
+typedef void (*MesgFriendListAck_Closure)
+                 (const MesgFriendListAck *message,
+                  void *closure_data);
+typedef void (*MesgChatReceipt_Closure)
+                 (const MesgChatReceipt *message,
+                  void *closure_data);
+typedef void (*MesgChatReceiptAck_Closure)
+                 (const MesgChatReceiptAck *message,
+                  void *closure_data);
+typedef void (*MesgChatRecall_Closure)
+                 (const MesgChatRecall *message,
+                  void *closure_data);
+typedef void (*MesgChatRecallAck_Closure)
+                 (const MesgChatRecallAck *message,
+                  void *closure_data);
 typedef void (*MesgGroupCreat_Closure)
                  (const MesgGroupCreat *message,
                   void *closure_data);
@@ -2921,6 +3715,24 @@ typedef void (*MesgGroupUsrList_Closure)
 typedef void (*MesgGroupUsrListAck_Closure)
                  (const MesgGroupUsrListAck *message,
                   void *closure_data);
+typedef void (*MesgGroupJoinAudit_Closure)
+                 (const MesgGroupJoinAudit *message,
+                  void *closure_data);
+typedef void (*MesgGroupJoinAuditAck_Closure)
+                 (const MesgGroupJoinAuditAck *message,
+                  void *closure_data);
+typedef void (*MesgGroupRecall_Closure)
+                 (const MesgGroupRecall *message,
+                  void *closure_data);
+typedef void (*MesgGroupRecallAck_Closure)
+                 (const MesgGroupRecallAck *message,
+                  void *closure_data);
+typedef void (*MesgGroupSync_Closure)
+                 (const MesgGroupSync *message,
+                  void *closure_data);
+typedef void (*MesgGroupSyncAck_Closure)
+                 (const MesgGroupSyncAck *message,
+                  void *closure_data);
 typedef void (*MesgGroupJoinNtf_Closure)
                  (const MesgGroupJoinNtf *message,
                   void *closure_data);
@@ -2948,6 +3760,9 @@ typedef void (*MesgGroupMgrAddNtf_Closure)
 typedef void (*MesgGroupMgrDelNtf_Closure)
                  (const MesgGroupMgrDelNtf *message,
                   void *closure_data);
+typedef void (*MesgGroupDismissNtf_Closure)
+                 (const MesgGroupDismissNtf *message,
+                  void *closure_data);
 typedef void (*MesgRoomCreat_Closure)
                  (const MesgRoomCreat *message,
                   void *closure_data);
@@ -3027,6 +3842,12 @@ extern const ProtobufCMessageDescriptor mesg_error__descriptor;
 extern const ProtobufCMessageDescriptor mesg_sync__descriptor;
 extern const ProtobufCMessageDescriptor mesg_sync_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_kick__descriptor;
+extern const ProtobufCMessageDescriptor mesg_conv_list__descriptor;
+extern const ProtobufCMessageDescriptor mesg_conv_list_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_conv_read__descriptor;
+extern const ProtobufCMessageDescriptor mesg_conv_read_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_online_ntf__descriptor;
+extern const ProtobufCMessageDescriptor mesg_offline_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_chat__descriptor;
 extern const ProtobufCMessageDescriptor mesg_chat_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_friend_add__descriptor;
@@ -3045,6 +3866,14 @@ extern const ProtobufCMessageDescriptor mesg_mark_add__descriptor;
 extern const ProtobufCMessageDescriptor mesg_mark_add_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_mark_del__descriptor;
 extern const ProtobufCMessageDescriptor mesg_mark_del_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_friend_reply__descriptor;
+extern const ProtobufCMessageDescriptor mesg_friend_reply_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_friend_list__descriptor;
+extern const ProtobufCMessageDescriptor mesg_friend_list_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_chat_receipt__descriptor;
+extern const ProtobufCMessageDescriptor mesg_chat_receipt_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_chat_recall__descriptor;
+extern const ProtobufCMessageDescriptor mesg_chat_recall_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_creat__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_creat_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_dismiss__descriptor;
@@ -3073,6 +3902,12 @@ extern const ProtobufCMessageDescriptor mesg_group_mgr_del__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_mgr_del_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_usr_list__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_usr_list_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_join_audit__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_join_audit_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_recall__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_recall_ack__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_sync__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_sync_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_join_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_quit_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_kick_ntf__descriptor;
@@ -3082,6 +3917,7 @@ extern const ProtobufCMessageDescriptor mesg_group_bl_add_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_bl_del_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_mgr_add_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_group_mgr_del_ntf__descriptor;
+extern const ProtobufCMessageDescriptor mesg_group_dismiss_ntf__descriptor;
 extern const ProtobufCMessageDescriptor mesg_room_creat__descriptor;
 extern const ProtobufCMessageDescriptor mesg_room_creat_ack__descriptor;
 extern const ProtobufCMessageDescriptor mesg_room_dismiss__descriptor;
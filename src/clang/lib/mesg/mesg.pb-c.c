@@ -437,6 +437,264 @@ void   mesg_kick__free_unpacked
   assert(message->base.descriptor == &mesg_kick__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mesg_conv_list__init
+                     (MesgConvList         *message)
+{
+  static MesgConvList init_value = MESG_CONV_LIST__INIT;
+  *message = init_value;
+}
+size_t mesg_conv_list__get_packed_size
+                     (const MesgConvList *message)
+{
+  assert(message->base.descriptor == &mesg_conv_list__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_conv_list__pack
+                     (const MesgConvList *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_conv_list__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_conv_list__pack_to_buffer
+                     (const MesgConvList *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_conv_list__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgConvList *
+       mesg_conv_list__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgConvList *)
+     protobuf_c_message_unpack (&mesg_conv_list__descriptor,
+                                allocator, len, data);
+}
+void   mesg_conv_list__free_unpacked
+                     (MesgConvList *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_conv_list__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_conv_list_ack__init
+                     (MesgConvListAck         *message)
+{
+  static MesgConvListAck init_value = MESG_CONV_LIST_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_conv_list_ack__get_packed_size
+                     (const MesgConvListAck *message)
+{
+  assert(message->base.descriptor == &mesg_conv_list_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_conv_list_ack__pack
+                     (const MesgConvListAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_conv_list_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_conv_list_ack__pack_to_buffer
+                     (const MesgConvListAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_conv_list_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgConvListAck *
+       mesg_conv_list_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgConvListAck *)
+     protobuf_c_message_unpack (&mesg_conv_list_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_conv_list_ack__free_unpacked
+                     (MesgConvListAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_conv_list_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_conv_read__init
+                     (MesgConvRead         *message)
+{
+  static MesgConvRead init_value = MESG_CONV_READ__INIT;
+  *message = init_value;
+}
+size_t mesg_conv_read__get_packed_size
+                     (const MesgConvRead *message)
+{
+  assert(message->base.descriptor == &mesg_conv_read__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_conv_read__pack
+                     (const MesgConvRead *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_conv_read__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_conv_read__pack_to_buffer
+                     (const MesgConvRead *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_conv_read__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgConvRead *
+       mesg_conv_read__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgConvRead *)
+     protobuf_c_message_unpack (&mesg_conv_read__descriptor,
+                                allocator, len, data);
+}
+void   mesg_conv_read__free_unpacked
+                     (MesgConvRead *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_conv_read__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_conv_read_ack__init
+                     (MesgConvReadAck         *message)
+{
+  static MesgConvReadAck init_value = MESG_CONV_READ_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_conv_read_ack__get_packed_size
+                     (const MesgConvReadAck *message)
+{
+  assert(message->base.descriptor == &mesg_conv_read_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_conv_read_ack__pack
+                     (const MesgConvReadAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_conv_read_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_conv_read_ack__pack_to_buffer
+                     (const MesgConvReadAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_conv_read_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgConvReadAck *
+       mesg_conv_read_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgConvReadAck *)
+     protobuf_c_message_unpack (&mesg_conv_read_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_conv_read_ack__free_unpacked
+                     (MesgConvReadAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_conv_read_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_online_ntf__init
+                     (MesgOnlineNtf         *message)
+{
+  static MesgOnlineNtf init_value = MESG_ONLINE_NTF__INIT;
+  *message = init_value;
+}
+size_t mesg_online_ntf__get_packed_size
+                     (const MesgOnlineNtf *message)
+{
+  assert(message->base.descriptor == &mesg_online_ntf__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_online_ntf__pack
+                     (const MesgOnlineNtf *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_online_ntf__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_online_ntf__pack_to_buffer
+                     (const MesgOnlineNtf *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_online_ntf__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgOnlineNtf *
+       mesg_online_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgOnlineNtf *)
+     protobuf_c_message_unpack (&mesg_online_ntf__descriptor,
+                                allocator, len, data);
+}
+void   mesg_online_ntf__free_unpacked
+                     (MesgOnlineNtf *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_online_ntf__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_offline_ntf__init
+                     (MesgOfflineNtf         *message)
+{
+  static MesgOfflineNtf init_value = MESG_OFFLINE_NTF__INIT;
+  *message = init_value;
+}
+size_t mesg_offline_ntf__get_packed_size
+                     (const MesgOfflineNtf *message)
+{
+  assert(message->base.descriptor == &mesg_offline_ntf__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_offline_ntf__pack
+                     (const MesgOfflineNtf *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_offline_ntf__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_offline_ntf__pack_to_buffer
+                     (const MesgOfflineNtf *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_offline_ntf__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgOfflineNtf *
+       mesg_offline_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgOfflineNtf *)
+     protobuf_c_message_unpack (&mesg_offline_ntf__descriptor,
+                                allocator, len, data);
+}
+void   mesg_offline_ntf__free_unpacked
+                     (MesgOfflineNtf *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_offline_ntf__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mesg_chat__init
                      (MesgChat         *message)
 {
@@ -1211,2937 +1469,5260 @@ void   mesg_mark_del_ack__free_unpacked
   assert(message->base.descriptor == &mesg_mark_del_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_creat__init
-                     (MesgGroupCreat         *message)
+void   mesg_friend_reply__init
+                     (MesgFriendReply         *message)
 {
-  static MesgGroupCreat init_value = MESG_GROUP_CREAT__INIT;
+  static MesgFriendReply init_value = MESG_FRIEND_REPLY__INIT;
   *message = init_value;
 }
-size_t mesg_group_creat__get_packed_size
-                     (const MesgGroupCreat *message)
+size_t mesg_friend_reply__get_packed_size
+                     (const MesgFriendReply *message)
 {
-  assert(message->base.descriptor == &mesg_group_creat__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_creat__pack
-                     (const MesgGroupCreat *message,
+size_t mesg_friend_reply__pack
+                     (const MesgFriendReply *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_creat__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_creat__pack_to_buffer
-                     (const MesgGroupCreat *message,
+size_t mesg_friend_reply__pack_to_buffer
+                     (const MesgFriendReply *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_creat__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupCreat *
-       mesg_group_creat__unpack
+MesgFriendReply *
+       mesg_friend_reply__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupCreat *)
-     protobuf_c_message_unpack (&mesg_group_creat__descriptor,
+  return (MesgFriendReply *)
+     protobuf_c_message_unpack (&mesg_friend_reply__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_creat__free_unpacked
-                     (MesgGroupCreat *message,
+void   mesg_friend_reply__free_unpacked
+                     (MesgFriendReply *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_creat__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_creat_ack__init
-                     (MesgGroupCreatAck         *message)
+void   mesg_friend_reply_ack__init
+                     (MesgFriendReplyAck         *message)
 {
-  static MesgGroupCreatAck init_value = MESG_GROUP_CREAT_ACK__INIT;
+  static MesgFriendReplyAck init_value = MESG_FRIEND_REPLY_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_creat_ack__get_packed_size
-                     (const MesgGroupCreatAck *message)
+size_t mesg_friend_reply_ack__get_packed_size
+                     (const MesgFriendReplyAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_creat_ack__pack
-                     (const MesgGroupCreatAck *message,
+size_t mesg_friend_reply_ack__pack
+                     (const MesgFriendReplyAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_creat_ack__pack_to_buffer
-                     (const MesgGroupCreatAck *message,
+size_t mesg_friend_reply_ack__pack_to_buffer
+                     (const MesgFriendReplyAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupCreatAck *
-       mesg_group_creat_ack__unpack
+MesgFriendReplyAck *
+       mesg_friend_reply_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupCreatAck *)
-     protobuf_c_message_unpack (&mesg_group_creat_ack__descriptor,
+  return (MesgFriendReplyAck *)
+     protobuf_c_message_unpack (&mesg_friend_reply_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_creat_ack__free_unpacked
-                     (MesgGroupCreatAck *message,
+void   mesg_friend_reply_ack__free_unpacked
+                     (MesgFriendReplyAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_reply_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_dismiss__init
-                     (MesgGroupDismiss         *message)
+void   mesg_friend_list__init
+                     (MesgFriendList         *message)
 {
-  static MesgGroupDismiss init_value = MESG_GROUP_DISMISS__INIT;
+  static MesgFriendList init_value = MESG_FRIEND_LIST__INIT;
   *message = init_value;
 }
-size_t mesg_group_dismiss__get_packed_size
-                     (const MesgGroupDismiss *message)
+size_t mesg_friend_list__get_packed_size
+                     (const MesgFriendList *message)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_dismiss__pack
-                     (const MesgGroupDismiss *message,
+size_t mesg_friend_list__pack
+                     (const MesgFriendList *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_dismiss__pack_to_buffer
-                     (const MesgGroupDismiss *message,
+size_t mesg_friend_list__pack_to_buffer
+                     (const MesgFriendList *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupDismiss *
-       mesg_group_dismiss__unpack
+MesgFriendList *
+       mesg_friend_list__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupDismiss *)
-     protobuf_c_message_unpack (&mesg_group_dismiss__descriptor,
+  return (MesgFriendList *)
+     protobuf_c_message_unpack (&mesg_friend_list__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_dismiss__free_unpacked
-                     (MesgGroupDismiss *message,
+void   mesg_friend_list__free_unpacked
+                     (MesgFriendList *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_dismiss_ack__init
-                     (MesgGroupDismissAck         *message)
+void   mesg_friend_list_ack__init
+                     (MesgFriendListAck         *message)
 {
-  static MesgGroupDismissAck init_value = MESG_GROUP_DISMISS_ACK__INIT;
+  static MesgFriendListAck init_value = MESG_FRIEND_LIST_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_dismiss_ack__get_packed_size
-                     (const MesgGroupDismissAck *message)
+size_t mesg_friend_list_ack__get_packed_size
+                     (const MesgFriendListAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_dismiss_ack__pack
-                     (const MesgGroupDismissAck *message,
+size_t mesg_friend_list_ack__pack
+                     (const MesgFriendListAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_dismiss_ack__pack_to_buffer
-                     (const MesgGroupDismissAck *message,
+size_t mesg_friend_list_ack__pack_to_buffer
+                     (const MesgFriendListAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupDismissAck *
-       mesg_group_dismiss_ack__unpack
+MesgFriendListAck *
+       mesg_friend_list_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupDismissAck *)
-     protobuf_c_message_unpack (&mesg_group_dismiss_ack__descriptor,
+  return (MesgFriendListAck *)
+     protobuf_c_message_unpack (&mesg_friend_list_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_dismiss_ack__free_unpacked
-                     (MesgGroupDismissAck *message,
+void   mesg_friend_list_ack__free_unpacked
+                     (MesgFriendListAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_friend_list_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_join__init
-                     (MesgGroupJoin         *message)
+void   mesg_chat_receipt__init
+                     (MesgChatReceipt         *message)
 {
-  static MesgGroupJoin init_value = MESG_GROUP_JOIN__INIT;
+  static MesgChatReceipt init_value = MESG_CHAT_RECEIPT__INIT;
   *message = init_value;
 }
-size_t mesg_group_join__get_packed_size
-                     (const MesgGroupJoin *message)
+size_t mesg_chat_receipt__get_packed_size
+                     (const MesgChatReceipt *message)
 {
-  assert(message->base.descriptor == &mesg_group_join__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_join__pack
-                     (const MesgGroupJoin *message,
+size_t mesg_chat_receipt__pack
+                     (const MesgChatReceipt *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_join__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_join__pack_to_buffer
-                     (const MesgGroupJoin *message,
+size_t mesg_chat_receipt__pack_to_buffer
+                     (const MesgChatReceipt *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_join__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupJoin *
-       mesg_group_join__unpack
+MesgChatReceipt *
+       mesg_chat_receipt__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupJoin *)
-     protobuf_c_message_unpack (&mesg_group_join__descriptor,
+  return (MesgChatReceipt *)
+     protobuf_c_message_unpack (&mesg_chat_receipt__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_join__free_unpacked
-                     (MesgGroupJoin *message,
+void   mesg_chat_receipt__free_unpacked
+                     (MesgChatReceipt *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_join__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_join_ack__init
-                     (MesgGroupJoinAck         *message)
+void   mesg_chat_receipt_ack__init
+                     (MesgChatReceiptAck         *message)
 {
-  static MesgGroupJoinAck init_value = MESG_GROUP_JOIN_ACK__INIT;
+  static MesgChatReceiptAck init_value = MESG_CHAT_RECEIPT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_join_ack__get_packed_size
-                     (const MesgGroupJoinAck *message)
+size_t mesg_chat_receipt_ack__get_packed_size
+                     (const MesgChatReceiptAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_join_ack__pack
-                     (const MesgGroupJoinAck *message,
+size_t mesg_chat_receipt_ack__pack
+                     (const MesgChatReceiptAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_join_ack__pack_to_buffer
-                     (const MesgGroupJoinAck *message,
+size_t mesg_chat_receipt_ack__pack_to_buffer
+                     (const MesgChatReceiptAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupJoinAck *
-       mesg_group_join_ack__unpack
+MesgChatReceiptAck *
+       mesg_chat_receipt_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupJoinAck *)
-     protobuf_c_message_unpack (&mesg_group_join_ack__descriptor,
+  return (MesgChatReceiptAck *)
+     protobuf_c_message_unpack (&mesg_chat_receipt_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_join_ack__free_unpacked
-                     (MesgGroupJoinAck *message,
+void   mesg_chat_receipt_ack__free_unpacked
+                     (MesgChatReceiptAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_receipt_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_quit__init
-                     (MesgGroupQuit         *message)
+void   mesg_chat_recall__init
+                     (MesgChatRecall         *message)
 {
-  static MesgGroupQuit init_value = MESG_GROUP_QUIT__INIT;
+  static MesgChatRecall init_value = MESG_CHAT_RECALL__INIT;
   *message = init_value;
 }
-size_t mesg_group_quit__get_packed_size
-                     (const MesgGroupQuit *message)
+size_t mesg_chat_recall__get_packed_size
+                     (const MesgChatRecall *message)
 {
-  assert(message->base.descriptor == &mesg_group_quit__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_quit__pack
-                     (const MesgGroupQuit *message,
+size_t mesg_chat_recall__pack
+                     (const MesgChatRecall *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_quit__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_quit__pack_to_buffer
-                     (const MesgGroupQuit *message,
+size_t mesg_chat_recall__pack_to_buffer
+                     (const MesgChatRecall *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_quit__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupQuit *
-       mesg_group_quit__unpack
+MesgChatRecall *
+       mesg_chat_recall__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupQuit *)
-     protobuf_c_message_unpack (&mesg_group_quit__descriptor,
+  return (MesgChatRecall *)
+     protobuf_c_message_unpack (&mesg_chat_recall__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_quit__free_unpacked
-                     (MesgGroupQuit *message,
+void   mesg_chat_recall__free_unpacked
+                     (MesgChatRecall *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_quit__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_quit_ack__init
-                     (MesgGroupQuitAck         *message)
+void   mesg_chat_recall_ack__init
+                     (MesgChatRecallAck         *message)
 {
-  static MesgGroupQuitAck init_value = MESG_GROUP_QUIT_ACK__INIT;
+  static MesgChatRecallAck init_value = MESG_CHAT_RECALL_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_quit_ack__get_packed_size
-                     (const MesgGroupQuitAck *message)
+size_t mesg_chat_recall_ack__get_packed_size
+                     (const MesgChatRecallAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_quit_ack__pack
-                     (const MesgGroupQuitAck *message,
+size_t mesg_chat_recall_ack__pack
+                     (const MesgChatRecallAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_quit_ack__pack_to_buffer
-                     (const MesgGroupQuitAck *message,
+size_t mesg_chat_recall_ack__pack_to_buffer
+                     (const MesgChatRecallAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupQuitAck *
-       mesg_group_quit_ack__unpack
+MesgChatRecallAck *
+       mesg_chat_recall_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupQuitAck *)
-     protobuf_c_message_unpack (&mesg_group_quit_ack__descriptor,
+  return (MesgChatRecallAck *)
+     protobuf_c_message_unpack (&mesg_chat_recall_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_quit_ack__free_unpacked
-                     (MesgGroupQuitAck *message,
+void   mesg_chat_recall_ack__free_unpacked
+                     (MesgChatRecallAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_chat_recall_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_invite__init
-                     (MesgGroupInvite         *message)
+void   mesg_group_creat__init
+                     (MesgGroupCreat         *message)
 {
-  static MesgGroupInvite init_value = MESG_GROUP_INVITE__INIT;
+  static MesgGroupCreat init_value = MESG_GROUP_CREAT__INIT;
   *message = init_value;
 }
-size_t mesg_group_invite__get_packed_size
-                     (const MesgGroupInvite *message)
+size_t mesg_group_creat__get_packed_size
+                     (const MesgGroupCreat *message)
 {
-  assert(message->base.descriptor == &mesg_group_invite__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_invite__pack
-                     (const MesgGroupInvite *message,
+size_t mesg_group_creat__pack
+                     (const MesgGroupCreat *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_invite__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_invite__pack_to_buffer
-                     (const MesgGroupInvite *message,
+size_t mesg_group_creat__pack_to_buffer
+                     (const MesgGroupCreat *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_invite__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupInvite *
-       mesg_group_invite__unpack
+MesgGroupCreat *
+       mesg_group_creat__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupInvite *)
-     protobuf_c_message_unpack (&mesg_group_invite__descriptor,
+  return (MesgGroupCreat *)
+     protobuf_c_message_unpack (&mesg_group_creat__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_invite__free_unpacked
-                     (MesgGroupInvite *message,
+void   mesg_group_creat__free_unpacked
+                     (MesgGroupCreat *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_invite__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_invite_ack__init
-                     (MesgGroupInviteAck         *message)
+void   mesg_group_creat_ack__init
+                     (MesgGroupCreatAck         *message)
 {
-  static MesgGroupInviteAck init_value = MESG_GROUP_INVITE_ACK__INIT;
+  static MesgGroupCreatAck init_value = MESG_GROUP_CREAT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_invite_ack__get_packed_size
-                     (const MesgGroupInviteAck *message)
+size_t mesg_group_creat_ack__get_packed_size
+                     (const MesgGroupCreatAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_invite_ack__pack
-                     (const MesgGroupInviteAck *message,
+size_t mesg_group_creat_ack__pack
+                     (const MesgGroupCreatAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_invite_ack__pack_to_buffer
-                     (const MesgGroupInviteAck *message,
+size_t mesg_group_creat_ack__pack_to_buffer
+                     (const MesgGroupCreatAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupInviteAck *
-       mesg_group_invite_ack__unpack
+MesgGroupCreatAck *
+       mesg_group_creat_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupInviteAck *)
-     protobuf_c_message_unpack (&mesg_group_invite_ack__descriptor,
+  return (MesgGroupCreatAck *)
+     protobuf_c_message_unpack (&mesg_group_creat_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_invite_ack__free_unpacked
-                     (MesgGroupInviteAck *message,
+void   mesg_group_creat_ack__free_unpacked
+                     (MesgGroupCreatAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_creat_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_chat__init
-                     (MesgGroupChat         *message)
+void   mesg_group_dismiss__init
+                     (MesgGroupDismiss         *message)
 {
-  static MesgGroupChat init_value = MESG_GROUP_CHAT__INIT;
+  static MesgGroupDismiss init_value = MESG_GROUP_DISMISS__INIT;
   *message = init_value;
 }
-size_t mesg_group_chat__get_packed_size
-                     (const MesgGroupChat *message)
+size_t mesg_group_dismiss__get_packed_size
+                     (const MesgGroupDismiss *message)
 {
-  assert(message->base.descriptor == &mesg_group_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_chat__pack
-                     (const MesgGroupChat *message,
+size_t mesg_group_dismiss__pack
+                     (const MesgGroupDismiss *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_chat__pack_to_buffer
-                     (const MesgGroupChat *message,
+size_t mesg_group_dismiss__pack_to_buffer
+                     (const MesgGroupDismiss *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupChat *
-       mesg_group_chat__unpack
+MesgGroupDismiss *
+       mesg_group_dismiss__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupChat *)
-     protobuf_c_message_unpack (&mesg_group_chat__descriptor,
+  return (MesgGroupDismiss *)
+     protobuf_c_message_unpack (&mesg_group_dismiss__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_chat__free_unpacked
-                     (MesgGroupChat *message,
+void   mesg_group_dismiss__free_unpacked
+                     (MesgGroupDismiss *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_chat_ack__init
-                     (MesgGroupChatAck         *message)
+void   mesg_group_dismiss_ack__init
+                     (MesgGroupDismissAck         *message)
 {
-  static MesgGroupChatAck init_value = MESG_GROUP_CHAT_ACK__INIT;
+  static MesgGroupDismissAck init_value = MESG_GROUP_DISMISS_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_chat_ack__get_packed_size
-                     (const MesgGroupChatAck *message)
+size_t mesg_group_dismiss_ack__get_packed_size
+                     (const MesgGroupDismissAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_chat_ack__pack
-                     (const MesgGroupChatAck *message,
+size_t mesg_group_dismiss_ack__pack
+                     (const MesgGroupDismissAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_chat_ack__pack_to_buffer
-                     (const MesgGroupChatAck *message,
+size_t mesg_group_dismiss_ack__pack_to_buffer
+                     (const MesgGroupDismissAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupChatAck *
-       mesg_group_chat_ack__unpack
+MesgGroupDismissAck *
+       mesg_group_dismiss_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupChatAck *)
-     protobuf_c_message_unpack (&mesg_group_chat_ack__descriptor,
+  return (MesgGroupDismissAck *)
+     protobuf_c_message_unpack (&mesg_group_dismiss_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_chat_ack__free_unpacked
-                     (MesgGroupChatAck *message,
+void   mesg_group_dismiss_ack__free_unpacked
+                     (MesgGroupDismissAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_kick__init
-                     (MesgGroupKick         *message)
+void   mesg_group_join__init
+                     (MesgGroupJoin         *message)
 {
-  static MesgGroupKick init_value = MESG_GROUP_KICK__INIT;
+  static MesgGroupJoin init_value = MESG_GROUP_JOIN__INIT;
   *message = init_value;
 }
-size_t mesg_group_kick__get_packed_size
-                     (const MesgGroupKick *message)
+size_t mesg_group_join__get_packed_size
+                     (const MesgGroupJoin *message)
 {
-  assert(message->base.descriptor == &mesg_group_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_join__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_kick__pack
-                     (const MesgGroupKick *message,
+size_t mesg_group_join__pack
+                     (const MesgGroupJoin *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_join__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_kick__pack_to_buffer
-                     (const MesgGroupKick *message,
+size_t mesg_group_join__pack_to_buffer
+                     (const MesgGroupJoin *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_join__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupKick *
-       mesg_group_kick__unpack
+MesgGroupJoin *
+       mesg_group_join__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupKick *)
-     protobuf_c_message_unpack (&mesg_group_kick__descriptor,
+  return (MesgGroupJoin *)
+     protobuf_c_message_unpack (&mesg_group_join__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_kick__free_unpacked
-                     (MesgGroupKick *message,
+void   mesg_group_join__free_unpacked
+                     (MesgGroupJoin *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_join__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_kick_ack__init
-                     (MesgGroupKickAck         *message)
+void   mesg_group_join_ack__init
+                     (MesgGroupJoinAck         *message)
 {
-  static MesgGroupKickAck init_value = MESG_GROUP_KICK_ACK__INIT;
+  static MesgGroupJoinAck init_value = MESG_GROUP_JOIN_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_kick_ack__get_packed_size
-                     (const MesgGroupKickAck *message)
+size_t mesg_group_join_ack__get_packed_size
+                     (const MesgGroupJoinAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_kick_ack__pack
-                     (const MesgGroupKickAck *message,
+size_t mesg_group_join_ack__pack
+                     (const MesgGroupJoinAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_kick_ack__pack_to_buffer
-                     (const MesgGroupKickAck *message,
+size_t mesg_group_join_ack__pack_to_buffer
+                     (const MesgGroupJoinAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupKickAck *
-       mesg_group_kick_ack__unpack
+MesgGroupJoinAck *
+       mesg_group_join_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupKickAck *)
-     protobuf_c_message_unpack (&mesg_group_kick_ack__descriptor,
+  return (MesgGroupJoinAck *)
+     protobuf_c_message_unpack (&mesg_group_join_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_kick_ack__free_unpacked
-                     (MesgGroupKickAck *message,
+void   mesg_group_join_ack__free_unpacked
+                     (MesgGroupJoinAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_add__init
-                     (MesgGroupGagAdd         *message)
+void   mesg_group_quit__init
+                     (MesgGroupQuit         *message)
 {
-  static MesgGroupGagAdd init_value = MESG_GROUP_GAG_ADD__INIT;
+  static MesgGroupQuit init_value = MESG_GROUP_QUIT__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_add__get_packed_size
-                     (const MesgGroupGagAdd *message)
+size_t mesg_group_quit__get_packed_size
+                     (const MesgGroupQuit *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_add__pack
-                     (const MesgGroupGagAdd *message,
+size_t mesg_group_quit__pack
+                     (const MesgGroupQuit *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_add__pack_to_buffer
-                     (const MesgGroupGagAdd *message,
+size_t mesg_group_quit__pack_to_buffer
+                     (const MesgGroupQuit *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagAdd *
-       mesg_group_gag_add__unpack
+MesgGroupQuit *
+       mesg_group_quit__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagAdd *)
-     protobuf_c_message_unpack (&mesg_group_gag_add__descriptor,
+  return (MesgGroupQuit *)
+     protobuf_c_message_unpack (&mesg_group_quit__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_add__free_unpacked
-                     (MesgGroupGagAdd *message,
+void   mesg_group_quit__free_unpacked
+                     (MesgGroupQuit *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_add_ack__init
-                     (MesgGroupGagAddAck         *message)
+void   mesg_group_quit_ack__init
+                     (MesgGroupQuitAck         *message)
 {
-  static MesgGroupGagAddAck init_value = MESG_GROUP_GAG_ADD_ACK__INIT;
+  static MesgGroupQuitAck init_value = MESG_GROUP_QUIT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_add_ack__get_packed_size
-                     (const MesgGroupGagAddAck *message)
+size_t mesg_group_quit_ack__get_packed_size
+                     (const MesgGroupQuitAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_add_ack__pack
-                     (const MesgGroupGagAddAck *message,
+size_t mesg_group_quit_ack__pack
+                     (const MesgGroupQuitAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_add_ack__pack_to_buffer
-                     (const MesgGroupGagAddAck *message,
+size_t mesg_group_quit_ack__pack_to_buffer
+                     (const MesgGroupQuitAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagAddAck *
-       mesg_group_gag_add_ack__unpack
+MesgGroupQuitAck *
+       mesg_group_quit_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagAddAck *)
-     protobuf_c_message_unpack (&mesg_group_gag_add_ack__descriptor,
+  return (MesgGroupQuitAck *)
+     protobuf_c_message_unpack (&mesg_group_quit_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_add_ack__free_unpacked
-                     (MesgGroupGagAddAck *message,
+void   mesg_group_quit_ack__free_unpacked
+                     (MesgGroupQuitAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_del__init
-                     (MesgGroupGagDel         *message)
+void   mesg_group_invite__init
+                     (MesgGroupInvite         *message)
 {
-  static MesgGroupGagDel init_value = MESG_GROUP_GAG_DEL__INIT;
+  static MesgGroupInvite init_value = MESG_GROUP_INVITE__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_del__get_packed_size
-                     (const MesgGroupGagDel *message)
+size_t mesg_group_invite__get_packed_size
+                     (const MesgGroupInvite *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_del__pack
-                     (const MesgGroupGagDel *message,
+size_t mesg_group_invite__pack
+                     (const MesgGroupInvite *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_del__pack_to_buffer
-                     (const MesgGroupGagDel *message,
+size_t mesg_group_invite__pack_to_buffer
+                     (const MesgGroupInvite *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagDel *
-       mesg_group_gag_del__unpack
+MesgGroupInvite *
+       mesg_group_invite__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagDel *)
-     protobuf_c_message_unpack (&mesg_group_gag_del__descriptor,
+  return (MesgGroupInvite *)
+     protobuf_c_message_unpack (&mesg_group_invite__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_del__free_unpacked
-                     (MesgGroupGagDel *message,
+void   mesg_group_invite__free_unpacked
+                     (MesgGroupInvite *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_del_ack__init
-                     (MesgGroupGagDelAck         *message)
+void   mesg_group_invite_ack__init
+                     (MesgGroupInviteAck         *message)
 {
-  static MesgGroupGagDelAck init_value = MESG_GROUP_GAG_DEL_ACK__INIT;
+  static MesgGroupInviteAck init_value = MESG_GROUP_INVITE_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_del_ack__get_packed_size
-                     (const MesgGroupGagDelAck *message)
+size_t mesg_group_invite_ack__get_packed_size
+                     (const MesgGroupInviteAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_del_ack__pack
-                     (const MesgGroupGagDelAck *message,
+size_t mesg_group_invite_ack__pack
+                     (const MesgGroupInviteAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_del_ack__pack_to_buffer
-                     (const MesgGroupGagDelAck *message,
+size_t mesg_group_invite_ack__pack_to_buffer
+                     (const MesgGroupInviteAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagDelAck *
-       mesg_group_gag_del_ack__unpack
+MesgGroupInviteAck *
+       mesg_group_invite_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagDelAck *)
-     protobuf_c_message_unpack (&mesg_group_gag_del_ack__descriptor,
+  return (MesgGroupInviteAck *)
+     protobuf_c_message_unpack (&mesg_group_invite_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_del_ack__free_unpacked
-                     (MesgGroupGagDelAck *message,
+void   mesg_group_invite_ack__free_unpacked
+                     (MesgGroupInviteAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_invite_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_add__init
-                     (MesgGroupBlAdd         *message)
+void   mesg_group_chat__init
+                     (MesgGroupChat         *message)
 {
-  static MesgGroupBlAdd init_value = MESG_GROUP_BL_ADD__INIT;
+  static MesgGroupChat init_value = MESG_GROUP_CHAT__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_add__get_packed_size
-                     (const MesgGroupBlAdd *message)
+size_t mesg_group_chat__get_packed_size
+                     (const MesgGroupChat *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_add__pack
-                     (const MesgGroupBlAdd *message,
+size_t mesg_group_chat__pack
+                     (const MesgGroupChat *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_add__pack_to_buffer
-                     (const MesgGroupBlAdd *message,
+size_t mesg_group_chat__pack_to_buffer
+                     (const MesgGroupChat *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlAdd *
-       mesg_group_bl_add__unpack
+MesgGroupChat *
+       mesg_group_chat__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlAdd *)
-     protobuf_c_message_unpack (&mesg_group_bl_add__descriptor,
+  return (MesgGroupChat *)
+     protobuf_c_message_unpack (&mesg_group_chat__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_add__free_unpacked
-                     (MesgGroupBlAdd *message,
+void   mesg_group_chat__free_unpacked
+                     (MesgGroupChat *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_add_ack__init
-                     (MesgGroupBlAddAck         *message)
+void   mesg_group_chat_ack__init
+                     (MesgGroupChatAck         *message)
 {
-  static MesgGroupBlAddAck init_value = MESG_GROUP_BL_ADD_ACK__INIT;
+  static MesgGroupChatAck init_value = MESG_GROUP_CHAT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_add_ack__get_packed_size
-                     (const MesgGroupBlAddAck *message)
+size_t mesg_group_chat_ack__get_packed_size
+                     (const MesgGroupChatAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_add_ack__pack
-                     (const MesgGroupBlAddAck *message,
+size_t mesg_group_chat_ack__pack
+                     (const MesgGroupChatAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_add_ack__pack_to_buffer
-                     (const MesgGroupBlAddAck *message,
+size_t mesg_group_chat_ack__pack_to_buffer
+                     (const MesgGroupChatAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlAddAck *
-       mesg_group_bl_add_ack__unpack
+MesgGroupChatAck *
+       mesg_group_chat_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlAddAck *)
-     protobuf_c_message_unpack (&mesg_group_bl_add_ack__descriptor,
+  return (MesgGroupChatAck *)
+     protobuf_c_message_unpack (&mesg_group_chat_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_add_ack__free_unpacked
-                     (MesgGroupBlAddAck *message,
+void   mesg_group_chat_ack__free_unpacked
+                     (MesgGroupChatAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_chat_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_del__init
-                     (MesgGroupBlDel         *message)
+void   mesg_group_kick__init
+                     (MesgGroupKick         *message)
 {
-  static MesgGroupBlDel init_value = MESG_GROUP_BL_DEL__INIT;
+  static MesgGroupKick init_value = MESG_GROUP_KICK__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_del__get_packed_size
-                     (const MesgGroupBlDel *message)
+size_t mesg_group_kick__get_packed_size
+                     (const MesgGroupKick *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_del__pack
-                     (const MesgGroupBlDel *message,
+size_t mesg_group_kick__pack
+                     (const MesgGroupKick *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_del__pack_to_buffer
-                     (const MesgGroupBlDel *message,
+size_t mesg_group_kick__pack_to_buffer
+                     (const MesgGroupKick *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlDel *
-       mesg_group_bl_del__unpack
+MesgGroupKick *
+       mesg_group_kick__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlDel *)
-     protobuf_c_message_unpack (&mesg_group_bl_del__descriptor,
+  return (MesgGroupKick *)
+     protobuf_c_message_unpack (&mesg_group_kick__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_del__free_unpacked
-                     (MesgGroupBlDel *message,
+void   mesg_group_kick__free_unpacked
+                     (MesgGroupKick *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_del_ack__init
-                     (MesgGroupBlDelAck         *message)
+void   mesg_group_kick_ack__init
+                     (MesgGroupKickAck         *message)
 {
-  static MesgGroupBlDelAck init_value = MESG_GROUP_BL_DEL_ACK__INIT;
+  static MesgGroupKickAck init_value = MESG_GROUP_KICK_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_del_ack__get_packed_size
-                     (const MesgGroupBlDelAck *message)
+size_t mesg_group_kick_ack__get_packed_size
+                     (const MesgGroupKickAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_del_ack__pack
-                     (const MesgGroupBlDelAck *message,
+size_t mesg_group_kick_ack__pack
+                     (const MesgGroupKickAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_del_ack__pack_to_buffer
-                     (const MesgGroupBlDelAck *message,
+size_t mesg_group_kick_ack__pack_to_buffer
+                     (const MesgGroupKickAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlDelAck *
-       mesg_group_bl_del_ack__unpack
+MesgGroupKickAck *
+       mesg_group_kick_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlDelAck *)
-     protobuf_c_message_unpack (&mesg_group_bl_del_ack__descriptor,
+  return (MesgGroupKickAck *)
+     protobuf_c_message_unpack (&mesg_group_kick_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_del_ack__free_unpacked
-                     (MesgGroupBlDelAck *message,
+void   mesg_group_kick_ack__free_unpacked
+                     (MesgGroupKickAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_add__init
-                     (MesgGroupMgrAdd         *message)
+void   mesg_group_gag_add__init
+                     (MesgGroupGagAdd         *message)
 {
-  static MesgGroupMgrAdd init_value = MESG_GROUP_MGR_ADD__INIT;
+  static MesgGroupGagAdd init_value = MESG_GROUP_GAG_ADD__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_add__get_packed_size
-                     (const MesgGroupMgrAdd *message)
+size_t mesg_group_gag_add__get_packed_size
+                     (const MesgGroupGagAdd *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_add__pack
-                     (const MesgGroupMgrAdd *message,
+size_t mesg_group_gag_add__pack
+                     (const MesgGroupGagAdd *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_add__pack_to_buffer
-                     (const MesgGroupMgrAdd *message,
+size_t mesg_group_gag_add__pack_to_buffer
+                     (const MesgGroupGagAdd *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrAdd *
-       mesg_group_mgr_add__unpack
+MesgGroupGagAdd *
+       mesg_group_gag_add__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrAdd *)
-     protobuf_c_message_unpack (&mesg_group_mgr_add__descriptor,
+  return (MesgGroupGagAdd *)
+     protobuf_c_message_unpack (&mesg_group_gag_add__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_add__free_unpacked
-                     (MesgGroupMgrAdd *message,
+void   mesg_group_gag_add__free_unpacked
+                     (MesgGroupGagAdd *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_add_ack__init
-                     (MesgGroupMgrAddAck         *message)
+void   mesg_group_gag_add_ack__init
+                     (MesgGroupGagAddAck         *message)
 {
-  static MesgGroupMgrAddAck init_value = MESG_GROUP_MGR_ADD_ACK__INIT;
+  static MesgGroupGagAddAck init_value = MESG_GROUP_GAG_ADD_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_add_ack__get_packed_size
-                     (const MesgGroupMgrAddAck *message)
+size_t mesg_group_gag_add_ack__get_packed_size
+                     (const MesgGroupGagAddAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_add_ack__pack
-                     (const MesgGroupMgrAddAck *message,
+size_t mesg_group_gag_add_ack__pack
+                     (const MesgGroupGagAddAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_add_ack__pack_to_buffer
-                     (const MesgGroupMgrAddAck *message,
+size_t mesg_group_gag_add_ack__pack_to_buffer
+                     (const MesgGroupGagAddAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrAddAck *
-       mesg_group_mgr_add_ack__unpack
+MesgGroupGagAddAck *
+       mesg_group_gag_add_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrAddAck *)
-     protobuf_c_message_unpack (&mesg_group_mgr_add_ack__descriptor,
+  return (MesgGroupGagAddAck *)
+     protobuf_c_message_unpack (&mesg_group_gag_add_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_add_ack__free_unpacked
-                     (MesgGroupMgrAddAck *message,
+void   mesg_group_gag_add_ack__free_unpacked
+                     (MesgGroupGagAddAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_del__init
-                     (MesgGroupMgrDel         *message)
+void   mesg_group_gag_del__init
+                     (MesgGroupGagDel         *message)
 {
-  static MesgGroupMgrDel init_value = MESG_GROUP_MGR_DEL__INIT;
+  static MesgGroupGagDel init_value = MESG_GROUP_GAG_DEL__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_del__get_packed_size
-                     (const MesgGroupMgrDel *message)
+size_t mesg_group_gag_del__get_packed_size
+                     (const MesgGroupGagDel *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_del__pack
-                     (const MesgGroupMgrDel *message,
+size_t mesg_group_gag_del__pack
+                     (const MesgGroupGagDel *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_del__pack_to_buffer
-                     (const MesgGroupMgrDel *message,
+size_t mesg_group_gag_del__pack_to_buffer
+                     (const MesgGroupGagDel *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrDel *
-       mesg_group_mgr_del__unpack
+MesgGroupGagDel *
+       mesg_group_gag_del__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrDel *)
-     protobuf_c_message_unpack (&mesg_group_mgr_del__descriptor,
+  return (MesgGroupGagDel *)
+     protobuf_c_message_unpack (&mesg_group_gag_del__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_del__free_unpacked
-                     (MesgGroupMgrDel *message,
+void   mesg_group_gag_del__free_unpacked
+                     (MesgGroupGagDel *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_del_ack__init
-                     (MesgGroupMgrDelAck         *message)
+void   mesg_group_gag_del_ack__init
+                     (MesgGroupGagDelAck         *message)
 {
-  static MesgGroupMgrDelAck init_value = MESG_GROUP_MGR_DEL_ACK__INIT;
+  static MesgGroupGagDelAck init_value = MESG_GROUP_GAG_DEL_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_del_ack__get_packed_size
-                     (const MesgGroupMgrDelAck *message)
+size_t mesg_group_gag_del_ack__get_packed_size
+                     (const MesgGroupGagDelAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_del_ack__pack
-                     (const MesgGroupMgrDelAck *message,
+size_t mesg_group_gag_del_ack__pack
+                     (const MesgGroupGagDelAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_del_ack__pack_to_buffer
-                     (const MesgGroupMgrDelAck *message,
+size_t mesg_group_gag_del_ack__pack_to_buffer
+                     (const MesgGroupGagDelAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrDelAck *
-       mesg_group_mgr_del_ack__unpack
+MesgGroupGagDelAck *
+       mesg_group_gag_del_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrDelAck *)
-     protobuf_c_message_unpack (&mesg_group_mgr_del_ack__descriptor,
+  return (MesgGroupGagDelAck *)
+     protobuf_c_message_unpack (&mesg_group_gag_del_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_del_ack__free_unpacked
-                     (MesgGroupMgrDelAck *message,
+void   mesg_group_gag_del_ack__free_unpacked
+                     (MesgGroupGagDelAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_usr_list__init
-                     (MesgGroupUsrList         *message)
+void   mesg_group_bl_add__init
+                     (MesgGroupBlAdd         *message)
 {
-  static MesgGroupUsrList init_value = MESG_GROUP_USR_LIST__INIT;
+  static MesgGroupBlAdd init_value = MESG_GROUP_BL_ADD__INIT;
   *message = init_value;
 }
-size_t mesg_group_usr_list__get_packed_size
-                     (const MesgGroupUsrList *message)
+size_t mesg_group_bl_add__get_packed_size
+                     (const MesgGroupBlAdd *message)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_usr_list__pack
-                     (const MesgGroupUsrList *message,
+size_t mesg_group_bl_add__pack
+                     (const MesgGroupBlAdd *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_usr_list__pack_to_buffer
-                     (const MesgGroupUsrList *message,
+size_t mesg_group_bl_add__pack_to_buffer
+                     (const MesgGroupBlAdd *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupUsrList *
-       mesg_group_usr_list__unpack
+MesgGroupBlAdd *
+       mesg_group_bl_add__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupUsrList *)
-     protobuf_c_message_unpack (&mesg_group_usr_list__descriptor,
+  return (MesgGroupBlAdd *)
+     protobuf_c_message_unpack (&mesg_group_bl_add__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_usr_list__free_unpacked
-                     (MesgGroupUsrList *message,
+void   mesg_group_bl_add__free_unpacked
+                     (MesgGroupBlAdd *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_usr_list_ack__init
-                     (MesgGroupUsrListAck         *message)
+void   mesg_group_bl_add_ack__init
+                     (MesgGroupBlAddAck         *message)
 {
-  static MesgGroupUsrListAck init_value = MESG_GROUP_USR_LIST_ACK__INIT;
+  static MesgGroupBlAddAck init_value = MESG_GROUP_BL_ADD_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_usr_list_ack__get_packed_size
-                     (const MesgGroupUsrListAck *message)
+size_t mesg_group_bl_add_ack__get_packed_size
+                     (const MesgGroupBlAddAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_usr_list_ack__pack
-                     (const MesgGroupUsrListAck *message,
+size_t mesg_group_bl_add_ack__pack
+                     (const MesgGroupBlAddAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_usr_list_ack__pack_to_buffer
-                     (const MesgGroupUsrListAck *message,
+size_t mesg_group_bl_add_ack__pack_to_buffer
+                     (const MesgGroupBlAddAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupUsrListAck *
-       mesg_group_usr_list_ack__unpack
+MesgGroupBlAddAck *
+       mesg_group_bl_add_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupUsrListAck *)
-     protobuf_c_message_unpack (&mesg_group_usr_list_ack__descriptor,
+  return (MesgGroupBlAddAck *)
+     protobuf_c_message_unpack (&mesg_group_bl_add_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_usr_list_ack__free_unpacked
-                     (MesgGroupUsrListAck *message,
+void   mesg_group_bl_add_ack__free_unpacked
+                     (MesgGroupBlAddAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_join_ntf__init
-                     (MesgGroupJoinNtf         *message)
+void   mesg_group_bl_del__init
+                     (MesgGroupBlDel         *message)
 {
-  static MesgGroupJoinNtf init_value = MESG_GROUP_JOIN_NTF__INIT;
+  static MesgGroupBlDel init_value = MESG_GROUP_BL_DEL__INIT;
   *message = init_value;
 }
-size_t mesg_group_join_ntf__get_packed_size
-                     (const MesgGroupJoinNtf *message)
+size_t mesg_group_bl_del__get_packed_size
+                     (const MesgGroupBlDel *message)
 {
-  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_join_ntf__pack
-                     (const MesgGroupJoinNtf *message,
+size_t mesg_group_bl_del__pack
+                     (const MesgGroupBlDel *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_join_ntf__pack_to_buffer
-                     (const MesgGroupJoinNtf *message,
+size_t mesg_group_bl_del__pack_to_buffer
+                     (const MesgGroupBlDel *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupJoinNtf *
-       mesg_group_join_ntf__unpack
+MesgGroupBlDel *
+       mesg_group_bl_del__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupJoinNtf *)
-     protobuf_c_message_unpack (&mesg_group_join_ntf__descriptor,
+  return (MesgGroupBlDel *)
+     protobuf_c_message_unpack (&mesg_group_bl_del__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_join_ntf__free_unpacked
-                     (MesgGroupJoinNtf *message,
+void   mesg_group_bl_del__free_unpacked
+                     (MesgGroupBlDel *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_quit_ntf__init
-                     (MesgGroupQuitNtf         *message)
+void   mesg_group_bl_del_ack__init
+                     (MesgGroupBlDelAck         *message)
 {
-  static MesgGroupQuitNtf init_value = MESG_GROUP_QUIT_NTF__INIT;
+  static MesgGroupBlDelAck init_value = MESG_GROUP_BL_DEL_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_quit_ntf__get_packed_size
-                     (const MesgGroupQuitNtf *message)
+size_t mesg_group_bl_del_ack__get_packed_size
+                     (const MesgGroupBlDelAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_quit_ntf__pack
-                     (const MesgGroupQuitNtf *message,
+size_t mesg_group_bl_del_ack__pack
+                     (const MesgGroupBlDelAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_quit_ntf__pack_to_buffer
-                     (const MesgGroupQuitNtf *message,
+size_t mesg_group_bl_del_ack__pack_to_buffer
+                     (const MesgGroupBlDelAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupQuitNtf *
-       mesg_group_quit_ntf__unpack
+MesgGroupBlDelAck *
+       mesg_group_bl_del_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupQuitNtf *)
-     protobuf_c_message_unpack (&mesg_group_quit_ntf__descriptor,
+  return (MesgGroupBlDelAck *)
+     protobuf_c_message_unpack (&mesg_group_bl_del_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_quit_ntf__free_unpacked
-                     (MesgGroupQuitNtf *message,
+void   mesg_group_bl_del_ack__free_unpacked
+                     (MesgGroupBlDelAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_kick_ntf__init
-                     (MesgGroupKickNtf         *message)
+void   mesg_group_mgr_add__init
+                     (MesgGroupMgrAdd         *message)
 {
-  static MesgGroupKickNtf init_value = MESG_GROUP_KICK_NTF__INIT;
+  static MesgGroupMgrAdd init_value = MESG_GROUP_MGR_ADD__INIT;
   *message = init_value;
 }
-size_t mesg_group_kick_ntf__get_packed_size
-                     (const MesgGroupKickNtf *message)
+size_t mesg_group_mgr_add__get_packed_size
+                     (const MesgGroupMgrAdd *message)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_kick_ntf__pack
-                     (const MesgGroupKickNtf *message,
+size_t mesg_group_mgr_add__pack
+                     (const MesgGroupMgrAdd *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_kick_ntf__pack_to_buffer
-                     (const MesgGroupKickNtf *message,
+size_t mesg_group_mgr_add__pack_to_buffer
+                     (const MesgGroupMgrAdd *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupKickNtf *
-       mesg_group_kick_ntf__unpack
+MesgGroupMgrAdd *
+       mesg_group_mgr_add__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupKickNtf *)
-     protobuf_c_message_unpack (&mesg_group_kick_ntf__descriptor,
+  return (MesgGroupMgrAdd *)
+     protobuf_c_message_unpack (&mesg_group_mgr_add__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_kick_ntf__free_unpacked
-                     (MesgGroupKickNtf *message,
+void   mesg_group_mgr_add__free_unpacked
+                     (MesgGroupMgrAdd *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_add_ntf__init
-                     (MesgGroupGagAddNtf         *message)
+void   mesg_group_mgr_add_ack__init
+                     (MesgGroupMgrAddAck         *message)
 {
-  static MesgGroupGagAddNtf init_value = MESG_GROUP_GAG_ADD_NTF__INIT;
+  static MesgGroupMgrAddAck init_value = MESG_GROUP_MGR_ADD_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_add_ntf__get_packed_size
-                     (const MesgGroupGagAddNtf *message)
+size_t mesg_group_mgr_add_ack__get_packed_size
+                     (const MesgGroupMgrAddAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_add_ntf__pack
-                     (const MesgGroupGagAddNtf *message,
+size_t mesg_group_mgr_add_ack__pack
+                     (const MesgGroupMgrAddAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_add_ntf__pack_to_buffer
-                     (const MesgGroupGagAddNtf *message,
+size_t mesg_group_mgr_add_ack__pack_to_buffer
+                     (const MesgGroupMgrAddAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagAddNtf *
-       mesg_group_gag_add_ntf__unpack
+MesgGroupMgrAddAck *
+       mesg_group_mgr_add_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagAddNtf *)
-     protobuf_c_message_unpack (&mesg_group_gag_add_ntf__descriptor,
+  return (MesgGroupMgrAddAck *)
+     protobuf_c_message_unpack (&mesg_group_mgr_add_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_add_ntf__free_unpacked
-                     (MesgGroupGagAddNtf *message,
+void   mesg_group_mgr_add_ack__free_unpacked
+                     (MesgGroupMgrAddAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_gag_del_ntf__init
-                     (MesgGroupGagDelNtf         *message)
+void   mesg_group_mgr_del__init
+                     (MesgGroupMgrDel         *message)
 {
-  static MesgGroupGagDelNtf init_value = MESG_GROUP_GAG_DEL_NTF__INIT;
+  static MesgGroupMgrDel init_value = MESG_GROUP_MGR_DEL__INIT;
   *message = init_value;
 }
-size_t mesg_group_gag_del_ntf__get_packed_size
-                     (const MesgGroupGagDelNtf *message)
+size_t mesg_group_mgr_del__get_packed_size
+                     (const MesgGroupMgrDel *message)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_gag_del_ntf__pack
-                     (const MesgGroupGagDelNtf *message,
+size_t mesg_group_mgr_del__pack
+                     (const MesgGroupMgrDel *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_gag_del_ntf__pack_to_buffer
-                     (const MesgGroupGagDelNtf *message,
+size_t mesg_group_mgr_del__pack_to_buffer
+                     (const MesgGroupMgrDel *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupGagDelNtf *
-       mesg_group_gag_del_ntf__unpack
+MesgGroupMgrDel *
+       mesg_group_mgr_del__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupGagDelNtf *)
-     protobuf_c_message_unpack (&mesg_group_gag_del_ntf__descriptor,
+  return (MesgGroupMgrDel *)
+     protobuf_c_message_unpack (&mesg_group_mgr_del__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_gag_del_ntf__free_unpacked
-                     (MesgGroupGagDelNtf *message,
+void   mesg_group_mgr_del__free_unpacked
+                     (MesgGroupMgrDel *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_add_ntf__init
-                     (MesgGroupBlAddNtf         *message)
+void   mesg_group_mgr_del_ack__init
+                     (MesgGroupMgrDelAck         *message)
 {
-  static MesgGroupBlAddNtf init_value = MESG_GROUP_BL_ADD_NTF__INIT;
+  static MesgGroupMgrDelAck init_value = MESG_GROUP_MGR_DEL_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_add_ntf__get_packed_size
-                     (const MesgGroupBlAddNtf *message)
+size_t mesg_group_mgr_del_ack__get_packed_size
+                     (const MesgGroupMgrDelAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_add_ntf__pack
-                     (const MesgGroupBlAddNtf *message,
+size_t mesg_group_mgr_del_ack__pack
+                     (const MesgGroupMgrDelAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_add_ntf__pack_to_buffer
-                     (const MesgGroupBlAddNtf *message,
+size_t mesg_group_mgr_del_ack__pack_to_buffer
+                     (const MesgGroupMgrDelAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlAddNtf *
-       mesg_group_bl_add_ntf__unpack
+MesgGroupMgrDelAck *
+       mesg_group_mgr_del_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlAddNtf *)
-     protobuf_c_message_unpack (&mesg_group_bl_add_ntf__descriptor,
+  return (MesgGroupMgrDelAck *)
+     protobuf_c_message_unpack (&mesg_group_mgr_del_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_add_ntf__free_unpacked
-                     (MesgGroupBlAddNtf *message,
+void   mesg_group_mgr_del_ack__free_unpacked
+                     (MesgGroupMgrDelAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_bl_del_ntf__init
-                     (MesgGroupBlDelNtf         *message)
+void   mesg_group_usr_list__init
+                     (MesgGroupUsrList         *message)
 {
-  static MesgGroupBlDelNtf init_value = MESG_GROUP_BL_DEL_NTF__INIT;
+  static MesgGroupUsrList init_value = MESG_GROUP_USR_LIST__INIT;
   *message = init_value;
 }
-size_t mesg_group_bl_del_ntf__get_packed_size
-                     (const MesgGroupBlDelNtf *message)
+size_t mesg_group_usr_list__get_packed_size
+                     (const MesgGroupUsrList *message)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_bl_del_ntf__pack
-                     (const MesgGroupBlDelNtf *message,
+size_t mesg_group_usr_list__pack
+                     (const MesgGroupUsrList *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_bl_del_ntf__pack_to_buffer
-                     (const MesgGroupBlDelNtf *message,
+size_t mesg_group_usr_list__pack_to_buffer
+                     (const MesgGroupUsrList *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupBlDelNtf *
-       mesg_group_bl_del_ntf__unpack
+MesgGroupUsrList *
+       mesg_group_usr_list__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupBlDelNtf *)
-     protobuf_c_message_unpack (&mesg_group_bl_del_ntf__descriptor,
+  return (MesgGroupUsrList *)
+     protobuf_c_message_unpack (&mesg_group_usr_list__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_bl_del_ntf__free_unpacked
-                     (MesgGroupBlDelNtf *message,
+void   mesg_group_usr_list__free_unpacked
+                     (MesgGroupUsrList *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_add_ntf__init
-                     (MesgGroupMgrAddNtf         *message)
+void   mesg_group_usr_list_ack__init
+                     (MesgGroupUsrListAck         *message)
 {
-  static MesgGroupMgrAddNtf init_value = MESG_GROUP_MGR_ADD_NTF__INIT;
+  static MesgGroupUsrListAck init_value = MESG_GROUP_USR_LIST_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_add_ntf__get_packed_size
-                     (const MesgGroupMgrAddNtf *message)
+size_t mesg_group_usr_list_ack__get_packed_size
+                     (const MesgGroupUsrListAck *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_add_ntf__pack
-                     (const MesgGroupMgrAddNtf *message,
+size_t mesg_group_usr_list_ack__pack
+                     (const MesgGroupUsrListAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_add_ntf__pack_to_buffer
-                     (const MesgGroupMgrAddNtf *message,
+size_t mesg_group_usr_list_ack__pack_to_buffer
+                     (const MesgGroupUsrListAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrAddNtf *
-       mesg_group_mgr_add_ntf__unpack
+MesgGroupUsrListAck *
+       mesg_group_usr_list_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrAddNtf *)
-     protobuf_c_message_unpack (&mesg_group_mgr_add_ntf__descriptor,
+  return (MesgGroupUsrListAck *)
+     protobuf_c_message_unpack (&mesg_group_usr_list_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_add_ntf__free_unpacked
-                     (MesgGroupMgrAddNtf *message,
+void   mesg_group_usr_list_ack__free_unpacked
+                     (MesgGroupUsrListAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_usr_list_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_group_mgr_del_ntf__init
-                     (MesgGroupMgrDelNtf         *message)
+void   mesg_group_join_audit__init
+                     (MesgGroupJoinAudit         *message)
 {
-  static MesgGroupMgrDelNtf init_value = MESG_GROUP_MGR_DEL_NTF__INIT;
+  static MesgGroupJoinAudit init_value = MESG_GROUP_JOIN_AUDIT__INIT;
   *message = init_value;
 }
-size_t mesg_group_mgr_del_ntf__get_packed_size
-                     (const MesgGroupMgrDelNtf *message)
+size_t mesg_group_join_audit__get_packed_size
+                     (const MesgGroupJoinAudit *message)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_group_mgr_del_ntf__pack
-                     (const MesgGroupMgrDelNtf *message,
+size_t mesg_group_join_audit__pack
+                     (const MesgGroupJoinAudit *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_group_mgr_del_ntf__pack_to_buffer
-                     (const MesgGroupMgrDelNtf *message,
+size_t mesg_group_join_audit__pack_to_buffer
+                     (const MesgGroupJoinAudit *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgGroupMgrDelNtf *
-       mesg_group_mgr_del_ntf__unpack
+MesgGroupJoinAudit *
+       mesg_group_join_audit__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgGroupMgrDelNtf *)
-     protobuf_c_message_unpack (&mesg_group_mgr_del_ntf__descriptor,
+  return (MesgGroupJoinAudit *)
+     protobuf_c_message_unpack (&mesg_group_join_audit__descriptor,
                                 allocator, len, data);
 }
-void   mesg_group_mgr_del_ntf__free_unpacked
-                     (MesgGroupMgrDelNtf *message,
+void   mesg_group_join_audit__free_unpacked
+                     (MesgGroupJoinAudit *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_creat__init
-                     (MesgRoomCreat         *message)
+void   mesg_group_join_audit_ack__init
+                     (MesgGroupJoinAuditAck         *message)
 {
-  static MesgRoomCreat init_value = MESG_ROOM_CREAT__INIT;
+  static MesgGroupJoinAuditAck init_value = MESG_GROUP_JOIN_AUDIT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_room_creat__get_packed_size
-                     (const MesgRoomCreat *message)
+size_t mesg_group_join_audit_ack__get_packed_size
+                     (const MesgGroupJoinAuditAck *message)
 {
-  assert(message->base.descriptor == &mesg_room_creat__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_creat__pack
-                     (const MesgRoomCreat *message,
+size_t mesg_group_join_audit_ack__pack
+                     (const MesgGroupJoinAuditAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_creat__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_creat__pack_to_buffer
-                     (const MesgRoomCreat *message,
+size_t mesg_group_join_audit_ack__pack_to_buffer
+                     (const MesgGroupJoinAuditAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_creat__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomCreat *
-       mesg_room_creat__unpack
+MesgGroupJoinAuditAck *
+       mesg_group_join_audit_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomCreat *)
-     protobuf_c_message_unpack (&mesg_room_creat__descriptor,
+  return (MesgGroupJoinAuditAck *)
+     protobuf_c_message_unpack (&mesg_group_join_audit_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_creat__free_unpacked
-                     (MesgRoomCreat *message,
+void   mesg_group_join_audit_ack__free_unpacked
+                     (MesgGroupJoinAuditAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_creat__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_audit_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_creat_ack__init
-                     (MesgRoomCreatAck         *message)
+void   mesg_group_recall__init
+                     (MesgGroupRecall         *message)
 {
-  static MesgRoomCreatAck init_value = MESG_ROOM_CREAT_ACK__INIT;
+  static MesgGroupRecall init_value = MESG_GROUP_RECALL__INIT;
   *message = init_value;
 }
-size_t mesg_room_creat_ack__get_packed_size
-                     (const MesgRoomCreatAck *message)
+size_t mesg_group_recall__get_packed_size
+                     (const MesgGroupRecall *message)
 {
-  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_creat_ack__pack
-                     (const MesgRoomCreatAck *message,
+size_t mesg_group_recall__pack
+                     (const MesgGroupRecall *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_creat_ack__pack_to_buffer
-                     (const MesgRoomCreatAck *message,
+size_t mesg_group_recall__pack_to_buffer
+                     (const MesgGroupRecall *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomCreatAck *
-       mesg_room_creat_ack__unpack
+MesgGroupRecall *
+       mesg_group_recall__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomCreatAck *)
-     protobuf_c_message_unpack (&mesg_room_creat_ack__descriptor,
+  return (MesgGroupRecall *)
+     protobuf_c_message_unpack (&mesg_group_recall__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_creat_ack__free_unpacked
-                     (MesgRoomCreatAck *message,
+void   mesg_group_recall__free_unpacked
+                     (MesgGroupRecall *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_dismiss__init
-                     (MesgRoomDismiss         *message)
+void   mesg_group_recall_ack__init
+                     (MesgGroupRecallAck         *message)
 {
-  static MesgRoomDismiss init_value = MESG_ROOM_DISMISS__INIT;
+  static MesgGroupRecallAck init_value = MESG_GROUP_RECALL_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_room_dismiss__get_packed_size
-                     (const MesgRoomDismiss *message)
+size_t mesg_group_recall_ack__get_packed_size
+                     (const MesgGroupRecallAck *message)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_dismiss__pack
-                     (const MesgRoomDismiss *message,
+size_t mesg_group_recall_ack__pack
+                     (const MesgGroupRecallAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_dismiss__pack_to_buffer
-                     (const MesgRoomDismiss *message,
+size_t mesg_group_recall_ack__pack_to_buffer
+                     (const MesgGroupRecallAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomDismiss *
-       mesg_room_dismiss__unpack
+MesgGroupRecallAck *
+       mesg_group_recall_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomDismiss *)
-     protobuf_c_message_unpack (&mesg_room_dismiss__descriptor,
+  return (MesgGroupRecallAck *)
+     protobuf_c_message_unpack (&mesg_group_recall_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_dismiss__free_unpacked
-                     (MesgRoomDismiss *message,
+void   mesg_group_recall_ack__free_unpacked
+                     (MesgGroupRecallAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
+  assert(message->base.descriptor == &mesg_group_recall_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_dismiss_ack__init
-                     (MesgRoomDismissAck         *message)
+void   mesg_group_sync__init
+                     (MesgGroupSync         *message)
 {
-  static MesgRoomDismissAck init_value = MESG_ROOM_DISMISS_ACK__INIT;
+  static MesgGroupSync init_value = MESG_GROUP_SYNC__INIT;
   *message = init_value;
 }
-size_t mesg_room_dismiss_ack__get_packed_size
-                     (const MesgRoomDismissAck *message)
+size_t mesg_group_sync__get_packed_size
+                     (const MesgGroupSync *message)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_dismiss_ack__pack
-                     (const MesgRoomDismissAck *message,
+size_t mesg_group_sync__pack
+                     (const MesgGroupSync *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_dismiss_ack__pack_to_buffer
-                     (const MesgRoomDismissAck *message,
+size_t mesg_group_sync__pack_to_buffer
+                     (const MesgGroupSync *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomDismissAck *
-       mesg_room_dismiss_ack__unpack
+MesgGroupSync *
+       mesg_group_sync__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomDismissAck *)
-     protobuf_c_message_unpack (&mesg_room_dismiss_ack__descriptor,
+  return (MesgGroupSync *)
+     protobuf_c_message_unpack (&mesg_group_sync__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_dismiss_ack__free_unpacked
-                     (MesgRoomDismissAck *message,
+void   mesg_group_sync__free_unpacked
+                     (MesgGroupSync *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_join__init
-                     (MesgRoomJoin         *message)
+void   mesg_group_sync_ack__init
+                     (MesgGroupSyncAck         *message)
 {
-  static MesgRoomJoin init_value = MESG_ROOM_JOIN__INIT;
+  static MesgGroupSyncAck init_value = MESG_GROUP_SYNC_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_room_join__get_packed_size
-                     (const MesgRoomJoin *message)
+size_t mesg_group_sync_ack__get_packed_size
+                     (const MesgGroupSyncAck *message)
 {
-  assert(message->base.descriptor == &mesg_room_join__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_join__pack
-                     (const MesgRoomJoin *message,
+size_t mesg_group_sync_ack__pack
+                     (const MesgGroupSyncAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_join__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_join__pack_to_buffer
-                     (const MesgRoomJoin *message,
+size_t mesg_group_sync_ack__pack_to_buffer
+                     (const MesgGroupSyncAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_join__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomJoin *
-       mesg_room_join__unpack
+MesgGroupSyncAck *
+       mesg_group_sync_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomJoin *)
-     protobuf_c_message_unpack (&mesg_room_join__descriptor,
+  return (MesgGroupSyncAck *)
+     protobuf_c_message_unpack (&mesg_group_sync_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_join__free_unpacked
-                     (MesgRoomJoin *message,
+void   mesg_group_sync_ack__free_unpacked
+                     (MesgGroupSyncAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_join__descriptor);
+  assert(message->base.descriptor == &mesg_group_sync_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_join_ack__init
-                     (MesgRoomJoinAck         *message)
+void   mesg_group_join_ntf__init
+                     (MesgGroupJoinNtf         *message)
 {
-  static MesgRoomJoinAck init_value = MESG_ROOM_JOIN_ACK__INIT;
+  static MesgGroupJoinNtf init_value = MESG_GROUP_JOIN_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_join_ack__get_packed_size
-                     (const MesgRoomJoinAck *message)
+size_t mesg_group_join_ntf__get_packed_size
+                     (const MesgGroupJoinNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_join_ack__pack
-                     (const MesgRoomJoinAck *message,
+size_t mesg_group_join_ntf__pack
+                     (const MesgGroupJoinNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_join_ack__pack_to_buffer
-                     (const MesgRoomJoinAck *message,
+size_t mesg_group_join_ntf__pack_to_buffer
+                     (const MesgGroupJoinNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomJoinAck *
-       mesg_room_join_ack__unpack
+MesgGroupJoinNtf *
+       mesg_group_join_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomJoinAck *)
-     protobuf_c_message_unpack (&mesg_room_join_ack__descriptor,
+  return (MesgGroupJoinNtf *)
+     protobuf_c_message_unpack (&mesg_group_join_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_join_ack__free_unpacked
-                     (MesgRoomJoinAck *message,
+void   mesg_group_join_ntf__free_unpacked
+                     (MesgGroupJoinNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_join_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_quit__init
-                     (MesgRoomQuit         *message)
+void   mesg_group_quit_ntf__init
+                     (MesgGroupQuitNtf         *message)
 {
-  static MesgRoomQuit init_value = MESG_ROOM_QUIT__INIT;
+  static MesgGroupQuitNtf init_value = MESG_GROUP_QUIT_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_quit__get_packed_size
-                     (const MesgRoomQuit *message)
+size_t mesg_group_quit_ntf__get_packed_size
+                     (const MesgGroupQuitNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_quit__pack
-                     (const MesgRoomQuit *message,
+size_t mesg_group_quit_ntf__pack
+                     (const MesgGroupQuitNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_quit__pack_to_buffer
-                     (const MesgRoomQuit *message,
+size_t mesg_group_quit_ntf__pack_to_buffer
+                     (const MesgGroupQuitNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomQuit *
-       mesg_room_quit__unpack
+MesgGroupQuitNtf *
+       mesg_group_quit_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomQuit *)
-     protobuf_c_message_unpack (&mesg_room_quit__descriptor,
+  return (MesgGroupQuitNtf *)
+     protobuf_c_message_unpack (&mesg_group_quit_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_quit__free_unpacked
-                     (MesgRoomQuit *message,
+void   mesg_group_quit_ntf__free_unpacked
+                     (MesgGroupQuitNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  assert(message->base.descriptor == &mesg_group_quit_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_quit_ack__init
-                     (MesgRoomQuitAck         *message)
+void   mesg_group_kick_ntf__init
+                     (MesgGroupKickNtf         *message)
 {
-  static MesgRoomQuitAck init_value = MESG_ROOM_QUIT_ACK__INIT;
+  static MesgGroupKickNtf init_value = MESG_GROUP_KICK_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_quit_ack__get_packed_size
-                     (const MesgRoomQuitAck *message)
+size_t mesg_group_kick_ntf__get_packed_size
+                     (const MesgGroupKickNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_quit_ack__pack
-                     (const MesgRoomQuitAck *message,
+size_t mesg_group_kick_ntf__pack
+                     (const MesgGroupKickNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_quit_ack__pack_to_buffer
-                     (const MesgRoomQuitAck *message,
+size_t mesg_group_kick_ntf__pack_to_buffer
+                     (const MesgGroupKickNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomQuitAck *
-       mesg_room_quit_ack__unpack
+MesgGroupKickNtf *
+       mesg_group_kick_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomQuitAck *)
-     protobuf_c_message_unpack (&mesg_room_quit_ack__descriptor,
+  return (MesgGroupKickNtf *)
+     protobuf_c_message_unpack (&mesg_group_kick_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_quit_ack__free_unpacked
-                     (MesgRoomQuitAck *message,
+void   mesg_group_kick_ntf__free_unpacked
+                     (MesgGroupKickNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_kick_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_kick__init
-                     (MesgRoomKick         *message)
+void   mesg_group_gag_add_ntf__init
+                     (MesgGroupGagAddNtf         *message)
 {
-  static MesgRoomKick init_value = MESG_ROOM_KICK__INIT;
+  static MesgGroupGagAddNtf init_value = MESG_GROUP_GAG_ADD_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_kick__get_packed_size
-                     (const MesgRoomKick *message)
+size_t mesg_group_gag_add_ntf__get_packed_size
+                     (const MesgGroupGagAddNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_kick__pack
-                     (const MesgRoomKick *message,
+size_t mesg_group_gag_add_ntf__pack
+                     (const MesgGroupGagAddNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_kick__pack_to_buffer
-                     (const MesgRoomKick *message,
+size_t mesg_group_gag_add_ntf__pack_to_buffer
+                     (const MesgGroupGagAddNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomKick *
-       mesg_room_kick__unpack
+MesgGroupGagAddNtf *
+       mesg_group_gag_add_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomKick *)
-     protobuf_c_message_unpack (&mesg_room_kick__descriptor,
+  return (MesgGroupGagAddNtf *)
+     protobuf_c_message_unpack (&mesg_group_gag_add_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_kick__free_unpacked
-                     (MesgRoomKick *message,
+void   mesg_group_gag_add_ntf__free_unpacked
+                     (MesgGroupGagAddNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_add_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_kick_ack__init
-                     (MesgRoomKickAck         *message)
+void   mesg_group_gag_del_ntf__init
+                     (MesgGroupGagDelNtf         *message)
 {
-  static MesgRoomKickAck init_value = MESG_ROOM_KICK_ACK__INIT;
+  static MesgGroupGagDelNtf init_value = MESG_GROUP_GAG_DEL_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_kick_ack__get_packed_size
-                     (const MesgRoomKickAck *message)
+size_t mesg_group_gag_del_ntf__get_packed_size
+                     (const MesgGroupGagDelNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_kick_ack__pack
-                     (const MesgRoomKickAck *message,
+size_t mesg_group_gag_del_ntf__pack
+                     (const MesgGroupGagDelNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_kick_ack__pack_to_buffer
-                     (const MesgRoomKickAck *message,
+size_t mesg_group_gag_del_ntf__pack_to_buffer
+                     (const MesgGroupGagDelNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomKickAck *
-       mesg_room_kick_ack__unpack
+MesgGroupGagDelNtf *
+       mesg_group_gag_del_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomKickAck *)
-     protobuf_c_message_unpack (&mesg_room_kick_ack__descriptor,
+  return (MesgGroupGagDelNtf *)
+     protobuf_c_message_unpack (&mesg_group_gag_del_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_kick_ack__free_unpacked
-                     (MesgRoomKickAck *message,
+void   mesg_group_gag_del_ntf__free_unpacked
+                     (MesgGroupGagDelNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_gag_del_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_chat__init
-                     (MesgRoomChat         *message)
+void   mesg_group_bl_add_ntf__init
+                     (MesgGroupBlAddNtf         *message)
 {
-  static MesgRoomChat init_value = MESG_ROOM_CHAT__INIT;
+  static MesgGroupBlAddNtf init_value = MESG_GROUP_BL_ADD_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_chat__get_packed_size
-                     (const MesgRoomChat *message)
+size_t mesg_group_bl_add_ntf__get_packed_size
+                     (const MesgGroupBlAddNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_chat__pack
-                     (const MesgRoomChat *message,
+size_t mesg_group_bl_add_ntf__pack
+                     (const MesgGroupBlAddNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_chat__pack_to_buffer
-                     (const MesgRoomChat *message,
+size_t mesg_group_bl_add_ntf__pack_to_buffer
+                     (const MesgGroupBlAddNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomChat *
-       mesg_room_chat__unpack
+MesgGroupBlAddNtf *
+       mesg_group_bl_add_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomChat *)
-     protobuf_c_message_unpack (&mesg_room_chat__descriptor,
+  return (MesgGroupBlAddNtf *)
+     protobuf_c_message_unpack (&mesg_group_bl_add_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_chat__free_unpacked
-                     (MesgRoomChat *message,
+void   mesg_group_bl_add_ntf__free_unpacked
+                     (MesgGroupBlAddNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_add_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_chat_ack__init
-                     (MesgRoomChatAck         *message)
+void   mesg_group_bl_del_ntf__init
+                     (MesgGroupBlDelNtf         *message)
 {
-  static MesgRoomChatAck init_value = MESG_ROOM_CHAT_ACK__INIT;
+  static MesgGroupBlDelNtf init_value = MESG_GROUP_BL_DEL_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_chat_ack__get_packed_size
-                     (const MesgRoomChatAck *message)
+size_t mesg_group_bl_del_ntf__get_packed_size
+                     (const MesgGroupBlDelNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_chat_ack__pack
-                     (const MesgRoomChatAck *message,
+size_t mesg_group_bl_del_ntf__pack
+                     (const MesgGroupBlDelNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_chat_ack__pack_to_buffer
-                     (const MesgRoomChatAck *message,
+size_t mesg_group_bl_del_ntf__pack_to_buffer
+                     (const MesgGroupBlDelNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomChatAck *
-       mesg_room_chat_ack__unpack
+MesgGroupBlDelNtf *
+       mesg_group_bl_del_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomChatAck *)
-     protobuf_c_message_unpack (&mesg_room_chat_ack__descriptor,
+  return (MesgGroupBlDelNtf *)
+     protobuf_c_message_unpack (&mesg_group_bl_del_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_chat_ack__free_unpacked
-                     (MesgRoomChatAck *message,
+void   mesg_group_bl_del_ntf__free_unpacked
+                     (MesgGroupBlDelNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_bl_del_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_bc__init
-                     (MesgRoomBc         *message)
+void   mesg_group_mgr_add_ntf__init
+                     (MesgGroupMgrAddNtf         *message)
 {
-  static MesgRoomBc init_value = MESG_ROOM_BC__INIT;
+  static MesgGroupMgrAddNtf init_value = MESG_GROUP_MGR_ADD_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_bc__get_packed_size
-                     (const MesgRoomBc *message)
+size_t mesg_group_mgr_add_ntf__get_packed_size
+                     (const MesgGroupMgrAddNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_bc__pack
-                     (const MesgRoomBc *message,
+size_t mesg_group_mgr_add_ntf__pack
+                     (const MesgGroupMgrAddNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_bc__pack_to_buffer
-                     (const MesgRoomBc *message,
+size_t mesg_group_mgr_add_ntf__pack_to_buffer
+                     (const MesgGroupMgrAddNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomBc *
-       mesg_room_bc__unpack
+MesgGroupMgrAddNtf *
+       mesg_group_mgr_add_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomBc *)
-     protobuf_c_message_unpack (&mesg_room_bc__descriptor,
+  return (MesgGroupMgrAddNtf *)
+     protobuf_c_message_unpack (&mesg_group_mgr_add_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_bc__free_unpacked
-                     (MesgRoomBc *message,
+void   mesg_group_mgr_add_ntf__free_unpacked
+                     (MesgGroupMgrAddNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_add_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_bc_ack__init
-                     (MesgRoomBcAck         *message)
+void   mesg_group_mgr_del_ntf__init
+                     (MesgGroupMgrDelNtf         *message)
 {
-  static MesgRoomBcAck init_value = MESG_ROOM_BC_ACK__INIT;
+  static MesgGroupMgrDelNtf init_value = MESG_GROUP_MGR_DEL_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_bc_ack__get_packed_size
-                     (const MesgRoomBcAck *message)
+size_t mesg_group_mgr_del_ntf__get_packed_size
+                     (const MesgGroupMgrDelNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_bc_ack__pack
-                     (const MesgRoomBcAck *message,
+size_t mesg_group_mgr_del_ntf__pack
+                     (const MesgGroupMgrDelNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_bc_ack__pack_to_buffer
-                     (const MesgRoomBcAck *message,
+size_t mesg_group_mgr_del_ntf__pack_to_buffer
+                     (const MesgGroupMgrDelNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomBcAck *
-       mesg_room_bc_ack__unpack
+MesgGroupMgrDelNtf *
+       mesg_group_mgr_del_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomBcAck *)
-     protobuf_c_message_unpack (&mesg_room_bc_ack__descriptor,
+  return (MesgGroupMgrDelNtf *)
+     protobuf_c_message_unpack (&mesg_group_mgr_del_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_bc_ack__free_unpacked
-                     (MesgRoomBcAck *message,
+void   mesg_group_mgr_del_ntf__free_unpacked
+                     (MesgGroupMgrDelNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  assert(message->base.descriptor == &mesg_group_mgr_del_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_usr_num__init
-                     (MesgRoomUsrNum         *message)
+void   mesg_group_dismiss_ntf__init
+                     (MesgGroupDismissNtf         *message)
 {
-  static MesgRoomUsrNum init_value = MESG_ROOM_USR_NUM__INIT;
+  static MesgGroupDismissNtf init_value = MESG_GROUP_DISMISS_NTF__INIT;
   *message = init_value;
 }
-size_t mesg_room_usr_num__get_packed_size
-                     (const MesgRoomUsrNum *message)
+size_t mesg_group_dismiss_ntf__get_packed_size
+                     (const MesgGroupDismissNtf *message)
 {
-  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ntf__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_usr_num__pack
-                     (const MesgRoomUsrNum *message,
+size_t mesg_group_dismiss_ntf__pack
+                     (const MesgGroupDismissNtf *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ntf__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_usr_num__pack_to_buffer
-                     (const MesgRoomUsrNum *message,
+size_t mesg_group_dismiss_ntf__pack_to_buffer
+                     (const MesgGroupDismissNtf *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ntf__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomUsrNum *
-       mesg_room_usr_num__unpack
+MesgGroupDismissNtf *
+       mesg_group_dismiss_ntf__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomUsrNum *)
-     protobuf_c_message_unpack (&mesg_room_usr_num__descriptor,
+  return (MesgGroupDismissNtf *)
+     protobuf_c_message_unpack (&mesg_group_dismiss_ntf__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_usr_num__free_unpacked
-                     (MesgRoomUsrNum *message,
+void   mesg_group_dismiss_ntf__free_unpacked
+                     (MesgGroupDismissNtf *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  assert(message->base.descriptor == &mesg_group_dismiss_ntf__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_lsn_stat__init
-                     (MesgRoomLsnStat         *message)
+void   mesg_room_creat__init
+                     (MesgRoomCreat         *message)
 {
-  static MesgRoomLsnStat init_value = MESG_ROOM_LSN_STAT__INIT;
+  static MesgRoomCreat init_value = MESG_ROOM_CREAT__INIT;
   *message = init_value;
 }
-size_t mesg_room_lsn_stat__get_packed_size
-                     (const MesgRoomLsnStat *message)
+size_t mesg_room_creat__get_packed_size
+                     (const MesgRoomCreat *message)
 {
-  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_lsn_stat__pack
-                     (const MesgRoomLsnStat *message,
+size_t mesg_room_creat__pack
+                     (const MesgRoomCreat *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_lsn_stat__pack_to_buffer
-                     (const MesgRoomLsnStat *message,
+size_t mesg_room_creat__pack_to_buffer
+                     (const MesgRoomCreat *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomLsnStat *
-       mesg_room_lsn_stat__unpack
+MesgRoomCreat *
+       mesg_room_creat__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomLsnStat *)
-     protobuf_c_message_unpack (&mesg_room_lsn_stat__descriptor,
+  return (MesgRoomCreat *)
+     protobuf_c_message_unpack (&mesg_room_creat__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_lsn_stat__free_unpacked
-                     (MesgRoomLsnStat *message,
+void   mesg_room_creat__free_unpacked
+                     (MesgRoomCreat *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_join_ntf__init
-                     (MesgRoomJoinNtf         *message)
+void   mesg_room_creat_ack__init
+                     (MesgRoomCreatAck         *message)
 {
-  static MesgRoomJoinNtf init_value = MESG_ROOM_JOIN_NTF__INIT;
+  static MesgRoomCreatAck init_value = MESG_ROOM_CREAT_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_room_join_ntf__get_packed_size
-                     (const MesgRoomJoinNtf *message)
+size_t mesg_room_creat_ack__get_packed_size
+                     (const MesgRoomCreatAck *message)
 {
-  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_join_ntf__pack
-                     (const MesgRoomJoinNtf *message,
+size_t mesg_room_creat_ack__pack
+                     (const MesgRoomCreatAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_join_ntf__pack_to_buffer
-                     (const MesgRoomJoinNtf *message,
+size_t mesg_room_creat_ack__pack_to_buffer
+                     (const MesgRoomCreatAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomJoinNtf *
-       mesg_room_join_ntf__unpack
+MesgRoomCreatAck *
+       mesg_room_creat_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomJoinNtf *)
-     protobuf_c_message_unpack (&mesg_room_join_ntf__descriptor,
+  return (MesgRoomCreatAck *)
+     protobuf_c_message_unpack (&mesg_room_creat_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_join_ntf__free_unpacked
-                     (MesgRoomJoinNtf *message,
+void   mesg_room_creat_ack__free_unpacked
+                     (MesgRoomCreatAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_creat_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_quit_ntf__init
-                     (MesgRoomQuitNtf         *message)
+void   mesg_room_dismiss__init
+                     (MesgRoomDismiss         *message)
 {
-  static MesgRoomQuitNtf init_value = MESG_ROOM_QUIT_NTF__INIT;
+  static MesgRoomDismiss init_value = MESG_ROOM_DISMISS__INIT;
   *message = init_value;
 }
-size_t mesg_room_quit_ntf__get_packed_size
-                     (const MesgRoomQuitNtf *message)
+size_t mesg_room_dismiss__get_packed_size
+                     (const MesgRoomDismiss *message)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_quit_ntf__pack
-                     (const MesgRoomQuitNtf *message,
+size_t mesg_room_dismiss__pack
+                     (const MesgRoomDismiss *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_quit_ntf__pack_to_buffer
-                     (const MesgRoomQuitNtf *message,
+size_t mesg_room_dismiss__pack_to_buffer
+                     (const MesgRoomDismiss *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomQuitNtf *
-       mesg_room_quit_ntf__unpack
+MesgRoomDismiss *
+       mesg_room_dismiss__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomQuitNtf *)
-     protobuf_c_message_unpack (&mesg_room_quit_ntf__descriptor,
+  return (MesgRoomDismiss *)
+     protobuf_c_message_unpack (&mesg_room_dismiss__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_quit_ntf__free_unpacked
-                     (MesgRoomQuitNtf *message,
+void   mesg_room_dismiss__free_unpacked
+                     (MesgRoomDismiss *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_room_kick_ntf__init
-                     (MesgRoomKickNtf         *message)
+void   mesg_room_dismiss_ack__init
+                     (MesgRoomDismissAck         *message)
 {
-  static MesgRoomKickNtf init_value = MESG_ROOM_KICK_NTF__INIT;
+  static MesgRoomDismissAck init_value = MESG_ROOM_DISMISS_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_room_kick_ntf__get_packed_size
-                     (const MesgRoomKickNtf *message)
+size_t mesg_room_dismiss_ack__get_packed_size
+                     (const MesgRoomDismissAck *message)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_room_kick_ntf__pack
-                     (const MesgRoomKickNtf *message,
+size_t mesg_room_dismiss_ack__pack
+                     (const MesgRoomDismissAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_room_kick_ntf__pack_to_buffer
-                     (const MesgRoomKickNtf *message,
+size_t mesg_room_dismiss_ack__pack_to_buffer
+                     (const MesgRoomDismissAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgRoomKickNtf *
-       mesg_room_kick_ntf__unpack
+MesgRoomDismissAck *
+       mesg_room_dismiss_ack__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgRoomKickNtf *)
-     protobuf_c_message_unpack (&mesg_room_kick_ntf__descriptor,
+  return (MesgRoomDismissAck *)
+     protobuf_c_message_unpack (&mesg_room_dismiss_ack__descriptor,
                                 allocator, len, data);
 }
-void   mesg_room_kick_ntf__free_unpacked
-                     (MesgRoomKickNtf *message,
+void   mesg_room_dismiss_ack__free_unpacked
+                     (MesgRoomDismissAck *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  assert(message->base.descriptor == &mesg_room_dismiss_ack__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_lsnd_info__init
-                     (MesgLsndInfo         *message)
+void   mesg_room_join__init
+                     (MesgRoomJoin         *message)
 {
-  static MesgLsndInfo init_value = MESG_LSND_INFO__INIT;
+  static MesgRoomJoin init_value = MESG_ROOM_JOIN__INIT;
   *message = init_value;
 }
-size_t mesg_lsnd_info__get_packed_size
-                     (const MesgLsndInfo *message)
+size_t mesg_room_join__get_packed_size
+                     (const MesgRoomJoin *message)
 {
-  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_lsnd_info__pack
-                     (const MesgLsndInfo *message,
+size_t mesg_room_join__pack
+                     (const MesgRoomJoin *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_lsnd_info__pack_to_buffer
-                     (const MesgLsndInfo *message,
+size_t mesg_room_join__pack_to_buffer
+                     (const MesgRoomJoin *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgLsndInfo *
-       mesg_lsnd_info__unpack
+MesgRoomJoin *
+       mesg_room_join__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgLsndInfo *)
-     protobuf_c_message_unpack (&mesg_lsnd_info__descriptor,
+  return (MesgRoomJoin *)
+     protobuf_c_message_unpack (&mesg_room_join__descriptor,
                                 allocator, len, data);
 }
-void   mesg_lsnd_info__free_unpacked
-                     (MesgLsndInfo *message,
+void   mesg_room_join__free_unpacked
+                     (MesgRoomJoin *message,
                       ProtobufCAllocator *allocator)
 {
-  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-void   mesg_frwd_info__init
-                     (MesgFrwdInfo         *message)
+void   mesg_room_join_ack__init
+                     (MesgRoomJoinAck         *message)
 {
-  static MesgFrwdInfo init_value = MESG_FRWD_INFO__INIT;
+  static MesgRoomJoinAck init_value = MESG_ROOM_JOIN_ACK__INIT;
   *message = init_value;
 }
-size_t mesg_frwd_info__get_packed_size
-                     (const MesgFrwdInfo *message)
+size_t mesg_room_join_ack__get_packed_size
+                     (const MesgRoomJoinAck *message)
 {
-  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
   return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
 }
-size_t mesg_frwd_info__pack
-                     (const MesgFrwdInfo *message,
+size_t mesg_room_join_ack__pack
+                     (const MesgRoomJoinAck *message,
                       uint8_t       *out)
 {
-  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
   return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
 }
-size_t mesg_frwd_info__pack_to_buffer
-                     (const MesgFrwdInfo *message,
+size_t mesg_room_join_ack__pack_to_buffer
+                     (const MesgRoomJoinAck *message,
                       ProtobufCBuffer *buffer)
 {
-  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
   return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
 }
-MesgFrwdInfo *
-       mesg_frwd_info__unpack
+MesgRoomJoinAck *
+       mesg_room_join_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomJoinAck *)
+     protobuf_c_message_unpack (&mesg_room_join_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_join_ack__free_unpacked
+                     (MesgRoomJoinAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_join_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_quit__init
+                     (MesgRoomQuit         *message)
+{
+  static MesgRoomQuit init_value = MESG_ROOM_QUIT__INIT;
+  *message = init_value;
+}
+size_t mesg_room_quit__get_packed_size
+                     (const MesgRoomQuit *message)
+{
+  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_quit__pack
+                     (const MesgRoomQuit *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_quit__pack_to_buffer
+                     (const MesgRoomQuit *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomQuit *
+       mesg_room_quit__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomQuit *)
+     protobuf_c_message_unpack (&mesg_room_quit__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_quit__free_unpacked
+                     (MesgRoomQuit *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_quit__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_quit_ack__init
+                     (MesgRoomQuitAck         *message)
+{
+  static MesgRoomQuitAck init_value = MESG_ROOM_QUIT_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_room_quit_ack__get_packed_size
+                     (const MesgRoomQuitAck *message)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_quit_ack__pack
+                     (const MesgRoomQuitAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_quit_ack__pack_to_buffer
+                     (const MesgRoomQuitAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomQuitAck *
+       mesg_room_quit_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomQuitAck *)
+     protobuf_c_message_unpack (&mesg_room_quit_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_quit_ack__free_unpacked
+                     (MesgRoomQuitAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_kick__init
+                     (MesgRoomKick         *message)
+{
+  static MesgRoomKick init_value = MESG_ROOM_KICK__INIT;
+  *message = init_value;
+}
+size_t mesg_room_kick__get_packed_size
+                     (const MesgRoomKick *message)
+{
+  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_kick__pack
+                     (const MesgRoomKick *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_kick__pack_to_buffer
+                     (const MesgRoomKick *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomKick *
+       mesg_room_kick__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomKick *)
+     protobuf_c_message_unpack (&mesg_room_kick__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_kick__free_unpacked
+                     (MesgRoomKick *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_kick__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_kick_ack__init
+                     (MesgRoomKickAck         *message)
+{
+  static MesgRoomKickAck init_value = MESG_ROOM_KICK_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_room_kick_ack__get_packed_size
+                     (const MesgRoomKickAck *message)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_kick_ack__pack
+                     (const MesgRoomKickAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_kick_ack__pack_to_buffer
+                     (const MesgRoomKickAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomKickAck *
+       mesg_room_kick_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomKickAck *)
+     protobuf_c_message_unpack (&mesg_room_kick_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_kick_ack__free_unpacked
+                     (MesgRoomKickAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_chat__init
+                     (MesgRoomChat         *message)
+{
+  static MesgRoomChat init_value = MESG_ROOM_CHAT__INIT;
+  *message = init_value;
+}
+size_t mesg_room_chat__get_packed_size
+                     (const MesgRoomChat *message)
+{
+  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_chat__pack
+                     (const MesgRoomChat *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_chat__pack_to_buffer
+                     (const MesgRoomChat *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomChat *
+       mesg_room_chat__unpack
                      (ProtobufCAllocator  *allocator,
                       size_t               len,
                       const uint8_t       *data)
 {
-  return (MesgFrwdInfo *)
-     protobuf_c_message_unpack (&mesg_frwd_info__descriptor,
-                                allocator, len, data);
-}
-void   mesg_frwd_info__free_unpacked
-                     (MesgFrwdInfo *message,
-                      ProtobufCAllocator *allocator)
+  return (MesgRoomChat *)
+     protobuf_c_message_unpack (&mesg_room_chat__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_chat__free_unpacked
+                     (MesgRoomChat *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_chat__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_chat_ack__init
+                     (MesgRoomChatAck         *message)
+{
+  static MesgRoomChatAck init_value = MESG_ROOM_CHAT_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_room_chat_ack__get_packed_size
+                     (const MesgRoomChatAck *message)
+{
+  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_chat_ack__pack
+                     (const MesgRoomChatAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_chat_ack__pack_to_buffer
+                     (const MesgRoomChatAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomChatAck *
+       mesg_room_chat_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomChatAck *)
+     protobuf_c_message_unpack (&mesg_room_chat_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_chat_ack__free_unpacked
+                     (MesgRoomChatAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_chat_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_bc__init
+                     (MesgRoomBc         *message)
+{
+  static MesgRoomBc init_value = MESG_ROOM_BC__INIT;
+  *message = init_value;
+}
+size_t mesg_room_bc__get_packed_size
+                     (const MesgRoomBc *message)
+{
+  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_bc__pack
+                     (const MesgRoomBc *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_bc__pack_to_buffer
+                     (const MesgRoomBc *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomBc *
+       mesg_room_bc__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomBc *)
+     protobuf_c_message_unpack (&mesg_room_bc__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_bc__free_unpacked
+                     (MesgRoomBc *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_bc__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_bc_ack__init
+                     (MesgRoomBcAck         *message)
+{
+  static MesgRoomBcAck init_value = MESG_ROOM_BC_ACK__INIT;
+  *message = init_value;
+}
+size_t mesg_room_bc_ack__get_packed_size
+                     (const MesgRoomBcAck *message)
+{
+  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_bc_ack__pack
+                     (const MesgRoomBcAck *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_bc_ack__pack_to_buffer
+                     (const MesgRoomBcAck *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomBcAck *
+       mesg_room_bc_ack__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomBcAck *)
+     protobuf_c_message_unpack (&mesg_room_bc_ack__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_bc_ack__free_unpacked
+                     (MesgRoomBcAck *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_bc_ack__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_usr_num__init
+                     (MesgRoomUsrNum         *message)
+{
+  static MesgRoomUsrNum init_value = MESG_ROOM_USR_NUM__INIT;
+  *message = init_value;
+}
+size_t mesg_room_usr_num__get_packed_size
+                     (const MesgRoomUsrNum *message)
+{
+  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_usr_num__pack
+                     (const MesgRoomUsrNum *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_usr_num__pack_to_buffer
+                     (const MesgRoomUsrNum *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomUsrNum *
+       mesg_room_usr_num__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomUsrNum *)
+     protobuf_c_message_unpack (&mesg_room_usr_num__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_usr_num__free_unpacked
+                     (MesgRoomUsrNum *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_usr_num__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_lsn_stat__init
+                     (MesgRoomLsnStat         *message)
+{
+  static MesgRoomLsnStat init_value = MESG_ROOM_LSN_STAT__INIT;
+  *message = init_value;
+}
+size_t mesg_room_lsn_stat__get_packed_size
+                     (const MesgRoomLsnStat *message)
+{
+  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_lsn_stat__pack
+                     (const MesgRoomLsnStat *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_lsn_stat__pack_to_buffer
+                     (const MesgRoomLsnStat *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomLsnStat *
+       mesg_room_lsn_stat__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomLsnStat *)
+     protobuf_c_message_unpack (&mesg_room_lsn_stat__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_lsn_stat__free_unpacked
+                     (MesgRoomLsnStat *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_lsn_stat__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_join_ntf__init
+                     (MesgRoomJoinNtf         *message)
+{
+  static MesgRoomJoinNtf init_value = MESG_ROOM_JOIN_NTF__INIT;
+  *message = init_value;
+}
+size_t mesg_room_join_ntf__get_packed_size
+                     (const MesgRoomJoinNtf *message)
+{
+  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_join_ntf__pack
+                     (const MesgRoomJoinNtf *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_join_ntf__pack_to_buffer
+                     (const MesgRoomJoinNtf *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomJoinNtf *
+       mesg_room_join_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomJoinNtf *)
+     protobuf_c_message_unpack (&mesg_room_join_ntf__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_join_ntf__free_unpacked
+                     (MesgRoomJoinNtf *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_join_ntf__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_quit_ntf__init
+                     (MesgRoomQuitNtf         *message)
+{
+  static MesgRoomQuitNtf init_value = MESG_ROOM_QUIT_NTF__INIT;
+  *message = init_value;
+}
+size_t mesg_room_quit_ntf__get_packed_size
+                     (const MesgRoomQuitNtf *message)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_quit_ntf__pack
+                     (const MesgRoomQuitNtf *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_quit_ntf__pack_to_buffer
+                     (const MesgRoomQuitNtf *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomQuitNtf *
+       mesg_room_quit_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomQuitNtf *)
+     protobuf_c_message_unpack (&mesg_room_quit_ntf__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_quit_ntf__free_unpacked
+                     (MesgRoomQuitNtf *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_quit_ntf__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_room_kick_ntf__init
+                     (MesgRoomKickNtf         *message)
+{
+  static MesgRoomKickNtf init_value = MESG_ROOM_KICK_NTF__INIT;
+  *message = init_value;
+}
+size_t mesg_room_kick_ntf__get_packed_size
+                     (const MesgRoomKickNtf *message)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_room_kick_ntf__pack
+                     (const MesgRoomKickNtf *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_room_kick_ntf__pack_to_buffer
+                     (const MesgRoomKickNtf *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgRoomKickNtf *
+       mesg_room_kick_ntf__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgRoomKickNtf *)
+     protobuf_c_message_unpack (&mesg_room_kick_ntf__descriptor,
+                                allocator, len, data);
+}
+void   mesg_room_kick_ntf__free_unpacked
+                     (MesgRoomKickNtf *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_room_kick_ntf__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_lsnd_info__init
+                     (MesgLsndInfo         *message)
+{
+  static MesgLsndInfo init_value = MESG_LSND_INFO__INIT;
+  *message = init_value;
+}
+size_t mesg_lsnd_info__get_packed_size
+                     (const MesgLsndInfo *message)
+{
+  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_lsnd_info__pack
+                     (const MesgLsndInfo *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_lsnd_info__pack_to_buffer
+                     (const MesgLsndInfo *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgLsndInfo *
+       mesg_lsnd_info__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgLsndInfo *)
+     protobuf_c_message_unpack (&mesg_lsnd_info__descriptor,
+                                allocator, len, data);
+}
+void   mesg_lsnd_info__free_unpacked
+                     (MesgLsndInfo *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_lsnd_info__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mesg_frwd_info__init
+                     (MesgFrwdInfo         *message)
+{
+  static MesgFrwdInfo init_value = MESG_FRWD_INFO__INIT;
+  *message = init_value;
+}
+size_t mesg_frwd_info__get_packed_size
+                     (const MesgFrwdInfo *message)
+{
+  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mesg_frwd_info__pack
+                     (const MesgFrwdInfo *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mesg_frwd_info__pack_to_buffer
+                     (const MesgFrwdInfo *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+MesgFrwdInfo *
+       mesg_frwd_info__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (MesgFrwdInfo *)
+     protobuf_c_message_unpack (&mesg_frwd_info__descriptor,
+                                allocator, len, data);
+}
+void   mesg_frwd_info__free_unpacked
+                     (MesgFrwdInfo *message,
+                      ProtobufCAllocator *allocator)
+{
+  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+static const ProtobufCFieldDescriptor mesg_online__field_descriptors[6] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnline, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "sid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnline, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "token",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnline, token),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "app",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnline, app),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "version",
+    5,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnline, version),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "terminal",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgOnline, has_terminal),
+    offsetof(MesgOnline, terminal),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_online__field_indices_by_name[] = {
+  3,   /* field[3] = app */
+  1,   /* field[1] = sid */
+  5,   /* field[5] = terminal */
+  2,   /* field[2] = token */
+  0,   /* field[0] = uid */
+  4,   /* field[4] = version */
+};
+static const ProtobufCIntRange mesg_online__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mesg_online__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_online",
+  "MesgOnline",
+  "MesgOnline",
+  "",
+  sizeof(MesgOnline),
+  6,
+  mesg_online__field_descriptors,
+  mesg_online__field_indices_by_name,
+  1,  mesg_online__number_ranges,
+  (ProtobufCMessageInit) mesg_online__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_online_ack__field_descriptors[8] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "sid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "seq",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, seq),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "app",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, app),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "version",
+    5,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, version),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "terminal",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgOnlineAck, has_terminal),
+    offsetof(MesgOnlineAck, terminal),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    7,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    8,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_online_ack__field_indices_by_name[] = {
+  3,   /* field[3] = app */
+  6,   /* field[6] = code */
+  7,   /* field[7] = errmsg */
+  2,   /* field[2] = seq */
+  1,   /* field[1] = sid */
+  5,   /* field[5] = terminal */
+  0,   /* field[0] = uid */
+  4,   /* field[4] = version */
+};
+static const ProtobufCIntRange mesg_online_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 8 }
+};
+const ProtobufCMessageDescriptor mesg_online_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_online_ack",
+  "MesgOnlineAck",
+  "MesgOnlineAck",
+  "",
+  sizeof(MesgOnlineAck),
+  8,
+  mesg_online_ack__field_descriptors,
+  mesg_online_ack__field_indices_by_name,
+  1,  mesg_online_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_online_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_sub__field_descriptors[2] =
+{
+  {
+    "cmd",
+    1,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgSub, has_cmd),
+    offsetof(MesgSub, cmd),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uid",
+    2,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgSub, has_uid),
+    offsetof(MesgSub, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_sub__field_indices_by_name[] = {
+  0,   /* field[0] = cmd */
+  1,   /* field[1] = uid */
+};
+static const ProtobufCIntRange mesg_sub__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_sub__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_sub",
+  "MesgSub",
+  "MesgSub",
+  "",
+  sizeof(MesgSub),
+  2,
+  mesg_sub__field_descriptors,
+  mesg_sub__field_indices_by_name,
+  1,  mesg_sub__number_ranges,
+  (ProtobufCMessageInit) mesg_sub__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_sub_ack__field_descriptors[3] =
+{
+  {
+    "cmd",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgSubAck, cmd),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgSubAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgSubAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_sub_ack__field_indices_by_name[] = {
+  0,   /* field[0] = cmd */
+  1,   /* field[1] = code */
+  2,   /* field[2] = errmsg */
+};
+static const ProtobufCIntRange mesg_sub_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mesg_sub_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_sub_ack",
+  "MesgSubAck",
+  "MesgSubAck",
+  "",
+  sizeof(MesgSubAck),
+  3,
+  mesg_sub_ack__field_descriptors,
+  mesg_sub_ack__field_indices_by_name,
+  1,  mesg_sub_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_sub_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_unsub__field_descriptors[2] =
+{
+  {
+    "cmd",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgUnsub, cmd),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uid",
+    2,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgUnsub, has_uid),
+    offsetof(MesgUnsub, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_unsub__field_indices_by_name[] = {
+  0,   /* field[0] = cmd */
+  1,   /* field[1] = uid */
+};
+static const ProtobufCIntRange mesg_unsub__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_unsub__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_unsub",
+  "MesgUnsub",
+  "MesgUnsub",
+  "",
+  sizeof(MesgUnsub),
+  2,
+  mesg_unsub__field_descriptors,
+  mesg_unsub__field_indices_by_name,
+  1,  mesg_unsub__number_ranges,
+  (ProtobufCMessageInit) mesg_unsub__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_unsub_ack__field_descriptors[3] =
+{
+  {
+    "cmd",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgUnsubAck, cmd),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgUnsubAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgUnsubAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_unsub_ack__field_indices_by_name[] = {
+  0,   /* field[0] = cmd */
+  1,   /* field[1] = code */
+  2,   /* field[2] = errmsg */
+};
+static const ProtobufCIntRange mesg_unsub_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mesg_unsub_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_unsub_ack",
+  "MesgUnsubAck",
+  "MesgUnsubAck",
+  "",
+  sizeof(MesgUnsubAck),
+  3,
+  mesg_unsub_ack__field_descriptors,
+  mesg_unsub_ack__field_indices_by_name,
+  1,  mesg_unsub_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_unsub_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_error__field_descriptors[2] =
+{
+  {
+    "code",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgError, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgError, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_error__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_error__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_error__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_error",
+  "MesgError",
+  "MesgError",
+  "",
+  sizeof(MesgError),
+  2,
+  mesg_error__field_descriptors,
+  mesg_error__field_indices_by_name,
+  1,  mesg_error__number_ranges,
+  (ProtobufCMessageInit) mesg_error__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_sync__field_descriptors[4] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgSync, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "since",
+    2,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgSync, has_since),
+    offsetof(MesgSync, since),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgSync, has_num),
+    offsetof(MesgSync, num),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "receipt_since",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgSync, has_receipt_since),
+    offsetof(MesgSync, receipt_since),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_sync__field_indices_by_name[] = {
+  2,   /* field[2] = num */
+  3,   /* field[3] = receipt_since */
+  1,   /* field[1] = since */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_sync__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mesg_sync__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_sync",
+  "MesgSync",
+  "MesgSync",
+  "",
+  sizeof(MesgSync),
+  4,
+  mesg_sync__field_descriptors,
+  mesg_sync__field_indices_by_name,
+  1,  mesg_sync__number_ranges,
+  (ProtobufCMessageInit) mesg_sync__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_sync_ack__field_descriptors[7] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgSyncAck, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgSyncAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgSyncAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "next",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgSyncAck, has_next),
+    offsetof(MesgSyncAck, next),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "more",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgSyncAck, has_more),
+    offsetof(MesgSyncAck, more),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "receipt_next",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgSyncAck, has_receipt_next),
+    offsetof(MesgSyncAck, receipt_next),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "receipt_more",
+    7,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgSyncAck, has_receipt_more),
+    offsetof(MesgSyncAck, receipt_more),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_sync_ack__field_indices_by_name[] = {
+  1,   /* field[1] = code */
+  2,   /* field[2] = errmsg */
+  4,   /* field[4] = more */
+  3,   /* field[3] = next */
+  6,   /* field[6] = receipt_more */
+  5,   /* field[5] = receipt_next */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_sync_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 7 }
+};
+const ProtobufCMessageDescriptor mesg_sync_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_sync_ack",
+  "MesgSyncAck",
+  "MesgSyncAck",
+  "",
+  sizeof(MesgSyncAck),
+  7,
+  mesg_sync_ack__field_descriptors,
+  mesg_sync_ack__field_indices_by_name,
+  1,  mesg_sync_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_sync_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_kick__field_descriptors[2] =
+{
+  {
+    "code",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgKick, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgKick, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_kick__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_kick__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_kick__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_kick",
+  "MesgKick",
+  "MesgKick",
+  "",
+  sizeof(MesgKick),
+  2,
+  mesg_kick__field_descriptors,
+  mesg_kick__field_indices_by_name,
+  1,  mesg_kick__number_ranges,
+  (ProtobufCMessageInit) mesg_kick__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_conv_list__field_descriptors[2] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvList, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num",
+    2,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvList, has_num),
+    offsetof(MesgConvList, num),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_conv_list__field_indices_by_name[] = {
+  1,   /* field[1] = num */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_conv_list__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_conv_list__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_conv_list",
+  "MesgConvList",
+  "MesgConvList",
+  "",
+  sizeof(MesgConvList),
+  2,
+  mesg_conv_list__field_descriptors,
+  mesg_conv_list__field_indices_by_name,
+  1,  mesg_conv_list__number_ranges,
+  (ProtobufCMessageInit) mesg_conv_list__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_conv_list_ack__field_descriptors[4] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvListAck, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "list",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvListAck, list),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvListAck, has_code),
+    offsetof(MesgConvListAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvListAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_conv_list_ack__field_indices_by_name[] = {
+  2,   /* field[2] = code */
+  3,   /* field[3] = errmsg */
+  1,   /* field[1] = list */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_conv_list_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mesg_conv_list_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_conv_list_ack",
+  "MesgConvListAck",
+  "MesgConvListAck",
+  "",
+  sizeof(MesgConvListAck),
+  4,
+  mesg_conv_list_ack__field_descriptors,
+  mesg_conv_list_ack__field_indices_by_name,
+  1,  mesg_conv_list_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_conv_list_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_conv_read__field_descriptors[5] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvRead, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "type",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvRead, type),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvRead, id),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvRead, has_num),
+    offsetof(MesgConvRead, num),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "unread",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvRead, has_unread),
+    offsetof(MesgConvRead, unread),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_conv_read__field_indices_by_name[] = {
+  2,   /* field[2] = id */
+  3,   /* field[3] = num */
+  1,   /* field[1] = type */
+  0,   /* field[0] = uid */
+  4,   /* field[4] = unread */
+};
+static const ProtobufCIntRange mesg_conv_read__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mesg_conv_read__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_conv_read",
+  "MesgConvRead",
+  "MesgConvRead",
+  "",
+  sizeof(MesgConvRead),
+  5,
+  mesg_conv_read__field_descriptors,
+  mesg_conv_read__field_indices_by_name,
+  1,  mesg_conv_read__number_ranges,
+  (ProtobufCMessageInit) mesg_conv_read__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_conv_read_ack__field_descriptors[6] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvReadAck, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "type",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvReadAck, type),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvReadAck, id),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "unread",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvReadAck, has_unread),
+    offsetof(MesgConvReadAck, unread),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgConvReadAck, has_code),
+    offsetof(MesgConvReadAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgConvReadAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_conv_read_ack__field_indices_by_name[] = {
+  4,   /* field[4] = code */
+  5,   /* field[5] = errmsg */
+  2,   /* field[2] = id */
+  1,   /* field[1] = type */
+  0,   /* field[0] = uid */
+  3,   /* field[3] = unread */
+};
+static const ProtobufCIntRange mesg_conv_read_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mesg_conv_read_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_conv_read_ack",
+  "MesgConvReadAck",
+  "MesgConvReadAck",
+  "",
+  sizeof(MesgConvReadAck),
+  6,
+  mesg_conv_read_ack__field_descriptors,
+  mesg_conv_read_ack__field_indices_by_name,
+  1,  mesg_conv_read_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_conv_read_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_online_ntf__field_descriptors[2] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineNtf, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOnlineNtf, time),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_online_ntf__field_indices_by_name[] = {
+  1,   /* field[1] = time */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_online_ntf__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_online_ntf__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_online_ntf",
+  "MesgOnlineNtf",
+  "MesgOnlineNtf",
+  "",
+  sizeof(MesgOnlineNtf),
+  2,
+  mesg_online_ntf__field_descriptors,
+  mesg_online_ntf__field_indices_by_name,
+  1,  mesg_online_ntf__number_ranges,
+  (ProtobufCMessageInit) mesg_online_ntf__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_offline_ntf__field_descriptors[2] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOfflineNtf, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgOfflineNtf, time),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_offline_ntf__field_indices_by_name[] = {
+  1,   /* field[1] = time */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_offline_ntf__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_offline_ntf__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_offline_ntf",
+  "MesgOfflineNtf",
+  "MesgOfflineNtf",
+  "",
+  sizeof(MesgOfflineNtf),
+  2,
+  mesg_offline_ntf__field_descriptors,
+  mesg_offline_ntf__field_indices_by_name,
+  1,  mesg_offline_ntf__number_ranges,
+  (ProtobufCMessageInit) mesg_offline_ntf__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_chat__field_descriptors[8] =
+{
+  {
+    "suid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChat, suid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "duid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChat, duid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "level",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgChat, level),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChat, time),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "text",
+    5,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgChat, text),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "data",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_BYTES,
+    offsetof(MesgChat, has_data),
+    offsetof(MesgChat, data),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "sid",
+    7,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgChat, has_sid),
+    offsetof(MesgChat, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    8,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgChat, has_msgid),
+    offsetof(MesgChat, msgid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_chat__field_indices_by_name[] = {
+  5,   /* field[5] = data */
+  1,   /* field[1] = duid */
+  2,   /* field[2] = level */
+  7,   /* field[7] = msgid */
+  6,   /* field[6] = sid */
+  0,   /* field[0] = suid */
+  4,   /* field[4] = text */
+  3,   /* field[3] = time */
+};
+static const ProtobufCIntRange mesg_chat__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 8 }
+};
+const ProtobufCMessageDescriptor mesg_chat__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_chat",
+  "MesgChat",
+  "MesgChat",
+  "",
+  sizeof(MesgChat),
+  8,
+  mesg_chat__field_descriptors,
+  mesg_chat__field_indices_by_name,
+  1,  mesg_chat__number_ranges,
+  (ProtobufCMessageInit) mesg_chat__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_chat_ack__field_descriptors[5] =
+{
+  {
+    "suid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatAck, suid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "duid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatAck, duid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "sid",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgChatAck, has_sid),
+    offsetof(MesgChatAck, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_chat_ack__field_indices_by_name[] = {
+  2,   /* field[2] = code */
+  1,   /* field[1] = duid */
+  3,   /* field[3] = errmsg */
+  4,   /* field[4] = sid */
+  0,   /* field[0] = suid */
+};
+static const ProtobufCIntRange mesg_chat_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mesg_chat_ack__descriptor =
 {
-  assert(message->base.descriptor == &mesg_frwd_info__descriptor);
-  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
-}
-static const ProtobufCFieldDescriptor mesg_online__field_descriptors[6] =
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_chat_ack",
+  "MesgChatAck",
+  "MesgChatAck",
+  "",
+  sizeof(MesgChatAck),
+  5,
+  mesg_chat_ack__field_descriptors,
+  mesg_chat_ack__field_indices_by_name,
+  1,  mesg_chat_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_chat_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_friend_add__field_descriptors[3] =
 {
   {
-    "uid",
+    "suid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnline, uid),
+    offsetof(MesgFriendAdd, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "sid",
+    "duid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnline, sid),
+    offsetof(MesgFriendAdd, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "token",
+    "mark",
     3,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgOnline, token),
+    offsetof(MesgFriendAdd, mark),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_friend_add__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  2,   /* field[2] = mark */
+  0,   /* field[0] = suid */
+};
+static const ProtobufCIntRange mesg_friend_add__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mesg_friend_add__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_friend_add",
+  "MesgFriendAdd",
+  "MesgFriendAdd",
+  "",
+  sizeof(MesgFriendAdd),
+  3,
+  mesg_friend_add__field_descriptors,
+  mesg_friend_add__field_indices_by_name,
+  1,  mesg_friend_add__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_add__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_friend_add_ack__field_descriptors[2] =
+{
+  {
+    "code",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendAddAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendAddAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_friend_add_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_friend_add_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_friend_add_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_friend_add_ack",
+  "MesgFriendAddAck",
+  "MesgFriendAddAck",
+  "",
+  sizeof(MesgFriendAddAck),
+  2,
+  mesg_friend_add_ack__field_descriptors,
+  mesg_friend_add_ack__field_indices_by_name,
+  1,  mesg_friend_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_add_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_friend_del__field_descriptors[3] =
+{
+  {
+    "suid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendDel, suid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "duid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendDel, duid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "mutual",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgFriendDel, has_mutual),
+    offsetof(MesgFriendDel, mutual),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_friend_del__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  2,   /* field[2] = mutual */
+  0,   /* field[0] = suid */
+};
+static const ProtobufCIntRange mesg_friend_del__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mesg_friend_del__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_friend_del",
+  "MesgFriendDel",
+  "MesgFriendDel",
+  "",
+  sizeof(MesgFriendDel),
+  3,
+  mesg_friend_del__field_descriptors,
+  mesg_friend_del__field_indices_by_name,
+  1,  mesg_friend_del__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_del__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_friend_del_ack__field_descriptors[2] =
+{
+  {
+    "code",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendDelAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgFriendDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_friend_del_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_friend_del_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_friend_del_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_friend_del_ack",
+  "MesgFriendDelAck",
+  "MesgFriendDelAck",
+  "",
+  sizeof(MesgFriendDelAck),
+  2,
+  mesg_friend_del_ack__field_descriptors,
+  mesg_friend_del_ack__field_indices_by_name,
+  1,  mesg_friend_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_del_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_blacklist_add__field_descriptors[2] =
+{
   {
-    "app",
-    4,
+    "suid",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnline, app),
+    offsetof(MesgBlacklistAdd, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "version",
-    5,
+    "duid",
+    2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnline, version),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "terminal",
-    6,
-    PROTOBUF_C_LABEL_OPTIONAL,
-    PROTOBUF_C_TYPE_UINT32,
-    offsetof(MesgOnline, has_terminal),
-    offsetof(MesgOnline, terminal),
+    offsetof(MesgBlacklistAdd, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_online__field_indices_by_name[] = {
-  3,   /* field[3] = app */
-  1,   /* field[1] = sid */
-  5,   /* field[5] = terminal */
-  2,   /* field[2] = token */
-  0,   /* field[0] = uid */
-  4,   /* field[4] = version */
+static const unsigned mesg_blacklist_add__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_online__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_blacklist_add__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 6 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_online__descriptor =
+const ProtobufCMessageDescriptor mesg_blacklist_add__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_online",
-  "MesgOnline",
-  "MesgOnline",
+  "mesg_blacklist_add",
+  "MesgBlacklistAdd",
+  "MesgBlacklistAdd",
   "",
-  sizeof(MesgOnline),
-  6,
-  mesg_online__field_descriptors,
-  mesg_online__field_indices_by_name,
-  1,  mesg_online__number_ranges,
-  (ProtobufCMessageInit) mesg_online__init,
+  sizeof(MesgBlacklistAdd),
+  2,
+  mesg_blacklist_add__field_descriptors,
+  mesg_blacklist_add__field_indices_by_name,
+  1,  mesg_blacklist_add__number_ranges,
+  (ProtobufCMessageInit) mesg_blacklist_add__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_online_ack__field_descriptors[8] =
+static const ProtobufCFieldDescriptor mesg_blacklist_add_ack__field_descriptors[2] =
 {
   {
-    "uid",
+    "code",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, uid),
+    offsetof(MesgBlacklistAddAck, code),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "sid",
+    "errmsg",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, sid),
+    offsetof(MesgBlacklistAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_blacklist_add_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_blacklist_add_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_blacklist_add_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_blacklist_add_ack",
+  "MesgBlacklistAddAck",
+  "MesgBlacklistAddAck",
+  "",
+  sizeof(MesgBlacklistAddAck),
+  2,
+  mesg_blacklist_add_ack__field_descriptors,
+  mesg_blacklist_add_ack__field_indices_by_name,
+  1,  mesg_blacklist_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_blacklist_add_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_blacklist_del__field_descriptors[2] =
+{
   {
-    "seq",
-    3,
+    "suid",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, seq),
+    offsetof(MesgBlacklistDel, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "app",
-    4,
+    "duid",
+    2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, app),
+    offsetof(MesgBlacklistDel, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_blacklist_del__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  0,   /* field[0] = suid */
+};
+static const ProtobufCIntRange mesg_blacklist_del__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_blacklist_del__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_blacklist_del",
+  "MesgBlacklistDel",
+  "MesgBlacklistDel",
+  "",
+  sizeof(MesgBlacklistDel),
+  2,
+  mesg_blacklist_del__field_descriptors,
+  mesg_blacklist_del__field_indices_by_name,
+  1,  mesg_blacklist_del__number_ranges,
+  (ProtobufCMessageInit) mesg_blacklist_del__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_blacklist_del_ack__field_descriptors[2] =
+{
   {
-    "version",
-    5,
+    "code",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, version),
+    offsetof(MesgBlacklistDelAck, code),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "terminal",
-    6,
-    PROTOBUF_C_LABEL_OPTIONAL,
-    PROTOBUF_C_TYPE_UINT32,
-    offsetof(MesgOnlineAck, has_terminal),
-    offsetof(MesgOnlineAck, terminal),
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgBlacklistDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_blacklist_del_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_blacklist_del_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_blacklist_del_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_blacklist_del_ack",
+  "MesgBlacklistDelAck",
+  "MesgBlacklistDelAck",
+  "",
+  sizeof(MesgBlacklistDelAck),
+  2,
+  mesg_blacklist_del_ack__field_descriptors,
+  mesg_blacklist_del_ack__field_indices_by_name,
+  1,  mesg_blacklist_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_blacklist_del_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_gag_add__field_descriptors[2] =
+{
   {
-    "code",
-    7,
+    "suid",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, code),
+    offsetof(MesgGagAdd, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
-    8,
+    "duid",
+    2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgOnlineAck, errmsg),
+    offsetof(MesgGagAdd, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_online_ack__field_indices_by_name[] = {
-  3,   /* field[3] = app */
-  6,   /* field[6] = code */
-  7,   /* field[7] = errmsg */
-  2,   /* field[2] = seq */
-  1,   /* field[1] = sid */
-  5,   /* field[5] = terminal */
-  0,   /* field[0] = uid */
-  4,   /* field[4] = version */
+static const unsigned mesg_gag_add__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_online_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_gag_add__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 8 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_online_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_gag_add__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_online_ack",
-  "MesgOnlineAck",
-  "MesgOnlineAck",
+  "mesg_gag_add",
+  "MesgGagAdd",
+  "MesgGagAdd",
   "",
-  sizeof(MesgOnlineAck),
-  8,
-  mesg_online_ack__field_descriptors,
-  mesg_online_ack__field_indices_by_name,
-  1,  mesg_online_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_online_ack__init,
+  sizeof(MesgGagAdd),
+  2,
+  mesg_gag_add__field_descriptors,
+  mesg_gag_add__field_indices_by_name,
+  1,  mesg_gag_add__number_ranges,
+  (ProtobufCMessageInit) mesg_gag_add__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_sub__field_descriptors[1] =
+static const ProtobufCFieldDescriptor mesg_gag_add_ack__field_descriptors[2] =
 {
   {
-    "cmd",
+    "code",
     1,
-    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
-    offsetof(MesgSub, has_cmd),
-    offsetof(MesgSub, cmd),
+    0,   /* quantifier_offset */
+    offsetof(MesgGagAddAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGagAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_sub__field_indices_by_name[] = {
-  0,   /* field[0] = cmd */
+static const unsigned mesg_gag_add_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_sub__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_gag_add_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 1 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_sub__descriptor =
+const ProtobufCMessageDescriptor mesg_gag_add_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_sub",
-  "MesgSub",
-  "MesgSub",
+  "mesg_gag_add_ack",
+  "MesgGagAddAck",
+  "MesgGagAddAck",
   "",
-  sizeof(MesgSub),
-  1,
-  mesg_sub__field_descriptors,
-  mesg_sub__field_indices_by_name,
-  1,  mesg_sub__number_ranges,
-  (ProtobufCMessageInit) mesg_sub__init,
+  sizeof(MesgGagAddAck),
+  2,
+  mesg_gag_add_ack__field_descriptors,
+  mesg_gag_add_ack__field_indices_by_name,
+  1,  mesg_gag_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_gag_add_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_sub_ack__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_gag_del__field_descriptors[2] =
 {
   {
-    "cmd",
+    "suid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgSubAck, cmd),
+    offsetof(MesgGagDel, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "code",
+    "duid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
-    0,   /* quantifier_offset */
-    offsetof(MesgSubAck, code),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "errmsg",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgSubAck, errmsg),
+    offsetof(MesgGagDel, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_sub_ack__field_indices_by_name[] = {
-  0,   /* field[0] = cmd */
-  1,   /* field[1] = code */
-  2,   /* field[2] = errmsg */
+static const unsigned mesg_gag_del__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_sub_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_gag_del__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_sub_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_gag_del__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_sub_ack",
-  "MesgSubAck",
-  "MesgSubAck",
+  "mesg_gag_del",
+  "MesgGagDel",
+  "MesgGagDel",
   "",
-  sizeof(MesgSubAck),
-  3,
-  mesg_sub_ack__field_descriptors,
-  mesg_sub_ack__field_indices_by_name,
-  1,  mesg_sub_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_sub_ack__init,
+  sizeof(MesgGagDel),
+  2,
+  mesg_gag_del__field_descriptors,
+  mesg_gag_del__field_indices_by_name,
+  1,  mesg_gag_del__number_ranges,
+  (ProtobufCMessageInit) mesg_gag_del__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_unsub__field_descriptors[1] =
+static const ProtobufCFieldDescriptor mesg_gag_del_ack__field_descriptors[2] =
 {
   {
-    "cmd",
+    "code",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgUnsub, cmd),
+    offsetof(MesgGagDelAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGagDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_unsub__field_indices_by_name[] = {
-  0,   /* field[0] = cmd */
+static const unsigned mesg_gag_del_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_unsub__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_gag_del_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 1 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_unsub__descriptor =
+const ProtobufCMessageDescriptor mesg_gag_del_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_unsub",
-  "MesgUnsub",
-  "MesgUnsub",
+  "mesg_gag_del_ack",
+  "MesgGagDelAck",
+  "MesgGagDelAck",
   "",
-  sizeof(MesgUnsub),
-  1,
-  mesg_unsub__field_descriptors,
-  mesg_unsub__field_indices_by_name,
-  1,  mesg_unsub__number_ranges,
-  (ProtobufCMessageInit) mesg_unsub__init,
+  sizeof(MesgGagDelAck),
+  2,
+  mesg_gag_del_ack__field_descriptors,
+  mesg_gag_del_ack__field_indices_by_name,
+  1,  mesg_gag_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_gag_del_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_unsub_ack__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_mark_add__field_descriptors[4] =
 {
   {
-    "cmd",
+    "suid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgUnsubAck, cmd),
+    offsetof(MesgMarkAdd, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "code",
+    "duid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgUnsubAck, code),
+    offsetof(MesgMarkAdd, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
+    "mark",
     3,
-    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgMarkAdd, has_mark),
+    offsetof(MesgMarkAdd, mark),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "name",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgUnsubAck, errmsg),
+    offsetof(MesgMarkAdd, name),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_unsub_ack__field_indices_by_name[] = {
-  0,   /* field[0] = cmd */
-  1,   /* field[1] = code */
-  2,   /* field[2] = errmsg */
+static const unsigned mesg_mark_add__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  2,   /* field[2] = mark */
+  3,   /* field[3] = name */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_unsub_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_mark_add__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_unsub_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_mark_add__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_unsub_ack",
-  "MesgUnsubAck",
-  "MesgUnsubAck",
+  "mesg_mark_add",
+  "MesgMarkAdd",
+  "MesgMarkAdd",
   "",
-  sizeof(MesgUnsubAck),
-  3,
-  mesg_unsub_ack__field_descriptors,
-  mesg_unsub_ack__field_indices_by_name,
-  1,  mesg_unsub_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_unsub_ack__init,
+  sizeof(MesgMarkAdd),
+  4,
+  mesg_mark_add__field_descriptors,
+  mesg_mark_add__field_indices_by_name,
+  1,  mesg_mark_add__number_ranges,
+  (ProtobufCMessageInit) mesg_mark_add__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_error__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_mark_add_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -4149,7 +6730,7 @@ static const ProtobufCFieldDescriptor mesg_error__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgError, code),
+    offsetof(MesgMarkAddAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -4161,140 +6742,89 @@ static const ProtobufCFieldDescriptor mesg_error__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgError, errmsg),
+    offsetof(MesgMarkAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_error__field_indices_by_name[] = {
+static const unsigned mesg_mark_add_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_error__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_mark_add_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_error__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_error",
-  "MesgError",
-  "MesgError",
-  "",
-  sizeof(MesgError),
-  2,
-  mesg_error__field_descriptors,
-  mesg_error__field_indices_by_name,
-  1,  mesg_error__number_ranges,
-  (ProtobufCMessageInit) mesg_error__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_sync__field_descriptors[1] =
-{
-  {
-    "uid",
-    1,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
-    0,   /* quantifier_offset */
-    offsetof(MesgSync, uid),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-};
-static const unsigned mesg_sync__field_indices_by_name[] = {
-  0,   /* field[0] = uid */
-};
-static const ProtobufCIntRange mesg_sync__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 1 }
-};
-const ProtobufCMessageDescriptor mesg_sync__descriptor =
+const ProtobufCMessageDescriptor mesg_mark_add_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_sync",
-  "MesgSync",
-  "MesgSync",
-  "",
-  sizeof(MesgSync),
-  1,
-  mesg_sync__field_descriptors,
-  mesg_sync__field_indices_by_name,
-  1,  mesg_sync__number_ranges,
-  (ProtobufCMessageInit) mesg_sync__init,
+  "mesg_mark_add_ack",
+  "MesgMarkAddAck",
+  "MesgMarkAddAck",
+  "",
+  sizeof(MesgMarkAddAck),
+  2,
+  mesg_mark_add_ack__field_descriptors,
+  mesg_mark_add_ack__field_indices_by_name,
+  1,  mesg_mark_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_mark_add_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_sync_ack__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_mark_del__field_descriptors[2] =
 {
   {
-    "uid",
+    "suid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgSyncAck, uid),
+    offsetof(MesgMarkDel, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "code",
+    "duid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
-    0,   /* quantifier_offset */
-    offsetof(MesgSyncAck, code),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "errmsg",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgSyncAck, errmsg),
+    offsetof(MesgMarkDel, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_sync_ack__field_indices_by_name[] = {
-  1,   /* field[1] = code */
-  2,   /* field[2] = errmsg */
-  0,   /* field[0] = uid */
+static const unsigned mesg_mark_del__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_sync_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_mark_del__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_sync_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_mark_del__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_sync_ack",
-  "MesgSyncAck",
-  "MesgSyncAck",
+  "mesg_mark_del",
+  "MesgMarkDel",
+  "MesgMarkDel",
   "",
-  sizeof(MesgSyncAck),
-  3,
-  mesg_sync_ack__field_descriptors,
-  mesg_sync_ack__field_indices_by_name,
-  1,  mesg_sync_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_sync_ack__init,
+  sizeof(MesgMarkDel),
+  2,
+  mesg_mark_del__field_descriptors,
+  mesg_mark_del__field_indices_by_name,
+  1,  mesg_mark_del__number_ranges,
+  (ProtobufCMessageInit) mesg_mark_del__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_kick__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_mark_del_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -4302,7 +6832,7 @@ static const ProtobufCFieldDescriptor mesg_kick__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgKick, code),
+    offsetof(MesgMarkDelAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -4314,38 +6844,38 @@ static const ProtobufCFieldDescriptor mesg_kick__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgKick, errmsg),
+    offsetof(MesgMarkDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_kick__field_indices_by_name[] = {
+static const unsigned mesg_mark_del_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_kick__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_mark_del_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_kick__descriptor =
+const ProtobufCMessageDescriptor mesg_mark_del_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_kick",
-  "MesgKick",
-  "MesgKick",
+  "mesg_mark_del_ack",
+  "MesgMarkDelAck",
+  "MesgMarkDelAck",
   "",
-  sizeof(MesgKick),
+  sizeof(MesgMarkDelAck),
   2,
-  mesg_kick__field_descriptors,
-  mesg_kick__field_indices_by_name,
-  1,  mesg_kick__number_ranges,
-  (ProtobufCMessageInit) mesg_kick__init,
+  mesg_mark_del_ack__field_descriptors,
+  mesg_mark_del_ack__field_indices_by_name,
+  1,  mesg_mark_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_mark_del_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_chat__field_descriptors[6] =
+static const ProtobufCFieldDescriptor mesg_friend_reply__field_descriptors[3] =
 {
   {
     "suid",
@@ -4353,7 +6883,7 @@ static const ProtobufCFieldDescriptor mesg_chat__field_descriptors[6] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgChat, suid),
+    offsetof(MesgFriendReply, suid),
     NULL,
     NULL,
     0,             /* flags */
@@ -4365,239 +6895,222 @@ static const ProtobufCFieldDescriptor mesg_chat__field_descriptors[6] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgChat, duid),
+    offsetof(MesgFriendReply, duid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "level",
+    "pass",
     3,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgChat, level),
+    offsetof(MesgFriendReply, pass),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_friend_reply__field_indices_by_name[] = {
+  1,   /* field[1] = duid */
+  2,   /* field[2] = pass */
+  0,   /* field[0] = suid */
+};
+static const ProtobufCIntRange mesg_friend_reply__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mesg_friend_reply__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_friend_reply",
+  "MesgFriendReply",
+  "MesgFriendReply",
+  "",
+  sizeof(MesgFriendReply),
+  3,
+  mesg_friend_reply__field_descriptors,
+  mesg_friend_reply__field_indices_by_name,
+  1,  mesg_friend_reply__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_reply__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_friend_reply_ack__field_descriptors[2] =
+{
   {
-    "time",
-    4,
+    "code",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgChat, time),
+    offsetof(MesgFriendReplyAck, code),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "text",
-    5,
+    "errmsg",
+    2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgChat, text),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "data",
-    6,
-    PROTOBUF_C_LABEL_OPTIONAL,
-    PROTOBUF_C_TYPE_BYTES,
-    offsetof(MesgChat, has_data),
-    offsetof(MesgChat, data),
+    offsetof(MesgFriendReplyAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_chat__field_indices_by_name[] = {
-  5,   /* field[5] = data */
-  1,   /* field[1] = duid */
-  2,   /* field[2] = level */
-  0,   /* field[0] = suid */
-  4,   /* field[4] = text */
-  3,   /* field[3] = time */
+static const unsigned mesg_friend_reply_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_chat__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_friend_reply_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 6 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_chat__descriptor =
+const ProtobufCMessageDescriptor mesg_friend_reply_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_chat",
-  "MesgChat",
-  "MesgChat",
+  "mesg_friend_reply_ack",
+  "MesgFriendReplyAck",
+  "MesgFriendReplyAck",
   "",
-  sizeof(MesgChat),
-  6,
-  mesg_chat__field_descriptors,
-  mesg_chat__field_indices_by_name,
-  1,  mesg_chat__number_ranges,
-  (ProtobufCMessageInit) mesg_chat__init,
+  sizeof(MesgFriendReplyAck),
+  2,
+  mesg_friend_reply_ack__field_descriptors,
+  mesg_friend_reply_ack__field_indices_by_name,
+  1,  mesg_friend_reply_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_reply_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_chat_ack__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mesg_friend_list__field_descriptors[3] =
 {
   {
-    "suid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgChatAck, suid),
+    offsetof(MesgFriendList, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "num",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
-    0,   /* quantifier_offset */
-    offsetof(MesgChatAck, duid),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "code",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgChatAck, code),
+    offsetof(MesgFriendList, num),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
-    4,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
-    0,   /* quantifier_offset */
-    offsetof(MesgChatAck, errmsg),
+    "cursor",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgFriendList, has_cursor),
+    offsetof(MesgFriendList, cursor),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_chat_ack__field_indices_by_name[] = {
-  2,   /* field[2] = code */
-  1,   /* field[1] = duid */
-  3,   /* field[3] = errmsg */
-  0,   /* field[0] = suid */
+static const unsigned mesg_friend_list__field_indices_by_name[] = {
+  2,   /* field[2] = cursor */
+  1,   /* field[1] = num */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_chat_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_friend_list__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 3 }
 };
-const ProtobufCMessageDescriptor mesg_chat_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_friend_list__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_chat_ack",
-  "MesgChatAck",
-  "MesgChatAck",
+  "mesg_friend_list",
+  "MesgFriendList",
+  "MesgFriendList",
   "",
-  sizeof(MesgChatAck),
-  4,
-  mesg_chat_ack__field_descriptors,
-  mesg_chat_ack__field_indices_by_name,
-  1,  mesg_chat_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_chat_ack__init,
+  sizeof(MesgFriendList),
+  3,
+  mesg_friend_list__field_descriptors,
+  mesg_friend_list__field_indices_by_name,
+  1,  mesg_friend_list__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_list__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_friend_add__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_friend_list_ack__field_descriptors[6] =
 {
   {
-    "suid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendAdd, suid),
+    offsetof(MesgFriendListAck, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "list",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendAdd, duid),
+    offsetof(MesgFriendListAck, list),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "mark",
+    "next",
     3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
-    0,   /* quantifier_offset */
-    offsetof(MesgFriendAdd, mark),
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgFriendListAck, has_next),
+    offsetof(MesgFriendListAck, next),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "total",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgFriendListAck, has_total),
+    offsetof(MesgFriendListAck, total),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_friend_add__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  2,   /* field[2] = mark */
-  0,   /* field[0] = suid */
-};
-static const ProtobufCIntRange mesg_friend_add__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 3 }
-};
-const ProtobufCMessageDescriptor mesg_friend_add__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_friend_add",
-  "MesgFriendAdd",
-  "MesgFriendAdd",
-  "",
-  sizeof(MesgFriendAdd),
-  3,
-  mesg_friend_add__field_descriptors,
-  mesg_friend_add__field_indices_by_name,
-  1,  mesg_friend_add__number_ranges,
-  (ProtobufCMessageInit) mesg_friend_add__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_friend_add_ack__field_descriptors[2] =
-{
   {
     "code",
-    1,
-    PROTOBUF_C_LABEL_REQUIRED,
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
     PROTOBUF_C_TYPE_UINT32,
-    0,   /* quantifier_offset */
-    offsetof(MesgFriendAddAck, code),
+    offsetof(MesgFriendListAck, has_code),
+    offsetof(MesgFriendListAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -4605,42 +7118,46 @@ static const ProtobufCFieldDescriptor mesg_friend_add_ack__field_descriptors[2]
   },
   {
     "errmsg",
-    2,
-    PROTOBUF_C_LABEL_REQUIRED,
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendAddAck, errmsg),
+    offsetof(MesgFriendListAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_friend_add_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_friend_list_ack__field_indices_by_name[] = {
+  4,   /* field[4] = code */
+  5,   /* field[5] = errmsg */
+  1,   /* field[1] = list */
+  2,   /* field[2] = next */
+  3,   /* field[3] = total */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_friend_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_friend_list_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 6 }
 };
-const ProtobufCMessageDescriptor mesg_friend_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_friend_list_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_friend_add_ack",
-  "MesgFriendAddAck",
-  "MesgFriendAddAck",
+  "mesg_friend_list_ack",
+  "MesgFriendListAck",
+  "MesgFriendListAck",
   "",
-  sizeof(MesgFriendAddAck),
-  2,
-  mesg_friend_add_ack__field_descriptors,
-  mesg_friend_add_ack__field_indices_by_name,
-  1,  mesg_friend_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_friend_add_ack__init,
+  sizeof(MesgFriendListAck),
+  6,
+  mesg_friend_list_ack__field_descriptors,
+  mesg_friend_list_ack__field_indices_by_name,
+  1,  mesg_friend_list_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_friend_list_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_friend_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_chat_receipt__field_descriptors[5] =
 {
   {
     "suid",
@@ -4648,101 +7165,89 @@ static const ProtobufCFieldDescriptor mesg_friend_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendDel, suid),
+    offsetof(MesgChatReceipt, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "sid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendDel, duid),
+    offsetof(MesgChatReceipt, sid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_friend_del__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
-};
-static const ProtobufCIntRange mesg_friend_del__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_friend_del__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_friend_del",
-  "MesgFriendDel",
-  "MesgFriendDel",
-  "",
-  sizeof(MesgFriendDel),
-  2,
-  mesg_friend_del__field_descriptors,
-  mesg_friend_del__field_indices_by_name,
-  1,  mesg_friend_del__number_ranges,
-  (ProtobufCMessageInit) mesg_friend_del__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_friend_del_ack__field_descriptors[2] =
-{
   {
-    "code",
-    1,
+    "msgid",
+    3,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendDelAck, code),
+    offsetof(MesgChatReceipt, msgid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
-    2,
+    "duid",
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgFriendDelAck, errmsg),
+    offsetof(MesgChatReceipt, duid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgChatReceipt, has_time),
+    offsetof(MesgChatReceipt, time),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_friend_del_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_chat_receipt__field_indices_by_name[] = {
+  3,   /* field[3] = duid */
+  2,   /* field[2] = msgid */
+  1,   /* field[1] = sid */
+  0,   /* field[0] = suid */
+  4,   /* field[4] = time */
 };
-static const ProtobufCIntRange mesg_friend_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_chat_receipt__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 5 }
 };
-const ProtobufCMessageDescriptor mesg_friend_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_chat_receipt__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_friend_del_ack",
-  "MesgFriendDelAck",
-  "MesgFriendDelAck",
+  "mesg_chat_receipt",
+  "MesgChatReceipt",
+  "MesgChatReceipt",
   "",
-  sizeof(MesgFriendDelAck),
-  2,
-  mesg_friend_del_ack__field_descriptors,
-  mesg_friend_del_ack__field_indices_by_name,
-  1,  mesg_friend_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_friend_del_ack__init,
+  sizeof(MesgChatReceipt),
+  5,
+  mesg_chat_receipt__field_descriptors,
+  mesg_chat_receipt__field_indices_by_name,
+  1,  mesg_chat_receipt__number_ranges,
+  (ProtobufCMessageInit) mesg_chat_receipt__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_blacklist_add__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_chat_receipt_ack__field_descriptors[5] =
 {
   {
     "suid",
@@ -4750,58 +7255,43 @@ static const ProtobufCFieldDescriptor mesg_blacklist_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistAdd, suid),
+    offsetof(MesgChatReceiptAck, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "sid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistAdd, duid),
+    offsetof(MesgChatReceiptAck, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatReceiptAck, msgid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_blacklist_add__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
-};
-static const ProtobufCIntRange mesg_blacklist_add__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_blacklist_add__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_blacklist_add",
-  "MesgBlacklistAdd",
-  "MesgBlacklistAdd",
-  "",
-  sizeof(MesgBlacklistAdd),
-  2,
-  mesg_blacklist_add__field_descriptors,
-  mesg_blacklist_add__field_indices_by_name,
-  1,  mesg_blacklist_add__number_ranges,
-  (ProtobufCMessageInit) mesg_blacklist_add__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_blacklist_add_ack__field_descriptors[2] =
-{
   {
     "code",
-    1,
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistAddAck, code),
+    offsetof(MesgChatReceiptAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -4809,42 +7299,45 @@ static const ProtobufCFieldDescriptor mesg_blacklist_add_ack__field_descriptors[
   },
   {
     "errmsg",
-    2,
+    5,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistAddAck, errmsg),
+    offsetof(MesgChatReceiptAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_blacklist_add_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_chat_receipt_ack__field_indices_by_name[] = {
+  3,   /* field[3] = code */
+  4,   /* field[4] = errmsg */
+  2,   /* field[2] = msgid */
+  1,   /* field[1] = sid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_blacklist_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_chat_receipt_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 5 }
 };
-const ProtobufCMessageDescriptor mesg_blacklist_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_chat_receipt_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_blacklist_add_ack",
-  "MesgBlacklistAddAck",
-  "MesgBlacklistAddAck",
+  "mesg_chat_receipt_ack",
+  "MesgChatReceiptAck",
+  "MesgChatReceiptAck",
   "",
-  sizeof(MesgBlacklistAddAck),
-  2,
-  mesg_blacklist_add_ack__field_descriptors,
-  mesg_blacklist_add_ack__field_indices_by_name,
-  1,  mesg_blacklist_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_blacklist_add_ack__init,
+  sizeof(MesgChatReceiptAck),
+  5,
+  mesg_chat_receipt_ack__field_descriptors,
+  mesg_chat_receipt_ack__field_indices_by_name,
+  1,  mesg_chat_receipt_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_chat_receipt_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_blacklist_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_chat_recall__field_descriptors[5] =
 {
   {
     "suid",
@@ -4852,101 +7345,89 @@ static const ProtobufCFieldDescriptor mesg_blacklist_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistDel, suid),
+    offsetof(MesgChatRecall, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "sid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistDel, duid),
+    offsetof(MesgChatRecall, sid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_blacklist_del__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
-};
-static const ProtobufCIntRange mesg_blacklist_del__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_blacklist_del__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_blacklist_del",
-  "MesgBlacklistDel",
-  "MesgBlacklistDel",
-  "",
-  sizeof(MesgBlacklistDel),
-  2,
-  mesg_blacklist_del__field_descriptors,
-  mesg_blacklist_del__field_indices_by_name,
-  1,  mesg_blacklist_del__number_ranges,
-  (ProtobufCMessageInit) mesg_blacklist_del__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_blacklist_del_ack__field_descriptors[2] =
-{
   {
-    "code",
-    1,
+    "msgid",
+    3,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistDelAck, code),
+    offsetof(MesgChatRecall, msgid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
-    2,
+    "duid",
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgBlacklistDelAck, errmsg),
+    offsetof(MesgChatRecall, duid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgChatRecall, has_time),
+    offsetof(MesgChatRecall, time),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_blacklist_del_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_chat_recall__field_indices_by_name[] = {
+  3,   /* field[3] = duid */
+  2,   /* field[2] = msgid */
+  1,   /* field[1] = sid */
+  0,   /* field[0] = suid */
+  4,   /* field[4] = time */
 };
-static const ProtobufCIntRange mesg_blacklist_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_chat_recall__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 5 }
 };
-const ProtobufCMessageDescriptor mesg_blacklist_del_ack__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_blacklist_del_ack",
-  "MesgBlacklistDelAck",
-  "MesgBlacklistDelAck",
-  "",
-  sizeof(MesgBlacklistDelAck),
-  2,
-  mesg_blacklist_del_ack__field_descriptors,
-  mesg_blacklist_del_ack__field_indices_by_name,
-  1,  mesg_blacklist_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_blacklist_del_ack__init,
+const ProtobufCMessageDescriptor mesg_chat_recall__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_chat_recall",
+  "MesgChatRecall",
+  "MesgChatRecall",
+  "",
+  sizeof(MesgChatRecall),
+  5,
+  mesg_chat_recall__field_descriptors,
+  mesg_chat_recall__field_indices_by_name,
+  1,  mesg_chat_recall__number_ranges,
+  (ProtobufCMessageInit) mesg_chat_recall__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_gag_add__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_chat_recall_ack__field_descriptors[5] =
 {
   {
     "suid",
@@ -4954,58 +7435,43 @@ static const ProtobufCFieldDescriptor mesg_gag_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGagAdd, suid),
+    offsetof(MesgChatRecallAck, suid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "sid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGagAdd, duid),
+    offsetof(MesgChatRecallAck, sid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgChatRecallAck, msgid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_gag_add__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
-};
-static const ProtobufCIntRange mesg_gag_add__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_gag_add__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_gag_add",
-  "MesgGagAdd",
-  "MesgGagAdd",
-  "",
-  sizeof(MesgGagAdd),
-  2,
-  mesg_gag_add__field_descriptors,
-  mesg_gag_add__field_indices_by_name,
-  1,  mesg_gag_add__number_ranges,
-  (ProtobufCMessageInit) mesg_gag_add__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_gag_add_ack__field_descriptors[2] =
-{
   {
     "code",
-    1,
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGagAddAck, code),
+    offsetof(MesgChatRecallAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5013,93 +7479,122 @@ static const ProtobufCFieldDescriptor mesg_gag_add_ack__field_descriptors[2] =
   },
   {
     "errmsg",
-    2,
+    5,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGagAddAck, errmsg),
+    offsetof(MesgChatRecallAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_gag_add_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_chat_recall_ack__field_indices_by_name[] = {
+  3,   /* field[3] = code */
+  4,   /* field[4] = errmsg */
+  2,   /* field[2] = msgid */
+  1,   /* field[1] = sid */
+  0,   /* field[0] = suid */
 };
-static const ProtobufCIntRange mesg_gag_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_chat_recall_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 5 }
 };
-const ProtobufCMessageDescriptor mesg_gag_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_chat_recall_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_gag_add_ack",
-  "MesgGagAddAck",
-  "MesgGagAddAck",
+  "mesg_chat_recall_ack",
+  "MesgChatRecallAck",
+  "MesgChatRecallAck",
   "",
-  sizeof(MesgGagAddAck),
-  2,
-  mesg_gag_add_ack__field_descriptors,
-  mesg_gag_add_ack__field_indices_by_name,
-  1,  mesg_gag_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_gag_add_ack__init,
+  sizeof(MesgChatRecallAck),
+  5,
+  mesg_chat_recall_ack__field_descriptors,
+  mesg_chat_recall_ack__field_indices_by_name,
+  1,  mesg_chat_recall_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_chat_recall_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_gag_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_creat__field_descriptors[4] =
 {
   {
-    "suid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGagDel, suid),
+    offsetof(MesgGroupCreat, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "gid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGagDel, duid),
+    offsetof(MesgGroupCreat, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "name",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupCreat, name),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "desc",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupCreat, desc),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_gag_del__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
+static const unsigned mesg_group_creat__field_indices_by_name[] = {
+  3,   /* field[3] = desc */
+  1,   /* field[1] = gid */
+  2,   /* field[2] = name */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_gag_del__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_creat__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_gag_del__descriptor =
+const ProtobufCMessageDescriptor mesg_group_creat__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_gag_del",
-  "MesgGagDel",
-  "MesgGagDel",
+  "mesg_group_creat",
+  "MesgGroupCreat",
+  "MesgGroupCreat",
   "",
-  sizeof(MesgGagDel),
-  2,
-  mesg_gag_del__field_descriptors,
-  mesg_gag_del__field_indices_by_name,
-  1,  mesg_gag_del__number_ranges,
-  (ProtobufCMessageInit) mesg_gag_del__init,
+  sizeof(MesgGroupCreat),
+  4,
+  mesg_group_creat__field_descriptors,
+  mesg_group_creat__field_indices_by_name,
+  1,  mesg_group_creat__number_ranges,
+  (ProtobufCMessageInit) mesg_group_creat__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_gag_del_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_creat_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5107,7 +7602,7 @@ static const ProtobufCFieldDescriptor mesg_gag_del_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGagDelAck, code),
+    offsetof(MesgGroupCreatAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5119,102 +7614,89 @@ static const ProtobufCFieldDescriptor mesg_gag_del_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGagDelAck, errmsg),
+    offsetof(MesgGroupCreatAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_gag_del_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_creat_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_gag_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_creat_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_gag_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_creat_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_gag_del_ack",
-  "MesgGagDelAck",
-  "MesgGagDelAck",
+  "mesg_group_creat_ack",
+  "MesgGroupCreatAck",
+  "MesgGroupCreatAck",
   "",
-  sizeof(MesgGagDelAck),
+  sizeof(MesgGroupCreatAck),
   2,
-  mesg_gag_del_ack__field_descriptors,
-  mesg_gag_del_ack__field_indices_by_name,
-  1,  mesg_gag_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_gag_del_ack__init,
+  mesg_group_creat_ack__field_descriptors,
+  mesg_group_creat_ack__field_indices_by_name,
+  1,  mesg_group_creat_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_creat_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_mark_add__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_group_dismiss__field_descriptors[2] =
 {
   {
-    "suid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkAdd, suid),
+    offsetof(MesgGroupDismiss, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "gid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkAdd, duid),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "mark",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
-    0,   /* quantifier_offset */
-    offsetof(MesgMarkAdd, mark),
+    offsetof(MesgGroupDismiss, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_mark_add__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  2,   /* field[2] = mark */
-  0,   /* field[0] = suid */
+static const unsigned mesg_group_dismiss__field_indices_by_name[] = {
+  1,   /* field[1] = gid */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_mark_add__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_dismiss__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_mark_add__descriptor =
+const ProtobufCMessageDescriptor mesg_group_dismiss__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_mark_add",
-  "MesgMarkAdd",
-  "MesgMarkAdd",
+  "mesg_group_dismiss",
+  "MesgGroupDismiss",
+  "MesgGroupDismiss",
   "",
-  sizeof(MesgMarkAdd),
-  3,
-  mesg_mark_add__field_descriptors,
-  mesg_mark_add__field_indices_by_name,
-  1,  mesg_mark_add__number_ranges,
-  (ProtobufCMessageInit) mesg_mark_add__init,
+  sizeof(MesgGroupDismiss),
+  2,
+  mesg_group_dismiss__field_descriptors,
+  mesg_group_dismiss__field_indices_by_name,
+  1,  mesg_group_dismiss__number_ranges,
+  (ProtobufCMessageInit) mesg_group_dismiss__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_mark_add_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_dismiss_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5222,7 +7704,7 @@ static const ProtobufCFieldDescriptor mesg_mark_add_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkAddAck, code),
+    offsetof(MesgGroupDismissAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5234,89 +7716,89 @@ static const ProtobufCFieldDescriptor mesg_mark_add_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkAddAck, errmsg),
+    offsetof(MesgGroupDismissAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_mark_add_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_dismiss_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_mark_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_dismiss_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_mark_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_dismiss_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_mark_add_ack",
-  "MesgMarkAddAck",
-  "MesgMarkAddAck",
+  "mesg_group_dismiss_ack",
+  "MesgGroupDismissAck",
+  "MesgGroupDismissAck",
   "",
-  sizeof(MesgMarkAddAck),
+  sizeof(MesgGroupDismissAck),
   2,
-  mesg_mark_add_ack__field_descriptors,
-  mesg_mark_add_ack__field_indices_by_name,
-  1,  mesg_mark_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_mark_add_ack__init,
+  mesg_group_dismiss_ack__field_descriptors,
+  mesg_group_dismiss_ack__field_indices_by_name,
+  1,  mesg_group_dismiss_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_dismiss_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_mark_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_join__field_descriptors[2] =
 {
   {
-    "suid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkDel, suid),
+    offsetof(MesgGroupJoin, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "duid",
+    "gid",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkDel, duid),
+    offsetof(MesgGroupJoin, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_mark_del__field_indices_by_name[] = {
-  1,   /* field[1] = duid */
-  0,   /* field[0] = suid */
+static const unsigned mesg_group_join__field_indices_by_name[] = {
+  1,   /* field[1] = gid */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_mark_del__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_join__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_mark_del__descriptor =
+const ProtobufCMessageDescriptor mesg_group_join__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_mark_del",
-  "MesgMarkDel",
-  "MesgMarkDel",
+  "mesg_group_join",
+  "MesgGroupJoin",
+  "MesgGroupJoin",
   "",
-  sizeof(MesgMarkDel),
+  sizeof(MesgGroupJoin),
   2,
-  mesg_mark_del__field_descriptors,
-  mesg_mark_del__field_indices_by_name,
-  1,  mesg_mark_del__number_ranges,
-  (ProtobufCMessageInit) mesg_mark_del__init,
+  mesg_group_join__field_descriptors,
+  mesg_group_join__field_indices_by_name,
+  1,  mesg_group_join__number_ranges,
+  (ProtobufCMessageInit) mesg_group_join__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_mark_del_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_join_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5324,7 +7806,7 @@ static const ProtobufCFieldDescriptor mesg_mark_del_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkDelAck, code),
+    offsetof(MesgGroupJoinAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5336,38 +7818,38 @@ static const ProtobufCFieldDescriptor mesg_mark_del_ack__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgMarkDelAck, errmsg),
+    offsetof(MesgGroupJoinAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_mark_del_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_join_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_mark_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_join_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_mark_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_join_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_mark_del_ack",
-  "MesgMarkDelAck",
-  "MesgMarkDelAck",
+  "mesg_group_join_ack",
+  "MesgGroupJoinAck",
+  "MesgGroupJoinAck",
   "",
-  sizeof(MesgMarkDelAck),
+  sizeof(MesgGroupJoinAck),
   2,
-  mesg_mark_del_ack__field_descriptors,
-  mesg_mark_del_ack__field_indices_by_name,
-  1,  mesg_mark_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_mark_del_ack__init,
+  mesg_group_join_ack__field_descriptors,
+  mesg_group_join_ack__field_indices_by_name,
+  1,  mesg_group_join_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_join_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_creat__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mesg_group_quit__field_descriptors[2] =
 {
   {
     "uid",
@@ -5375,7 +7857,7 @@ static const ProtobufCFieldDescriptor mesg_group_creat__field_descriptors[4] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupCreat, uid),
+    offsetof(MesgGroupQuit, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -5387,64 +7869,38 @@ static const ProtobufCFieldDescriptor mesg_group_creat__field_descriptors[4] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupCreat, gid),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "name",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
-    0,   /* quantifier_offset */
-    offsetof(MesgGroupCreat, name),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "desc",
-    4,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
-    0,   /* quantifier_offset */
-    offsetof(MesgGroupCreat, desc),
+    offsetof(MesgGroupQuit, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_creat__field_indices_by_name[] = {
-  3,   /* field[3] = desc */
+static const unsigned mesg_group_quit__field_indices_by_name[] = {
   1,   /* field[1] = gid */
-  2,   /* field[2] = name */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_creat__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_quit__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_creat__descriptor =
+const ProtobufCMessageDescriptor mesg_group_quit__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_creat",
-  "MesgGroupCreat",
-  "MesgGroupCreat",
+  "mesg_group_quit",
+  "MesgGroupQuit",
+  "MesgGroupQuit",
   "",
-  sizeof(MesgGroupCreat),
-  4,
-  mesg_group_creat__field_descriptors,
-  mesg_group_creat__field_indices_by_name,
-  1,  mesg_group_creat__number_ranges,
-  (ProtobufCMessageInit) mesg_group_creat__init,
+  sizeof(MesgGroupQuit),
+  2,
+  mesg_group_quit__field_descriptors,
+  mesg_group_quit__field_indices_by_name,
+  1,  mesg_group_quit__number_ranges,
+  (ProtobufCMessageInit) mesg_group_quit__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_creat_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_quit_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5452,7 +7908,7 @@ static const ProtobufCFieldDescriptor mesg_group_creat_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupCreatAck, code),
+    offsetof(MesgGroupQuitAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5464,38 +7920,38 @@ static const ProtobufCFieldDescriptor mesg_group_creat_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupCreatAck, errmsg),
+    offsetof(MesgGroupQuitAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_creat_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_quit_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_creat_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_quit_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_creat_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_quit_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_creat_ack",
-  "MesgGroupCreatAck",
-  "MesgGroupCreatAck",
+  "mesg_group_quit_ack",
+  "MesgGroupQuitAck",
+  "MesgGroupQuitAck",
   "",
-  sizeof(MesgGroupCreatAck),
+  sizeof(MesgGroupQuitAck),
   2,
-  mesg_group_creat_ack__field_descriptors,
-  mesg_group_creat_ack__field_indices_by_name,
-  1,  mesg_group_creat_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_creat_ack__init,
+  mesg_group_quit_ack__field_descriptors,
+  mesg_group_quit_ack__field_indices_by_name,
+  1,  mesg_group_quit_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_quit_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_dismiss__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_invite__field_descriptors[3] =
 {
   {
     "uid",
@@ -5503,7 +7959,7 @@ static const ProtobufCFieldDescriptor mesg_group_dismiss__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupDismiss, uid),
+    offsetof(MesgGroupInvite, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -5515,38 +7971,51 @@ static const ProtobufCFieldDescriptor mesg_group_dismiss__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupDismiss, gid),
+    offsetof(MesgGroupInvite, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "to",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupInvite, to),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_dismiss__field_indices_by_name[] = {
+static const unsigned mesg_group_invite__field_indices_by_name[] = {
   1,   /* field[1] = gid */
+  2,   /* field[2] = to */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_dismiss__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_invite__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 3 }
 };
-const ProtobufCMessageDescriptor mesg_group_dismiss__descriptor =
+const ProtobufCMessageDescriptor mesg_group_invite__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_dismiss",
-  "MesgGroupDismiss",
-  "MesgGroupDismiss",
+  "mesg_group_invite",
+  "MesgGroupInvite",
+  "MesgGroupInvite",
   "",
-  sizeof(MesgGroupDismiss),
-  2,
-  mesg_group_dismiss__field_descriptors,
-  mesg_group_dismiss__field_indices_by_name,
-  1,  mesg_group_dismiss__number_ranges,
-  (ProtobufCMessageInit) mesg_group_dismiss__init,
+  sizeof(MesgGroupInvite),
+  3,
+  mesg_group_invite__field_descriptors,
+  mesg_group_invite__field_indices_by_name,
+  1,  mesg_group_invite__number_ranges,
+  (ProtobufCMessageInit) mesg_group_invite__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_dismiss_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_invite_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5554,7 +8023,7 @@ static const ProtobufCFieldDescriptor mesg_group_dismiss_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupDismissAck, code),
+    offsetof(MesgGroupInviteAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5566,38 +8035,38 @@ static const ProtobufCFieldDescriptor mesg_group_dismiss_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupDismissAck, errmsg),
+    offsetof(MesgGroupInviteAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_dismiss_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_invite_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_dismiss_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_invite_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_dismiss_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_invite_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_dismiss_ack",
-  "MesgGroupDismissAck",
-  "MesgGroupDismissAck",
+  "mesg_group_invite_ack",
+  "MesgGroupInviteAck",
+  "MesgGroupInviteAck",
   "",
-  sizeof(MesgGroupDismissAck),
+  sizeof(MesgGroupInviteAck),
   2,
-  mesg_group_dismiss_ack__field_descriptors,
-  mesg_group_dismiss_ack__field_indices_by_name,
-  1,  mesg_group_dismiss_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_dismiss_ack__init,
+  mesg_group_invite_ack__field_descriptors,
+  mesg_group_invite_ack__field_indices_by_name,
+  1,  mesg_group_invite_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_invite_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_join__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_chat__field_descriptors[9] =
 {
   {
     "uid",
@@ -5605,7 +8074,7 @@ static const ProtobufCFieldDescriptor mesg_group_join__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupJoin, uid),
+    offsetof(MesgGroupChat, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -5617,140 +8086,129 @@ static const ProtobufCFieldDescriptor mesg_group_join__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupJoin, gid),
+    offsetof(MesgGroupChat, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "level",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupChat, level),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_group_join__field_indices_by_name[] = {
-  1,   /* field[1] = gid */
-  0,   /* field[0] = uid */
-};
-static const ProtobufCIntRange mesg_group_join__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_group_join__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_join",
-  "MesgGroupJoin",
-  "MesgGroupJoin",
-  "",
-  sizeof(MesgGroupJoin),
-  2,
-  mesg_group_join__field_descriptors,
-  mesg_group_join__field_indices_by_name,
-  1,  mesg_group_join__number_ranges,
-  (ProtobufCMessageInit) mesg_group_join__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_group_join_ack__field_descriptors[2] =
-{
   {
-    "code",
-    1,
+    "time",
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupJoinAck, code),
+    offsetof(MesgGroupChat, time),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
-    2,
+    "text",
+    5,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupJoinAck, errmsg),
+    offsetof(MesgGroupChat, text),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
-};
-static const unsigned mesg_group_join_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
-};
-static const ProtobufCIntRange mesg_group_join_ack__number_ranges[1 + 1] =
-{
-  { 1, 0 },
-  { 0, 2 }
-};
-const ProtobufCMessageDescriptor mesg_group_join_ack__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_join_ack",
-  "MesgGroupJoinAck",
-  "MesgGroupJoinAck",
-  "",
-  sizeof(MesgGroupJoinAck),
-  2,
-  mesg_group_join_ack__field_descriptors,
-  mesg_group_join_ack__field_indices_by_name,
-  1,  mesg_group_join_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_join_ack__init,
-  NULL,NULL,NULL    /* reserved[123] */
-};
-static const ProtobufCFieldDescriptor mesg_group_quit__field_descriptors[2] =
-{
   {
-    "uid",
-    1,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
-    0,   /* quantifier_offset */
-    offsetof(MesgGroupQuit, uid),
+    "data",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_BYTES,
+    offsetof(MesgGroupChat, has_data),
+    offsetof(MesgGroupChat, data),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "gid",
-    2,
-    PROTOBUF_C_LABEL_REQUIRED,
+    "msgid",
+    7,
+    PROTOBUF_C_LABEL_OPTIONAL,
     PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupChat, has_msgid),
+    offsetof(MesgGroupChat, msgid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "revoked",
+    8,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgGroupChat, has_revoked),
+    offsetof(MesgGroupChat, revoked),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "at",
+    9,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupQuit, gid),
+    offsetof(MesgGroupChat, at),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_quit__field_indices_by_name[] = {
+static const unsigned mesg_group_chat__field_indices_by_name[] = {
+  8,   /* field[8] = at */
+  5,   /* field[5] = data */
   1,   /* field[1] = gid */
+  2,   /* field[2] = level */
+  6,   /* field[6] = msgid */
+  7,   /* field[7] = revoked */
+  4,   /* field[4] = text */
+  3,   /* field[3] = time */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_quit__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_chat__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 9 }
 };
-const ProtobufCMessageDescriptor mesg_group_quit__descriptor =
+const ProtobufCMessageDescriptor mesg_group_chat__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_quit",
-  "MesgGroupQuit",
-  "MesgGroupQuit",
+  "mesg_group_chat",
+  "MesgGroupChat",
+  "MesgGroupChat",
   "",
-  sizeof(MesgGroupQuit),
-  2,
-  mesg_group_quit__field_descriptors,
-  mesg_group_quit__field_indices_by_name,
-  1,  mesg_group_quit__number_ranges,
-  (ProtobufCMessageInit) mesg_group_quit__init,
+  sizeof(MesgGroupChat),
+  9,
+  mesg_group_chat__field_descriptors,
+  mesg_group_chat__field_indices_by_name,
+  1,  mesg_group_chat__number_ranges,
+  (ProtobufCMessageInit) mesg_group_chat__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_quit_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_chat_ack__field_descriptors[3] =
 {
   {
     "code",
@@ -5758,7 +8216,7 @@ static const ProtobufCFieldDescriptor mesg_group_quit_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupQuitAck, code),
+    offsetof(MesgGroupChatAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5770,38 +8228,51 @@ static const ProtobufCFieldDescriptor mesg_group_quit_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupQuitAck, errmsg),
+    offsetof(MesgGroupChatAck, errmsg),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupChatAck, has_msgid),
+    offsetof(MesgGroupChatAck, msgid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_quit_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_chat_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
+  2,   /* field[2] = msgid */
 };
-static const ProtobufCIntRange mesg_group_quit_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_chat_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 3 }
 };
-const ProtobufCMessageDescriptor mesg_group_quit_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_chat_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_quit_ack",
-  "MesgGroupQuitAck",
-  "MesgGroupQuitAck",
+  "mesg_group_chat_ack",
+  "MesgGroupChatAck",
+  "MesgGroupChatAck",
   "",
-  sizeof(MesgGroupQuitAck),
-  2,
-  mesg_group_quit_ack__field_descriptors,
-  mesg_group_quit_ack__field_indices_by_name,
-  1,  mesg_group_quit_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_quit_ack__init,
+  sizeof(MesgGroupChatAck),
+  3,
+  mesg_group_chat_ack__field_descriptors,
+  mesg_group_chat_ack__field_indices_by_name,
+  1,  mesg_group_chat_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_chat_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_invite__field_descriptors[3] =
+static const ProtobufCFieldDescriptor mesg_group_kick__field_descriptors[2] =
 {
   {
     "uid",
@@ -5809,7 +8280,7 @@ static const ProtobufCFieldDescriptor mesg_group_invite__field_descriptors[3] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupInvite, uid),
+    offsetof(MesgGroupKick, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -5821,51 +8292,38 @@ static const ProtobufCFieldDescriptor mesg_group_invite__field_descriptors[3] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupInvite, gid),
-    NULL,
-    NULL,
-    0,             /* flags */
-    0,NULL,NULL    /* reserved1,reserved2, etc */
-  },
-  {
-    "to",
-    3,
-    PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
-    0,   /* quantifier_offset */
-    offsetof(MesgGroupInvite, to),
+    offsetof(MesgGroupKick, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_invite__field_indices_by_name[] = {
+static const unsigned mesg_group_kick__field_indices_by_name[] = {
   1,   /* field[1] = gid */
-  2,   /* field[2] = to */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_invite__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_kick__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_invite__descriptor =
+const ProtobufCMessageDescriptor mesg_group_kick__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_invite",
-  "MesgGroupInvite",
-  "MesgGroupInvite",
+  "mesg_group_kick",
+  "MesgGroupKick",
+  "MesgGroupKick",
   "",
-  sizeof(MesgGroupInvite),
-  3,
-  mesg_group_invite__field_descriptors,
-  mesg_group_invite__field_indices_by_name,
-  1,  mesg_group_invite__number_ranges,
-  (ProtobufCMessageInit) mesg_group_invite__init,
+  sizeof(MesgGroupKick),
+  2,
+  mesg_group_kick__field_descriptors,
+  mesg_group_kick__field_indices_by_name,
+  1,  mesg_group_kick__number_ranges,
+  (ProtobufCMessageInit) mesg_group_kick__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_invite_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_kick_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -5873,7 +8331,7 @@ static const ProtobufCFieldDescriptor mesg_group_invite_ack__field_descriptors[2
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupInviteAck, code),
+    offsetof(MesgGroupKickAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -5885,38 +8343,38 @@ static const ProtobufCFieldDescriptor mesg_group_invite_ack__field_descriptors[2
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupInviteAck, errmsg),
+    offsetof(MesgGroupKickAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_invite_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_kick_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_invite_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_kick_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_invite_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_kick_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_invite_ack",
-  "MesgGroupInviteAck",
-  "MesgGroupInviteAck",
+  "mesg_group_kick_ack",
+  "MesgGroupKickAck",
+  "MesgGroupKickAck",
   "",
-  sizeof(MesgGroupInviteAck),
+  sizeof(MesgGroupKickAck),
   2,
-  mesg_group_invite_ack__field_descriptors,
-  mesg_group_invite_ack__field_indices_by_name,
-  1,  mesg_group_invite_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_invite_ack__init,
+  mesg_group_kick_ack__field_descriptors,
+  mesg_group_kick_ack__field_indices_by_name,
+  1,  mesg_group_kick_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_kick_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_chat__field_descriptors[6] =
+static const ProtobufCFieldDescriptor mesg_group_gag_add__field_descriptors[2] =
 {
   {
     "uid",
@@ -5924,7 +8382,7 @@ static const ProtobufCFieldDescriptor mesg_group_chat__field_descriptors[6] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChat, uid),
+    offsetof(MesgGroupGagAdd, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -5936,90 +8394,140 @@ static const ProtobufCFieldDescriptor mesg_group_chat__field_descriptors[6] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChat, gid),
+    offsetof(MesgGroupGagAdd, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_group_gag_add__field_indices_by_name[] = {
+  1,   /* field[1] = gid */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_group_gag_add__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_group_gag_add__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_group_gag_add",
+  "MesgGroupGagAdd",
+  "MesgGroupGagAdd",
+  "",
+  sizeof(MesgGroupGagAdd),
+  2,
+  mesg_group_gag_add__field_descriptors,
+  mesg_group_gag_add__field_indices_by_name,
+  1,  mesg_group_gag_add__number_ranges,
+  (ProtobufCMessageInit) mesg_group_gag_add__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_group_gag_add_ack__field_descriptors[2] =
+{
   {
-    "level",
-    3,
+    "code",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChat, level),
+    offsetof(MesgGroupGagAddAck, code),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "time",
-    4,
+    "errmsg",
+    2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChat, time),
+    offsetof(MesgGroupGagAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+};
+static const unsigned mesg_group_gag_add_ack__field_indices_by_name[] = {
+  0,   /* field[0] = code */
+  1,   /* field[1] = errmsg */
+};
+static const ProtobufCIntRange mesg_group_gag_add_ack__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_group_gag_add_ack__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_group_gag_add_ack",
+  "MesgGroupGagAddAck",
+  "MesgGroupGagAddAck",
+  "",
+  sizeof(MesgGroupGagAddAck),
+  2,
+  mesg_group_gag_add_ack__field_descriptors,
+  mesg_group_gag_add_ack__field_indices_by_name,
+  1,  mesg_group_gag_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_gag_add_ack__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mesg_group_gag_del__field_descriptors[2] =
+{
   {
-    "text",
-    5,
+    "uid",
+    1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_STRING,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChat, text),
+    offsetof(MesgGroupGagDel, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "data",
-    6,
-    PROTOBUF_C_LABEL_OPTIONAL,
-    PROTOBUF_C_TYPE_BYTES,
-    offsetof(MesgGroupChat, has_data),
-    offsetof(MesgGroupChat, data),
+    "gid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupGagDel, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_chat__field_indices_by_name[] = {
-  5,   /* field[5] = data */
+static const unsigned mesg_group_gag_del__field_indices_by_name[] = {
   1,   /* field[1] = gid */
-  2,   /* field[2] = level */
-  4,   /* field[4] = text */
-  3,   /* field[3] = time */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_chat__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_gag_del__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 6 }
+  { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_chat__descriptor =
+const ProtobufCMessageDescriptor mesg_group_gag_del__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_chat",
-  "MesgGroupChat",
-  "MesgGroupChat",
+  "mesg_group_gag_del",
+  "MesgGroupGagDel",
+  "MesgGroupGagDel",
   "",
-  sizeof(MesgGroupChat),
-  6,
-  mesg_group_chat__field_descriptors,
-  mesg_group_chat__field_indices_by_name,
-  1,  mesg_group_chat__number_ranges,
-  (ProtobufCMessageInit) mesg_group_chat__init,
+  sizeof(MesgGroupGagDel),
+  2,
+  mesg_group_gag_del__field_descriptors,
+  mesg_group_gag_del__field_indices_by_name,
+  1,  mesg_group_gag_del__number_ranges,
+  (ProtobufCMessageInit) mesg_group_gag_del__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_chat_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_gag_del_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6027,7 +8535,7 @@ static const ProtobufCFieldDescriptor mesg_group_chat_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChatAck, code),
+    offsetof(MesgGroupGagDelAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6039,38 +8547,38 @@ static const ProtobufCFieldDescriptor mesg_group_chat_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupChatAck, errmsg),
+    offsetof(MesgGroupGagDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_chat_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_gag_del_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_chat_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_gag_del_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_chat_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_gag_del_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_chat_ack",
-  "MesgGroupChatAck",
-  "MesgGroupChatAck",
+  "mesg_group_gag_del_ack",
+  "MesgGroupGagDelAck",
+  "MesgGroupGagDelAck",
   "",
-  sizeof(MesgGroupChatAck),
+  sizeof(MesgGroupGagDelAck),
   2,
-  mesg_group_chat_ack__field_descriptors,
-  mesg_group_chat_ack__field_indices_by_name,
-  1,  mesg_group_chat_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_chat_ack__init,
+  mesg_group_gag_del_ack__field_descriptors,
+  mesg_group_gag_del_ack__field_indices_by_name,
+  1,  mesg_group_gag_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_gag_del_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_kick__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_bl_add__field_descriptors[2] =
 {
   {
     "uid",
@@ -6078,7 +8586,7 @@ static const ProtobufCFieldDescriptor mesg_group_kick__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupKick, uid),
+    offsetof(MesgGroupBlAdd, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6090,38 +8598,38 @@ static const ProtobufCFieldDescriptor mesg_group_kick__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupKick, gid),
+    offsetof(MesgGroupBlAdd, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_kick__field_indices_by_name[] = {
+static const unsigned mesg_group_bl_add__field_indices_by_name[] = {
   1,   /* field[1] = gid */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_kick__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_bl_add__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_kick__descriptor =
+const ProtobufCMessageDescriptor mesg_group_bl_add__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_kick",
-  "MesgGroupKick",
-  "MesgGroupKick",
+  "mesg_group_bl_add",
+  "MesgGroupBlAdd",
+  "MesgGroupBlAdd",
   "",
-  sizeof(MesgGroupKick),
+  sizeof(MesgGroupBlAdd),
   2,
-  mesg_group_kick__field_descriptors,
-  mesg_group_kick__field_indices_by_name,
-  1,  mesg_group_kick__number_ranges,
-  (ProtobufCMessageInit) mesg_group_kick__init,
+  mesg_group_bl_add__field_descriptors,
+  mesg_group_bl_add__field_indices_by_name,
+  1,  mesg_group_bl_add__number_ranges,
+  (ProtobufCMessageInit) mesg_group_bl_add__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_kick_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_bl_add_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6129,7 +8637,7 @@ static const ProtobufCFieldDescriptor mesg_group_kick_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupKickAck, code),
+    offsetof(MesgGroupBlAddAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6141,38 +8649,38 @@ static const ProtobufCFieldDescriptor mesg_group_kick_ack__field_descriptors[2]
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupKickAck, errmsg),
+    offsetof(MesgGroupBlAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_kick_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_bl_add_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_kick_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_bl_add_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_kick_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_bl_add_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_kick_ack",
-  "MesgGroupKickAck",
-  "MesgGroupKickAck",
+  "mesg_group_bl_add_ack",
+  "MesgGroupBlAddAck",
+  "MesgGroupBlAddAck",
   "",
-  sizeof(MesgGroupKickAck),
+  sizeof(MesgGroupBlAddAck),
   2,
-  mesg_group_kick_ack__field_descriptors,
-  mesg_group_kick_ack__field_indices_by_name,
-  1,  mesg_group_kick_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_kick_ack__init,
+  mesg_group_bl_add_ack__field_descriptors,
+  mesg_group_bl_add_ack__field_indices_by_name,
+  1,  mesg_group_bl_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_bl_add_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_gag_add__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_bl_del__field_descriptors[2] =
 {
   {
     "uid",
@@ -6180,7 +8688,7 @@ static const ProtobufCFieldDescriptor mesg_group_gag_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagAdd, uid),
+    offsetof(MesgGroupBlDel, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6192,38 +8700,38 @@ static const ProtobufCFieldDescriptor mesg_group_gag_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagAdd, gid),
+    offsetof(MesgGroupBlDel, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_gag_add__field_indices_by_name[] = {
+static const unsigned mesg_group_bl_del__field_indices_by_name[] = {
   1,   /* field[1] = gid */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_gag_add__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_bl_del__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_gag_add__descriptor =
+const ProtobufCMessageDescriptor mesg_group_bl_del__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_gag_add",
-  "MesgGroupGagAdd",
-  "MesgGroupGagAdd",
+  "mesg_group_bl_del",
+  "MesgGroupBlDel",
+  "MesgGroupBlDel",
   "",
-  sizeof(MesgGroupGagAdd),
+  sizeof(MesgGroupBlDel),
   2,
-  mesg_group_gag_add__field_descriptors,
-  mesg_group_gag_add__field_indices_by_name,
-  1,  mesg_group_gag_add__number_ranges,
-  (ProtobufCMessageInit) mesg_group_gag_add__init,
+  mesg_group_bl_del__field_descriptors,
+  mesg_group_bl_del__field_indices_by_name,
+  1,  mesg_group_bl_del__number_ranges,
+  (ProtobufCMessageInit) mesg_group_bl_del__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_gag_add_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_bl_del_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6231,7 +8739,7 @@ static const ProtobufCFieldDescriptor mesg_group_gag_add_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagAddAck, code),
+    offsetof(MesgGroupBlDelAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6243,38 +8751,38 @@ static const ProtobufCFieldDescriptor mesg_group_gag_add_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagAddAck, errmsg),
+    offsetof(MesgGroupBlDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_gag_add_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_bl_del_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_gag_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_bl_del_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_gag_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_bl_del_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_gag_add_ack",
-  "MesgGroupGagAddAck",
-  "MesgGroupGagAddAck",
+  "mesg_group_bl_del_ack",
+  "MesgGroupBlDelAck",
+  "MesgGroupBlDelAck",
   "",
-  sizeof(MesgGroupGagAddAck),
+  sizeof(MesgGroupBlDelAck),
   2,
-  mesg_group_gag_add_ack__field_descriptors,
-  mesg_group_gag_add_ack__field_indices_by_name,
-  1,  mesg_group_gag_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_gag_add_ack__init,
+  mesg_group_bl_del_ack__field_descriptors,
+  mesg_group_bl_del_ack__field_indices_by_name,
+  1,  mesg_group_bl_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_bl_del_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_gag_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_mgr_add__field_descriptors[2] =
 {
   {
     "uid",
@@ -6282,7 +8790,7 @@ static const ProtobufCFieldDescriptor mesg_group_gag_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagDel, uid),
+    offsetof(MesgGroupMgrAdd, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6294,38 +8802,38 @@ static const ProtobufCFieldDescriptor mesg_group_gag_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagDel, gid),
+    offsetof(MesgGroupMgrAdd, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_gag_del__field_indices_by_name[] = {
+static const unsigned mesg_group_mgr_add__field_indices_by_name[] = {
   1,   /* field[1] = gid */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_gag_del__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_mgr_add__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_gag_del__descriptor =
+const ProtobufCMessageDescriptor mesg_group_mgr_add__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_gag_del",
-  "MesgGroupGagDel",
-  "MesgGroupGagDel",
+  "mesg_group_mgr_add",
+  "MesgGroupMgrAdd",
+  "MesgGroupMgrAdd",
   "",
-  sizeof(MesgGroupGagDel),
+  sizeof(MesgGroupMgrAdd),
   2,
-  mesg_group_gag_del__field_descriptors,
-  mesg_group_gag_del__field_indices_by_name,
-  1,  mesg_group_gag_del__number_ranges,
-  (ProtobufCMessageInit) mesg_group_gag_del__init,
+  mesg_group_mgr_add__field_descriptors,
+  mesg_group_mgr_add__field_indices_by_name,
+  1,  mesg_group_mgr_add__number_ranges,
+  (ProtobufCMessageInit) mesg_group_mgr_add__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_gag_del_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_mgr_add_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6333,7 +8841,7 @@ static const ProtobufCFieldDescriptor mesg_group_gag_del_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagDelAck, code),
+    offsetof(MesgGroupMgrAddAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6345,38 +8853,38 @@ static const ProtobufCFieldDescriptor mesg_group_gag_del_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupGagDelAck, errmsg),
+    offsetof(MesgGroupMgrAddAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_gag_del_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_mgr_add_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_gag_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_mgr_add_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_gag_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_mgr_add_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_gag_del_ack",
-  "MesgGroupGagDelAck",
-  "MesgGroupGagDelAck",
+  "mesg_group_mgr_add_ack",
+  "MesgGroupMgrAddAck",
+  "MesgGroupMgrAddAck",
   "",
-  sizeof(MesgGroupGagDelAck),
+  sizeof(MesgGroupMgrAddAck),
   2,
-  mesg_group_gag_del_ack__field_descriptors,
-  mesg_group_gag_del_ack__field_indices_by_name,
-  1,  mesg_group_gag_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_gag_del_ack__init,
+  mesg_group_mgr_add_ack__field_descriptors,
+  mesg_group_mgr_add_ack__field_indices_by_name,
+  1,  mesg_group_mgr_add_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_mgr_add_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_bl_add__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_mgr_del__field_descriptors[2] =
 {
   {
     "uid",
@@ -6384,7 +8892,7 @@ static const ProtobufCFieldDescriptor mesg_group_bl_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlAdd, uid),
+    offsetof(MesgGroupMgrDel, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6396,38 +8904,38 @@ static const ProtobufCFieldDescriptor mesg_group_bl_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlAdd, gid),
+    offsetof(MesgGroupMgrDel, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_bl_add__field_indices_by_name[] = {
+static const unsigned mesg_group_mgr_del__field_indices_by_name[] = {
   1,   /* field[1] = gid */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_bl_add__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_mgr_del__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_bl_add__descriptor =
+const ProtobufCMessageDescriptor mesg_group_mgr_del__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_bl_add",
-  "MesgGroupBlAdd",
-  "MesgGroupBlAdd",
+  "mesg_group_mgr_del",
+  "MesgGroupMgrDel",
+  "MesgGroupMgrDel",
   "",
-  sizeof(MesgGroupBlAdd),
+  sizeof(MesgGroupMgrDel),
   2,
-  mesg_group_bl_add__field_descriptors,
-  mesg_group_bl_add__field_indices_by_name,
-  1,  mesg_group_bl_add__number_ranges,
-  (ProtobufCMessageInit) mesg_group_bl_add__init,
+  mesg_group_mgr_del__field_descriptors,
+  mesg_group_mgr_del__field_indices_by_name,
+  1,  mesg_group_mgr_del__number_ranges,
+  (ProtobufCMessageInit) mesg_group_mgr_del__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_bl_add_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_mgr_del_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6435,7 +8943,7 @@ static const ProtobufCFieldDescriptor mesg_group_bl_add_ack__field_descriptors[2
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlAddAck, code),
+    offsetof(MesgGroupMgrDelAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6447,140 +8955,205 @@ static const ProtobufCFieldDescriptor mesg_group_bl_add_ack__field_descriptors[2
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlAddAck, errmsg),
+    offsetof(MesgGroupMgrDelAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_bl_add_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_mgr_del_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_bl_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_mgr_del_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_bl_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_mgr_del_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_bl_add_ack",
-  "MesgGroupBlAddAck",
-  "MesgGroupBlAddAck",
+  "mesg_group_mgr_del_ack",
+  "MesgGroupMgrDelAck",
+  "MesgGroupMgrDelAck",
   "",
-  sizeof(MesgGroupBlAddAck),
+  sizeof(MesgGroupMgrDelAck),
   2,
-  mesg_group_bl_add_ack__field_descriptors,
-  mesg_group_bl_add_ack__field_indices_by_name,
-  1,  mesg_group_bl_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_bl_add_ack__init,
+  mesg_group_mgr_del_ack__field_descriptors,
+  mesg_group_mgr_del_ack__field_indices_by_name,
+  1,  mesg_group_mgr_del_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_mgr_del_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_bl_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_usr_list__field_descriptors[3] =
 {
   {
-    "uid",
+    "gid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlDel, uid),
+    offsetof(MesgGroupUsrList, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "gid",
+    "num",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT64,
+    PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlDel, gid),
+    offsetof(MesgGroupUsrList, num),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cursor",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupUsrList, has_cursor),
+    offsetof(MesgGroupUsrList, cursor),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_bl_del__field_indices_by_name[] = {
-  1,   /* field[1] = gid */
-  0,   /* field[0] = uid */
+static const unsigned mesg_group_usr_list__field_indices_by_name[] = {
+  2,   /* field[2] = cursor */
+  0,   /* field[0] = gid */
+  1,   /* field[1] = num */
 };
-static const ProtobufCIntRange mesg_group_bl_del__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_usr_list__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 3 }
 };
-const ProtobufCMessageDescriptor mesg_group_bl_del__descriptor =
+const ProtobufCMessageDescriptor mesg_group_usr_list__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_bl_del",
-  "MesgGroupBlDel",
-  "MesgGroupBlDel",
+  "mesg_group_usr_list",
+  "MesgGroupUsrList",
+  "MesgGroupUsrList",
   "",
-  sizeof(MesgGroupBlDel),
-  2,
-  mesg_group_bl_del__field_descriptors,
-  mesg_group_bl_del__field_indices_by_name,
-  1,  mesg_group_bl_del__number_ranges,
-  (ProtobufCMessageInit) mesg_group_bl_del__init,
+  sizeof(MesgGroupUsrList),
+  3,
+  mesg_group_usr_list__field_descriptors,
+  mesg_group_usr_list__field_indices_by_name,
+  1,  mesg_group_usr_list__number_ranges,
+  (ProtobufCMessageInit) mesg_group_usr_list__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_bl_del_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_usr_list_ack__field_descriptors[6] =
 {
   {
-    "code",
+    "gid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
-    PROTOBUF_C_TYPE_UINT32,
+    PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlDelAck, code),
+    offsetof(MesgGroupUsrListAck, gid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "errmsg",
+    "list",
     2,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupBlDelAck, errmsg),
+    offsetof(MesgGroupUsrListAck, list),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "next",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupUsrListAck, has_next),
+    offsetof(MesgGroupUsrListAck, next),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "total",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgGroupUsrListAck, has_total),
+    offsetof(MesgGroupUsrListAck, total),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    5,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgGroupUsrListAck, has_code),
+    offsetof(MesgGroupUsrListAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    6,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupUsrListAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_bl_del_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_group_usr_list_ack__field_indices_by_name[] = {
+  4,   /* field[4] = code */
+  5,   /* field[5] = errmsg */
+  0,   /* field[0] = gid */
+  1,   /* field[1] = list */
+  2,   /* field[2] = next */
+  3,   /* field[3] = total */
 };
-static const ProtobufCIntRange mesg_group_bl_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_usr_list_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 6 }
 };
-const ProtobufCMessageDescriptor mesg_group_bl_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_usr_list_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_bl_del_ack",
-  "MesgGroupBlDelAck",
-  "MesgGroupBlDelAck",
+  "mesg_group_usr_list_ack",
+  "MesgGroupUsrListAck",
+  "MesgGroupUsrListAck",
   "",
-  sizeof(MesgGroupBlDelAck),
-  2,
-  mesg_group_bl_del_ack__field_descriptors,
-  mesg_group_bl_del_ack__field_indices_by_name,
-  1,  mesg_group_bl_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_bl_del_ack__init,
+  sizeof(MesgGroupUsrListAck),
+  6,
+  mesg_group_usr_list_ack__field_descriptors,
+  mesg_group_usr_list_ack__field_indices_by_name,
+  1,  mesg_group_usr_list_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_usr_list_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_mgr_add__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_join_audit__field_descriptors[4] =
 {
   {
     "uid",
@@ -6588,7 +9161,7 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrAdd, uid),
+    offsetof(MesgGroupJoinAudit, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6600,38 +9173,64 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_add__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrAdd, gid),
+    offsetof(MesgGroupJoinAudit, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "to",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupJoinAudit, to),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "pass",
+    4,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupJoinAudit, pass),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_mgr_add__field_indices_by_name[] = {
+static const unsigned mesg_group_join_audit__field_indices_by_name[] = {
   1,   /* field[1] = gid */
+  3,   /* field[3] = pass */
+  2,   /* field[2] = to */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_mgr_add__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_join_audit__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_group_mgr_add__descriptor =
-{
-  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_mgr_add",
-  "MesgGroupMgrAdd",
-  "MesgGroupMgrAdd",
-  "",
-  sizeof(MesgGroupMgrAdd),
-  2,
-  mesg_group_mgr_add__field_descriptors,
-  mesg_group_mgr_add__field_indices_by_name,
-  1,  mesg_group_mgr_add__number_ranges,
-  (ProtobufCMessageInit) mesg_group_mgr_add__init,
+const ProtobufCMessageDescriptor mesg_group_join_audit__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_group_join_audit",
+  "MesgGroupJoinAudit",
+  "MesgGroupJoinAudit",
+  "",
+  sizeof(MesgGroupJoinAudit),
+  4,
+  mesg_group_join_audit__field_descriptors,
+  mesg_group_join_audit__field_indices_by_name,
+  1,  mesg_group_join_audit__number_ranges,
+  (ProtobufCMessageInit) mesg_group_join_audit__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_mgr_add_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_join_audit_ack__field_descriptors[2] =
 {
   {
     "code",
@@ -6639,7 +9238,7 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_add_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrAddAck, code),
+    offsetof(MesgGroupJoinAuditAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6651,38 +9250,38 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_add_ack__field_descriptors[
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrAddAck, errmsg),
+    offsetof(MesgGroupJoinAuditAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_mgr_add_ack__field_indices_by_name[] = {
+static const unsigned mesg_group_join_audit_ack__field_indices_by_name[] = {
   0,   /* field[0] = code */
   1,   /* field[1] = errmsg */
 };
-static const ProtobufCIntRange mesg_group_mgr_add_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_join_audit_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
   { 0, 2 }
 };
-const ProtobufCMessageDescriptor mesg_group_mgr_add_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_join_audit_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_mgr_add_ack",
-  "MesgGroupMgrAddAck",
-  "MesgGroupMgrAddAck",
+  "mesg_group_join_audit_ack",
+  "MesgGroupJoinAuditAck",
+  "MesgGroupJoinAuditAck",
   "",
-  sizeof(MesgGroupMgrAddAck),
+  sizeof(MesgGroupJoinAuditAck),
   2,
-  mesg_group_mgr_add_ack__field_descriptors,
-  mesg_group_mgr_add_ack__field_indices_by_name,
-  1,  mesg_group_mgr_add_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_mgr_add_ack__init,
+  mesg_group_join_audit_ack__field_descriptors,
+  mesg_group_join_audit_ack__field_indices_by_name,
+  1,  mesg_group_join_audit_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_join_audit_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_mgr_del__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_recall__field_descriptors[4] =
 {
   {
     "uid",
@@ -6690,7 +9289,7 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrDel, uid),
+    offsetof(MesgGroupRecall, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6702,46 +9301,96 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_del__field_descriptors[2] =
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrDel, gid),
+    offsetof(MesgGroupRecall, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupRecall, msgid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "time",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupRecall, has_time),
+    offsetof(MesgGroupRecall, time),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_mgr_del__field_indices_by_name[] = {
+static const unsigned mesg_group_recall__field_indices_by_name[] = {
   1,   /* field[1] = gid */
+  2,   /* field[2] = msgid */
+  3,   /* field[3] = time */
   0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_mgr_del__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_recall__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_group_mgr_del__descriptor =
+const ProtobufCMessageDescriptor mesg_group_recall__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_mgr_del",
-  "MesgGroupMgrDel",
-  "MesgGroupMgrDel",
+  "mesg_group_recall",
+  "MesgGroupRecall",
+  "MesgGroupRecall",
   "",
-  sizeof(MesgGroupMgrDel),
-  2,
-  mesg_group_mgr_del__field_descriptors,
-  mesg_group_mgr_del__field_indices_by_name,
-  1,  mesg_group_mgr_del__number_ranges,
-  (ProtobufCMessageInit) mesg_group_mgr_del__init,
+  sizeof(MesgGroupRecall),
+  4,
+  mesg_group_recall__field_descriptors,
+  mesg_group_recall__field_indices_by_name,
+  1,  mesg_group_recall__number_ranges,
+  (ProtobufCMessageInit) mesg_group_recall__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_mgr_del_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_recall_ack__field_descriptors[4] =
 {
   {
-    "code",
+    "gid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupRecallAck, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "msgid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupRecallAck, msgid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    3,
+    PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT32,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrDelAck, code),
+    offsetof(MesgGroupRecallAck, code),
     NULL,
     NULL,
     0,             /* flags */
@@ -6749,101 +9398,129 @@ static const ProtobufCFieldDescriptor mesg_group_mgr_del_ack__field_descriptors[
   },
   {
     "errmsg",
-    2,
+    4,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupMgrDelAck, errmsg),
+    offsetof(MesgGroupRecallAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_mgr_del_ack__field_indices_by_name[] = {
-  0,   /* field[0] = code */
-  1,   /* field[1] = errmsg */
+static const unsigned mesg_group_recall_ack__field_indices_by_name[] = {
+  2,   /* field[2] = code */
+  3,   /* field[3] = errmsg */
+  0,   /* field[0] = gid */
+  1,   /* field[1] = msgid */
 };
-static const ProtobufCIntRange mesg_group_mgr_del_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_recall_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_group_mgr_del_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_recall_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_mgr_del_ack",
-  "MesgGroupMgrDelAck",
-  "MesgGroupMgrDelAck",
+  "mesg_group_recall_ack",
+  "MesgGroupRecallAck",
+  "MesgGroupRecallAck",
   "",
-  sizeof(MesgGroupMgrDelAck),
-  2,
-  mesg_group_mgr_del_ack__field_descriptors,
-  mesg_group_mgr_del_ack__field_indices_by_name,
-  1,  mesg_group_mgr_del_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_mgr_del_ack__init,
+  sizeof(MesgGroupRecallAck),
+  4,
+  mesg_group_recall_ack__field_descriptors,
+  mesg_group_recall_ack__field_indices_by_name,
+  1,  mesg_group_recall_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_recall_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_usr_list__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_sync__field_descriptors[4] =
 {
   {
-    "gid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupUsrList, gid),
+    offsetof(MesgGroupSync, uid),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
   {
-    "num",
+    "gid",
     2,
-    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupSync, has_gid),
+    offsetof(MesgGroupSync, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "since",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(MesgGroupSync, has_since),
+    offsetof(MesgGroupSync, since),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
     PROTOBUF_C_TYPE_UINT32,
-    0,   /* quantifier_offset */
-    offsetof(MesgGroupUsrList, num),
+    offsetof(MesgGroupSync, has_num),
+    offsetof(MesgGroupSync, num),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_usr_list__field_indices_by_name[] = {
-  0,   /* field[0] = gid */
-  1,   /* field[1] = num */
+static const unsigned mesg_group_sync__field_indices_by_name[] = {
+  1,   /* field[1] = gid */
+  3,   /* field[3] = num */
+  2,   /* field[2] = since */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_usr_list__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_sync__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_group_usr_list__descriptor =
+const ProtobufCMessageDescriptor mesg_group_sync__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_usr_list",
-  "MesgGroupUsrList",
-  "MesgGroupUsrList",
+  "mesg_group_sync",
+  "MesgGroupSync",
+  "MesgGroupSync",
   "",
-  sizeof(MesgGroupUsrList),
-  2,
-  mesg_group_usr_list__field_descriptors,
-  mesg_group_usr_list__field_indices_by_name,
-  1,  mesg_group_usr_list__number_ranges,
-  (ProtobufCMessageInit) mesg_group_usr_list__init,
+  sizeof(MesgGroupSync),
+  4,
+  mesg_group_sync__field_descriptors,
+  mesg_group_sync__field_indices_by_name,
+  1,  mesg_group_sync__number_ranges,
+  (ProtobufCMessageInit) mesg_group_sync__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_group_usr_list_ack__field_descriptors[2] =
+static const ProtobufCFieldDescriptor mesg_group_sync_ack__field_descriptors[4] =
 {
   {
-    "gid",
+    "uid",
     1,
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_UINT64,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupUsrListAck, gid),
+    offsetof(MesgGroupSyncAck, uid),
     NULL,
     NULL,
     0,             /* flags */
@@ -6855,35 +9532,61 @@ static const ProtobufCFieldDescriptor mesg_group_usr_list_ack__field_descriptors
     PROTOBUF_C_LABEL_REQUIRED,
     PROTOBUF_C_TYPE_STRING,
     0,   /* quantifier_offset */
-    offsetof(MesgGroupUsrListAck, list),
+    offsetof(MesgGroupSyncAck, list),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "code",
+    3,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgGroupSyncAck, has_code),
+    offsetof(MesgGroupSyncAck, code),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "errmsg",
+    4,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupSyncAck, errmsg),
     NULL,
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
-static const unsigned mesg_group_usr_list_ack__field_indices_by_name[] = {
-  0,   /* field[0] = gid */
+static const unsigned mesg_group_sync_ack__field_indices_by_name[] = {
+  2,   /* field[2] = code */
+  3,   /* field[3] = errmsg */
   1,   /* field[1] = list */
+  0,   /* field[0] = uid */
 };
-static const ProtobufCIntRange mesg_group_usr_list_ack__number_ranges[1 + 1] =
+static const ProtobufCIntRange mesg_group_sync_ack__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 2 }
+  { 0, 4 }
 };
-const ProtobufCMessageDescriptor mesg_group_usr_list_ack__descriptor =
+const ProtobufCMessageDescriptor mesg_group_sync_ack__descriptor =
 {
   PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
-  "mesg_group_usr_list_ack",
-  "MesgGroupUsrListAck",
-  "MesgGroupUsrListAck",
+  "mesg_group_sync_ack",
+  "MesgGroupSyncAck",
+  "MesgGroupSyncAck",
   "",
-  sizeof(MesgGroupUsrListAck),
-  2,
-  mesg_group_usr_list_ack__field_descriptors,
-  mesg_group_usr_list_ack__field_indices_by_name,
-  1,  mesg_group_usr_list_ack__number_ranges,
-  (ProtobufCMessageInit) mesg_group_usr_list_ack__init,
+  sizeof(MesgGroupSyncAck),
+  4,
+  mesg_group_sync_ack__field_descriptors,
+  mesg_group_sync_ack__field_indices_by_name,
+  1,  mesg_group_sync_ack__number_ranges,
+  (ProtobufCMessageInit) mesg_group_sync_ack__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
 static const ProtobufCFieldDescriptor mesg_group_join_ntf__field_descriptors[2] =
@@ -7345,6 +10048,57 @@ const ProtobufCMessageDescriptor mesg_group_mgr_del_ntf__descriptor =
   (ProtobufCMessageInit) mesg_group_mgr_del_ntf__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mesg_group_dismiss_ntf__field_descriptors[2] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupDismissNtf, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "gid",
+    2,
+    PROTOBUF_C_LABEL_REQUIRED,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(MesgGroupDismissNtf, gid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mesg_group_dismiss_ntf__field_indices_by_name[] = {
+  1,   /* field[1] = gid */
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange mesg_group_dismiss_ntf__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mesg_group_dismiss_ntf__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mesg_group_dismiss_ntf",
+  "MesgGroupDismissNtf",
+  "MesgGroupDismissNtf",
+  "",
+  sizeof(MesgGroupDismissNtf),
+  2,
+  mesg_group_dismiss_ntf__field_descriptors,
+  mesg_group_dismiss_ntf__field_indices_by_name,
+  1,  mesg_group_dismiss_ntf__number_ranges,
+  (ProtobufCMessageInit) mesg_group_dismiss_ntf__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mesg_room_creat__field_descriptors[3] =
 {
   {
@@ -8639,7 +11393,7 @@ const ProtobufCMessageDescriptor mesg_room_kick_ntf__descriptor =
   (ProtobufCMessageInit) mesg_room_kick_ntf__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mesg_lsnd_info__field_descriptors[7] =
+static const ProtobufCFieldDescriptor mesg_lsnd_info__field_descriptors[9] =
 {
   {
     "type",
@@ -8725,20 +11479,46 @@ static const ProtobufCFieldDescriptor mesg_lsnd_info__field_descriptors[7] =
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "ipv6",
+    8,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(MesgLsndInfo, ipv6),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "status",
+    9,
+    PROTOBUF_C_LABEL_OPTIONAL,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(MesgLsndInfo, has_status),
+    offsetof(MesgLsndInfo, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mesg_lsnd_info__field_indices_by_name[] = {
   6,   /* field[6] = connections */
   4,   /* field[4] = ip */
+  7,   /* field[7] = ipv6 */
   3,   /* field[3] = nation */
   1,   /* field[1] = nid */
   2,   /* field[2] = opid */
   5,   /* field[5] = port */
+  8,   /* field[8] = status */
   0,   /* field[0] = type */
 };
 static const ProtobufCIntRange mesg_lsnd_info__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 7 }
+  { 0, 9 }
 };
 const ProtobufCMessageDescriptor mesg_lsnd_info__descriptor =
 {
@@ -8748,7 +11528,7 @@ const ProtobufCMessageDescriptor mesg_lsnd_info__descriptor =
   "MesgLsndInfo",
   "",
   sizeof(MesgLsndInfo),
-  7,
+  9,
   mesg_lsnd_info__field_descriptors,
   mesg_lsnd_info__field_indices_by_name,
   1,  mesg_lsnd_info__number_ranges,
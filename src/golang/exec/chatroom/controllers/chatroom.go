@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"errors"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/crypt"
 	"beehive-im/src/golang/lib/log"
 	"beehive-im/src/golang/lib/mesg"
 	"beehive-im/src/golang/lib/mesg/seqsvr"
@@ -72,6 +74,7 @@ type ChatRoomCntx struct {
 	listend        ChatRoomLsndData    /* 侦听层数据 */
 	room           RoomMap             /* 聊天室映射 */
 	room_mesg_chan chan *MesgRoomItem  /* 聊天室消息存储队列 */
+	token          *crypt.TokenCtx     /* TOKEN签名对象 */
 }
 
 var g_chatroom_cntx *ChatRoomCntx /* 全局对象 */
@@ -113,6 +116,18 @@ func ChatRoomInit(conf *conf.ChatRoomConf) (ctx *ChatRoomCntx, err error) {
 	/* > 创建侦听层列表 */
 	ctx.listend.dict.types = make(map[int]*ChatRoomLsndDictItem)
 
+	/* > TOKEN签名对象 */
+	keys := make(map[string]string)
+	for _, item := range conf.Token.Keys {
+		keys[item.Id] = strings.TrimSpace(item.Key)
+	}
+
+	ctx.token, err = crypt.CreateTokenCtx(conf.Token.Kid, keys)
+	if nil != err {
+		ctx.log.Error("Create token context failed! errmsg:%s", err.Error())
+		return nil, err
+	}
+
 	/* > 初始化缓存 */
 	err = ctx.cache.Init(conf.Redis.Addr, conf.Redis.Passwd)
 	if nil != err {
@@ -130,6 +145,11 @@ func ChatRoomInit(conf *conf.ChatRoomConf) (ctx *ChatRoomCntx, err error) {
 		return nil, err
 	}
 
+	err = models.DbRoomHistoryIndex(ctx.mongo, conf.Mongo.DbName)
+	if nil != err {
+		ctx.log.Error("Create room history index failed! errmsg:%s", err.Error())
+	}
+
 	/* > MYSQL连接池 */
 	err = ctx.userdb.Init(conf.UserDb.Usr,
 		conf.UserDb.Passwd, conf.UserDb.Addr, conf.UserDb.Dbname)
@@ -21,6 +21,7 @@ type ChatRoomConf struct {
 	UserDb   ChatRoomMysqlConf  // USERDB配置(MYSQL)
 	Mongo    ChatRoomMongoConf  // MONGO配置
 	Cipher   string             // 私密密钥
+	Token    ChatRoomTokenConf  // TOKEN配置
 	Log      log.Conf           // 日志配置
 	Frwder   rtmq.ProxyConf     // RTMQ配置
 }
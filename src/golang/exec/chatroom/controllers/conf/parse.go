@@ -43,6 +43,20 @@ type ChatRoomMongoConf struct {
 	Passwd string `xml:"PASSWD,attr"` // 登录密码
 }
 
+/* TOKEN密钥配置 */
+type ChatRoomTokenKeyConf struct {
+	Id  string `xml:"ID,attr"`   // 密钥ID
+	Key string `xml:",chardata"` // 密钥
+}
+
+/* TOKEN配置 */
+type ChatRoomTokenConf struct {
+	Ttl    int64                  `xml:"TTL,attr"`    // 有效时长(秒)
+	Kid    string                 `xml:"KID,attr"`    // 签名密钥ID
+	Legacy int                    `xml:"LEGACY,attr"` // 是否兼容旧版TOKEN(0:否 1:是)
+	Keys   []ChatRoomTokenKeyConf `xml:"KEY"`         // 密钥列表
+}
+
 /* 鉴权配置 */
 type ChatRoomRtmqAuthConf struct {
 	Usr    string `xml:"USR,attr"`    // 用户名
@@ -68,6 +82,7 @@ type ChatRoomConfXmlData struct {
 	UserDb ChatRoomMysqlConf     `xml:"USERDB"`    // USERDB配置
 	Mongo  ChatRoomMongoConf     `xml:"MONGO"`     // Mongo配置
 	Cipher string                `xml:"CIPHER"`    // 私密密钥
+	Token  ChatRoomTokenConf     `xml:"TOKEN"`     // TOKEN配置
 	Log    ChatRoomLogConf       `xml:"LOG"`       // 日志配置
 	Frwder ChatRoomRtmqProxyConf `xml:"FRWDER"`    // RTMQ PROXY配置
 }
@@ -187,9 +202,27 @@ func (conf *ChatRoomConf) parse() (err error) {
 		return errors.New("Get password of mongo failed!")
 	}
 
-	/* > 私密密钥 */
+	/* > TOKEN配置 */
+	conf.Token.Ttl = node.Token.Ttl
+	if 0 >= conf.Token.Ttl {
+		return errors.New("Get token ttl failed!")
+	}
+
+	conf.Token.Kid = node.Token.Kid
+	if 0 == len(conf.Token.Kid) {
+		return errors.New("Get token kid failed!")
+	}
+
+	conf.Token.Keys = node.Token.Keys
+	if 0 == len(conf.Token.Keys) {
+		return errors.New("Get token keys failed!")
+	}
+
+	conf.Token.Legacy = node.Token.Legacy
+
+	/* > 私密密钥(用于校验旧版TOKEN) */
 	conf.Cipher = node.Cipher
-	if 0 == len(conf.Cipher) {
+	if 0 != conf.Token.Legacy && 0 == len(conf.Cipher) {
 		return errors.New("Get chiper failed!")
 	}
 
@@ -581,7 +581,7 @@ func (req *RoomCloseReq) parseParam() (*RoomCloseParam, error) {
  **     ctx: 全局对象
  **输出参数: NONE
  **返    回: VOID
- **实现描述: 1.抽取请求参数 2.修改聊天室属性
+ **实现描述: 1.抽取请求参数 2.修改聊天室属性 3.从成员的会话列表中移除该聊天室
  **注意事项: TODO: 关闭聊天室后, 需要给所有侦听层广播解散聊天室的指令.
  **作    者: # Qifeng.zou # 2017.03.19 08:07:31 #
  ******************************************************************************/
@@ -608,6 +608,9 @@ func (this *ChatRoomConfigCtrl) closeRoom(ctx *ChatRoomCntx) {
 		return
 	}
 
+	/* > 清理成员会话列表 */
+	ctx.room_conv_clean(param.rid)
+
 	/* > 回复处理应答 */
 	this.Error(comm.OK, "Ok")
 
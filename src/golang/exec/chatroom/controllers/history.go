@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/history"
+	"beehive-im/src/golang/lib/mesg"
+
+	"beehive-im/src/golang/exec/chatroom/models"
+)
+
+// 聊天室历史消息查询
+type ChatRoomHistoryCtrl struct {
+	BaseController
+}
+
+/* 聊天室消息 */
+type RoomHistoryItem struct {
+	Rid   uint64             `json:"rid"`   // 聊天室ID
+	Uid   uint64             `json:"uid"`   // 发送者UID
+	Sid   uint64             `json:"sid"`   // 发送方会话SID
+	Msgid uint64             `json:"msgid"` // 聊天室消息ID
+	Ctm   int64              `json:"ctm"`   // 发送时间
+	Mesg  *mesg.MesgRoomChat `json:"mesg"`  // 消息内容
+}
+
+/* 应答结果 */
+type RoomHistoryRsp struct {
+	Rid    uint64            `json:"rid"`    // 聊天室ID
+	Next   uint64            `json:"next"`   // 下页游标(本页最后一条消息的聊天室消息ID)
+	Len    int               `json:"len"`    // 列表长度
+	List   []RoomHistoryItem `json:"list"`   // 消息列表
+	Code   int               `json:"code"`   // 错误码
+	ErrMsg string            `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: Query
+ **功    能: 查询聊天室历史消息
+ **输入参数: NONE
+ **输出参数:
+ **返    回:
+ **实现描述: 从MONGO中查询聊天室消息, 并将PB消息体解析后返回.
+ **注意事项:
+ **     请求参数: rid: 聊天室ID(M) sender: 发送者UID(O) stm: 起始时间(O)
+ **               etm: 截止时间(O) msgid: 消息ID游标(O) order: 排序方式(O)
+ **               num: 获取数目(O)
+ **     按消息ID分页时将next作为下次请求的msgid.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func (this *ChatRoomHistoryCtrl) Query() {
+	ctx := GetRoomSvrCntx()
+
+	rid, _ := strconv.ParseUint(this.GetString("rid"), 10, 64)
+	if 0 == rid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [rid] is invalied!")
+		return
+	}
+
+	/* > 解析查询条件 */
+	param := history.HistoryParamParse(func(key string) string {
+		return this.GetString(key)
+	})
+
+	/* > 查询历史消息 */
+	rows, err := models.DbRoomHistory(ctx.mongo, ctx.conf.Mongo.DbName, rid, param)
+	if nil != err {
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	rsp := &RoomHistoryRsp{
+		Rid:  rid,
+		List: make([]RoomHistoryItem, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		item := RoomHistoryItem{
+			Rid:   row.Rid,
+			Uid:   row.Uid,
+			Sid:   row.Sid,
+			Msgid: row.Msgid,
+			Ctm:   row.Ctm,
+		}
+
+		rsp.Next = row.Msgid
+
+		if len(row.Data) >= comm.MESG_HEAD_SIZE {
+			item.Mesg = &mesg.MesgRoomChat{}
+			if nil != proto.Unmarshal(row.Data[comm.MESG_HEAD_SIZE:], item.Mesg) {
+				item.Mesg = nil
+			}
+		}
+
+		rsp.List = append(rsp.List, item)
+	}
+
+	/* > 回复应答 */
+	rsp.Len = len(rsp.List)
+	rsp.Code = 0
+	rsp.ErrMsg = "Ok"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
@@ -14,6 +14,7 @@ import (
 
 	"beehive-im/src/golang/lib/comm"
 	"beehive-im/src/golang/lib/crypt"
+	"beehive-im/src/golang/lib/im"
 	"beehive-im/src/golang/lib/mesg"
 	"beehive-im/src/golang/lib/mesg/seqsvr"
 
@@ -31,6 +32,7 @@ type OnlineToken struct {
 	uid uint64 /* 用户ID */
 	ttl int64  /* TTL */
 	sid uint64 /* 会话SID */
+	ctm int64  /* 签发时间 */
 }
 
 /******************************************************************************
@@ -40,15 +42,47 @@ type OnlineToken struct {
  **     token: TOKEN字串
  **输出参数: NONE
  **返    回: TOKEN字段
+ **实现描述: 签名TOKEN校验签名后提取有效数据; 旧版TOKEN只在配置允许时才进行解析.
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.20 09:28:06 #
+ ******************************************************************************/
+func (ctx *ChatRoomCntx) decodeOnlineToken(token string) *OnlineToken {
+	if !crypt.IsSignedToken(token) {
+		if 0 == ctx.conf.Token.Legacy {
+			ctx.log.Error("Legacy token is not allowed! digest:%s", crypt.TokenDigest(token))
+			return nil
+		}
+		return ctx.decodeLegacyOnlineToken(token)
+	}
+
+	/* > 校验TOKEN签名 */
+	tk, err := ctx.token.Verify(token)
+	if nil != err {
+		ctx.log.Error("Verify token failed! digest:%s errmsg:%s",
+			crypt.TokenDigest(token), err.Error())
+		return nil
+	}
+
+	return &OnlineToken{uid: tk.Uid, ttl: tk.Ttl, sid: tk.Sid, ctm: tk.Ctm}
+}
+
+/******************************************************************************
+ **函数名称: decodeLegacyOnlineToken
+ **功    能: 解码旧版TOKEN
+ **输入参数:
+ **     token: TOKEN字串
+ **输出参数: NONE
+ **返    回: TOKEN字段
  **实现描述: 解析token, 并提取有效数据.
  **注意事项:
  **     TOKEN的格式"uid:${uid}:ttl:${ttl}:sid:${sid}:end"
  **     uid: 用户ID
  **     ttl: 该token的最大生命时间
  **     sid: 会话SID
+ **     旧版TOKEN没有签发时间, 按"过期时间-1年"推算.
  **作    者: # Qifeng.zou # 2016.11.20 09:28:06 #
  ******************************************************************************/
-func (ctx *ChatRoomCntx) decodeOnlineToken(token string) *OnlineToken {
+func (ctx *ChatRoomCntx) decodeLegacyOnlineToken(token string) *OnlineToken {
 	tk := &OnlineToken{}
 
 	/* > TOKEN解码 */
@@ -56,11 +90,11 @@ func (ctx *ChatRoomCntx) decodeOnlineToken(token string) *OnlineToken {
 	orig_token := crypt.Decode(cry, token)
 	words := strings.Split(orig_token, ":")
 	if 7 != len(words) {
-		ctx.log.Error("Token format not right! token:%s orig:%s", token, orig_token)
+		ctx.log.Error("Token format not right! token:%s", crypt.TokenDigest(token))
 		return nil
 	}
 
-	ctx.log.Debug("token:%s orig:%s", token, orig_token)
+	ctx.log.Debug("token:%s", crypt.TokenDigest(token))
 
 	/* > 验证TOKEN合法性 */
 	uid, _ := strconv.ParseInt(words[1], 10, 64)
@@ -75,6 +109,8 @@ func (ctx *ChatRoomCntx) decodeOnlineToken(token string) *OnlineToken {
 	tk.sid = uint64(sid)
 	ctx.log.Debug("words[5]:%s sid:%d sid:%d", words[5], sid, tk.sid)
 
+	tk.ctm = tk.ttl - comm.TIME_YEAR
+
 	return tk
 }
 
@@ -85,12 +121,9 @@ func (ctx *ChatRoomCntx) decodeOnlineToken(token string) *OnlineToken {
  **     req: ONLINE请求
  **输出参数: NONE
  **返    回: 异常信息
- **实现描述: 计算TOKEN合法性
+ **实现描述: 校验TOKEN签名、有效期、是否与请求匹配以及是否已被吊销
  **注意事项:
- **     1.TOKEN的格式"${uid}:${ttl}:${sid}"
- **         uid: 用户ID
- **         ttl: 该token的最大生命时间
- **         sid: 会话SID
+ **     1.TOKEN的格式见decodeOnlineToken()
  **     2.头部数据(MesgHeader)中的SID此时表示的是客户端的连接CID.
  **作    者: # Qifeng.zou # 2016.11.02 10:20:57 #
  ******************************************************************************/
@@ -108,6 +141,17 @@ func (ctx *ChatRoomCntx) checkOnlineReq(req *mesg.MesgOnline) error {
 		return errors.New("Token is invalid!!")
 	}
 
+	/* > 判断TOKEN是否已被吊销 */
+	revoked, err := ctx.cache.IsTokenRevoked(token.uid, token.sid, token.ctm)
+	if nil != err {
+		ctx.log.Error("Check token revoked failed! uid:%d sid:%d errmsg:%s",
+			token.uid, token.sid, err.Error())
+		return err
+	} else if revoked {
+		ctx.log.Error("Token is revoked! uid:%d sid:%d ctm:%d", token.uid, token.sid, token.ctm)
+		return errors.New("Token is revoked!")
+	}
+
 	return nil
 }
 
@@ -786,6 +830,47 @@ func ChatRoomCreatHandler(cmd uint32, nid uint32, data []byte, length uint32, pa
 	return 0
 }
 
+/******************************************************************************
+ **函数名称: room_conv_clean
+ **功    能: 从聊天室成员的会话列表中移除该聊天室
+ **输入参数:
+ **     rid: 聊天室ID
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 遍历聊天室用户列表"${uid}:${sid}", 按UID去重后逐一移除.
+ **注意事项: 在关闭(解散)聊天室时调用
+ **作    者: # agent # 2026.10.18 08:23:22 #
+ ******************************************************************************/
+func (ctx *ChatRoomCntx) room_conv_clean(rid uint64) error {
+	rds := ctx.cache.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(models.ROOM_KEY_RID_TO_UID_SID_ZSET, rid)
+
+	members, err := redis.Strings(rds.Do("ZRANGE", key, 0, -1))
+	if nil != err {
+		ctx.log.Error("Get user list of room failed! rid:%d errmsg:%s", rid, err.Error())
+		return err
+	}
+
+	uids := make(map[uint64]bool)
+	for _, member := range members {
+		var uid, sid uint64
+
+		n, _ := fmt.Sscanf(member, comm.CHAT_FMT_UID_SID_STR, &uid, &sid)
+		if 2 != n || 0 == uid || uids[uid] {
+			continue
+		}
+		uids[uid] = true
+
+		im.ConvRemove(rds, uid, comm.CHAT_CONV_TYPE_ROOM, rid)
+	}
+
+	_, err = rds.Do("")
+
+	return err
+}
+
 /* 解散聊天室 */
 func ChatRoomDismissHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
 	return 0
@@ -1417,7 +1502,7 @@ func (ctx *ChatRoomCntx) roomQuitNotify(sid uint64, uid uint64, rid uint64) int
  **返    回:
  **     code: 错误码
  **     err: 错误描述
- **实现描述:
+ **实现描述: 清理聊天室的会话列表, 并从用户的会话列表中移除该聊天室
  **注意事项: 已验证了ROOM-QUIT请求的合法性
  **作    者: # Qifeng.zou # 2016.11.03 21:28:18 #
  ******************************************************************************/
@@ -1436,6 +1521,8 @@ func (ctx *ChatRoomCntx) roomQuitHandler(
 	member := fmt.Sprintf(comm.CHAT_FMT_UID_SID_STR, req.GetUid(), head.GetSid())
 	pl.Send("ZREM", key, member) // 清理RID -> UID集合"${uid}:${sid}"
 
+	im.ConvRemove(pl, req.GetUid(), comm.CHAT_CONV_TYPE_ROOM, req.GetRid())
+
 	return 0, nil
 }
 
@@ -1841,6 +1928,9 @@ func (ctx *ChatRoomCntx) roomKickHandler(
 
 	ctx.mongo.Exec(ctx.conf.Mongo.DbName, models.ROOM_TAB_BLACKLIST, cb)
 
+	/* > 从会话列表中移除 */
+	im.ConvRemove(pl, req.GetUid(), comm.CHAT_CONV_TYPE_ROOM, req.GetRid())
+
 	/* > 遍历下发踢除指令 */
 	ctx.room_kick_by_uid(req.GetRid(), req.GetUid())
 
@@ -2367,7 +2457,7 @@ func (ctx *ChatRoomCntx) taskRoomMesgChanPop() {
  **     ctx: 全局对象
  **输出参数: NONE
  **返    回: NONE
- **实现描述: 将消息存入聊天室缓存和数据库
+ **实现描述: 将消息存入聊天室缓存和数据库, 并更新发送者的会话列表
  **注意事项:
  **作    者: # Qifeng.zou # 2016.12.28 22:05:51 #
  ******************************************************************************/
@@ -2387,16 +2477,34 @@ func (item *MesgRoomItem) storage(ctx *ChatRoomCntx) {
 		return
 	}
 
+	/* > 申请聊天室消息序列号(用于历史消息分页) */
+	key := fmt.Sprintf(models.ROOM_KEY_ROOM_MSGID_INCR, item.req.GetRid())
+
+	msgid, err := redis.Uint64(pl.Do("INCRBY", key, 1))
+	if nil != err {
+		ctx.log.Error("Get room msgid failed! key:%s errmsg:%s", key, err.Error())
+		return
+	}
+
+	ctm := time.Now().Unix()
+
+	/* > 更新发送者的会话列表(聊天室消息量大, 不统计未读数) */
+	last := im.ConvPreview(msg.GetUid(), msgid, msg.GetText())
+
+	im.ConvUpdate(pl, msg.GetUid(), comm.CHAT_CONV_TYPE_ROOM, msg.GetRid(), ctm, last, false)
+
 	/* > 提交REDIS缓存 */
-	key := fmt.Sprintf(models.ROOM_KEY_ROOM_MESG_QUEUE, item.req.GetRid())
+	key = fmt.Sprintf(models.ROOM_KEY_ROOM_MESG_QUEUE, item.req.GetRid())
 	pl.Send("LPUSH", key, item.raw[comm.MESG_HEAD_SIZE:])
 
 	/* > 提交MONGO存储 */
 	data := &models.RoomChatTabRow{
-		Rid:  msg.GetRid(),
-		Uid:  msg.GetUid(),
-		Ctm:  time.Now().Unix(),
-		Data: item.raw,
+		Rid:   msg.GetRid(),
+		Uid:   msg.GetUid(),
+		Sid:   item.head.GetSid(),
+		Msgid: msgid,
+		Ctm:   ctm,
+		Data:  item.raw,
 	}
 
 	cb := func(c *mgo.Collection) (err error) {
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/history"
+	"beehive-im/src/golang/lib/mongo"
+)
+
+/******************************************************************************
+ **函数名称: DbRoomHistoryIndex
+ **功    能: 创建聊天室历史消息查询所需的索引
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 创建(rid, ctm)和(rid, msgid)索引
+ **注意事项: 索引已存在时不做处理, 因此每次启动时均可调用.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func DbRoomHistoryIndex(mongo *mongo.Pool, dbname string) error {
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.EnsureIndex(mgo.Index{Key: []string{"rid", "ctm"}, Background: true})
+		if nil != err {
+			return err
+		}
+		return c.EnsureIndex(mgo.Index{Key: []string{"rid", "msgid"}, Background: true})
+	}
+
+	return mongo.Exec(dbname, ROOM_TAB_MESG, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbRoomHistory
+ **功    能: 查询聊天室历史消息
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     rid: 聊天室ID
+ **     param: 查询条件
+ **输出参数: NONE
+ **返    回:
+ **     rows: 聊天室消息列表(按发送时间排序)
+ **     err: 错误信息
+ **实现描述:
+ **注意事项: 按发送时间排序, 同一秒内再按聊天室消息ID排序.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func DbRoomHistory(mongo *mongo.Pool, dbname string,
+	rid uint64, param *history.HistoryParam) (rows []RoomChatTabRow, err error) {
+	/* > 生成查询条件 */
+	cond := bson.M{"rid": rid}
+
+	if 0 != param.Sender {
+		cond["uid"] = param.Sender
+	}
+
+	if ctm := history.HistoryCtmCond(param); nil != ctm {
+		cond["ctm"] = ctm
+	}
+
+	sort := []string{"-ctm", "-msgid"}
+	if param.Asc {
+		sort = []string{"ctm", "msgid"}
+		if 0 != param.Msgid {
+			cond["msgid"] = bson.M{"$gt": param.Msgid}
+		}
+	} else if 0 != param.Msgid {
+		cond["msgid"] = bson.M{"$lt": param.Msgid}
+	}
+
+	/* > 查询历史消息 */
+	rows = make([]RoomChatTabRow, 0)
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Find(cond).Sort(sort...).Limit(param.Num).All(&rows)
+	}
+
+	err = mongo.Exec(dbname, ROOM_TAB_MESG, cb)
+	if nil != err {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
 	"beehive-im/src/golang/lib/mesg"
 	"beehive-im/src/golang/lib/rdb"
 	"beehive-im/src/golang/lib/rtmq"
@@ -58,6 +59,25 @@ func (c *RoomCacheObj) Get() redis.Conn {
 	return c.redis.Get()
 }
 
+/******************************************************************************
+ **函数名称: IsTokenRevoked
+ **功    能: 判断TOKEN是否已被吊销
+ **输入参数:
+ **     uid: 用户ID
+ **     sid: 会话SID
+ **     ctm: TOKEN签发时间
+ **输出参数: NONE
+ **返    回:
+ **     revoked: 是否已被吊销
+ **     err: 错误信息
+ **实现描述: 吊销列表与USRSVR共用
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (c *RoomCacheObj) IsTokenRevoked(uid uint64, sid uint64, ctm int64) (revoked bool, err error) {
+	return im.IsTokenRevoked(c.redis, uid, sid, ctm)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 
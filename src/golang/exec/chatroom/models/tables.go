@@ -16,10 +16,12 @@ type RoomInfoTabRow struct {
 
 /* 聊天室数据 */
 type RoomChatTabRow struct {
-	Rid  uint64 "rid"  // 聊天室ID
-	Uid  uint64 "uid"  // 用户UID
-	Ctm  int64  "ctm"  // 发送时间
-	Data []byte "data" // 原始数据包
+	Rid   uint64 "rid"   // 聊天室ID
+	Uid   uint64 "uid"   // 用户UID
+	Sid   uint64 "sid"   // 发送方会话SID
+	Msgid uint64 "msgid" // 聊天室消息ID(存储时分配)
+	Ctm   int64  "ctm"   // 发送时间
+	Data  []byte "data"  // 原始数据包
 }
 
 /* 聊天室黑名单 */
@@ -16,4 +16,5 @@ func Router() {
 
 	beego.Router("/room/query", &controllers.ChatRoomQueryCtrl{}, "get:Query")
 	beego.Router("/room/config", &controllers.ChatRoomConfigCtrl{}, "get:Config")
+	beego.Router("/room/history", &controllers.ChatRoomHistoryCtrl{}, "get:Query")
 }
@@ -33,10 +33,31 @@ func (ctx *MonSvrCntx) lsnd_info_isvalid(req *mesg.MesgLsndInfo) bool {
 		0 == len(req.GetNation()) ||
 		0 == len(req.GetIp()) {
 		return false
+	} else if 0 != len(req.GetIpv6()) && !comm.IsIpv6(req.GetIpv6()) {
+		return false
 	}
 	return true
 }
 
+/******************************************************************************
+ **函数名称: lsnd_addr
+ **功    能: 生成侦听层地址字串
+ **输入参数:
+ **     ip: IP地址(IPv4或IPv6)
+ **     port: 端口号
+ **输出参数: NONE
+ **返    回: 地址字串(IPv4:"${ip}:${port}" IPv6:"[${ip}]:${port}")
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:49:39 #
+ ******************************************************************************/
+func lsnd_addr(ip string, port uint32) string {
+	if comm.IsIpv6(ip) {
+		return fmt.Sprintf(comm.IM_FMT_IP6_PORT_STR, ip, port)
+	}
+	return fmt.Sprintf(comm.IM_FMT_IP_PORT_STR, ip, port)
+}
+
 /******************************************************************************
  **函数名称: lsnd_info_parse
  **功    能: 解析LSND-INFO请求
@@ -91,7 +112,7 @@ func (ctx *MonSvrCntx) lsnd_info_has_conflict(req *mesg.MesgLsndInfo) (has bool,
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
-	addr := fmt.Sprintf(comm.IM_FMT_IP_PORT_STR, req.GetIp(), req.GetPort())
+	addr := lsnd_addr(req.GetIp(), req.GetPort())
 	ok, err := redis.Bool(rds.Do("HEXISTS", comm.IM_KEY_LSND_ADDR_TO_NID, addr))
 	if nil != err {
 		ctx.log.Error("Exec hexists failed! err:%s", err.Error())
@@ -160,11 +181,21 @@ func (ctx *MonSvrCntx) lsnd_info_handler(head *comm.MesgHeader, req *mesg.MesgLs
 
 	/* 存储基本信息 */
 	key := fmt.Sprintf(comm.IM_KEY_LSND_ATTR, req.GetNid())
-	addr := fmt.Sprintf(comm.IM_FMT_IP_PORT_STR, req.GetIp(), req.GetPort())
+	addr := lsnd_addr(req.GetIp(), req.GetPort())
 
 	pl.Send("HSETNX", key, comm.IM_LSND_ATTR_ADDR, addr)                     /* 记录NID->ADDR映射 */
 	pl.Send("HSET", key, comm.IM_LSND_ATTR_TYPE, req.GetType())              /* 侦听层类型 */
 	pl.Send("HSET", key, comm.IM_LSND_ATTR_CONNECTION, req.GetConnections()) /* 记录NID在线连接数 */
+	if 0 != len(req.GetIpv6()) {
+		pl.Send("HSET", key, comm.IM_LSND_ATTR_ADDR6, lsnd_addr(req.GetIpv6(), req.GetPort())) /* 记录NID->IPv6地址映射 */
+	} else {
+		pl.Send("HDEL", key, comm.IM_LSND_ATTR_ADDR6)
+	}
+	if 0 != req.GetStatus() {
+		pl.Send("HSET", key, comm.IM_LSND_ATTR_STATUS, req.GetStatus()) /* 侦听层负载状态 */
+	} else {
+		pl.Send("HDEL", key, comm.IM_LSND_ATTR_STATUS) /* 旧版侦听层未上报状态 */
+	}
 
 	pl.Send("HSETNX", comm.IM_KEY_LSND_ADDR_TO_NID, addr, req.GetNid()) /* 记录ADDR->NID映射 */
 
@@ -188,11 +219,11 @@ func (ctx *MonSvrCntx) lsnd_info_handler(head *comm.MesgHeader, req *mesg.MesgLs
 
 	/* 国家+运营商 -> 侦听层IP列表 */
 	key = fmt.Sprintf(comm.IM_KEY_LSND_IP_ZSET, req.GetType(), req.GetNation(), req.GetOpid())
-	val := fmt.Sprintf(comm.IM_FMT_IP_PORT_STR, req.GetIp(), req.GetPort())
-	pl.Send("ZADD", key, ttl, val)
+	pl.Send("ZADD", key, ttl, addr)
 
-	ctx.log.Debug("Handle listend information! type:%d nid:%d nation:%s opid:%d ip:%s port:%d user-num:%d",
-		req.GetType(), req.GetNid(), req.GetNation(), req.GetOpid(), req.GetIp(), req.GetPort(), req.GetConnections())
+	ctx.log.Debug("Handle listend information! type:%d nid:%d nation:%s opid:%d ip:%s ipv6:%s port:%d user-num:%d status:%d",
+		req.GetType(), req.GetNid(), req.GetNation(), req.GetOpid(),
+		req.GetIp(), req.GetIpv6(), req.GetPort(), req.GetConnections(), req.GetStatus())
 
 	return
 }
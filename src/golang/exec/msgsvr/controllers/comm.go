@@ -1,7 +1,13 @@
 package controllers
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/garyburd/redigo/redis"
+
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
 )
 
 /******************************************************************************
@@ -42,3 +48,97 @@ func (ctx *MsgSvrCntx) send_data(cmd uint32, sid uint64, cid uint64, nid uint32,
 	/* > 发送协议包 */
 	return ctx.frwder.AsyncSend(cmd, p.Buff, uint32(len(p.Buff)))
 }
+
+/******************************************************************************
+ **函数名称: mesg_pack
+ **功    能: 拼接协议包
+ **输入参数:
+ **     head: 协议头
+ **     body: 协议体
+ **输出参数: NONE
+ **返    回: 协议包(协议头+协议体)
+ **实现描述:
+ **注意事项: 协议头中的报体长度将被同步更新
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) mesg_pack(head *comm.MesgHeader, body []byte) []byte {
+	head.Length = uint32(len(body))
+
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+len(body))
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	return p.Buff
+}
+
+/******************************************************************************
+ **函数名称: send_to_uid
+ **功    能: 下发消息给指定用户的所有终端
+ **输入参数:
+ **     cmd: 命令类型
+ **     uid: 用户UID
+ **     seq: 序列号
+ **     data: 下发数据
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: 下发的终端个数
+ **实现描述:
+ **注意事项: 用户不在线时, 不下发消息
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) send_to_uid(cmd uint32, uid uint64, seq uint64, data []byte, length uint32) int {
+	return ctx.send_to_uid_except(cmd, uid, 0, seq, data, length)
+}
+
+/******************************************************************************
+ **函数名称: send_to_uid_except
+ **功    能: 下发消息给指定用户除指定会话外的所有终端
+ **输入参数:
+ **     cmd: 命令类型
+ **     uid: 用户UID
+ **     except: 不需要下发的会话SID(0:表示下发给所有终端)
+ **     seq: 序列号
+ **     data: 下发数据
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: 下发的终端个数
+ **实现描述: 遍历UID对应的会话SID集合, 并逐一下发消息
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) send_to_uid_except(cmd uint32,
+	uid uint64, except uint64, seq uint64, data []byte, length uint32) int {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+
+	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
+	if nil != err {
+		ctx.log.Error("Get sid set by uid [%d] failed! errmsg:%s", uid, err.Error())
+		return 0
+	}
+
+	total := 0
+	num := len(sid_list)
+	for idx := 0; idx < num; idx += 1 {
+		sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
+		if 0 != except && uint64(sid) == except {
+			continue
+		}
+
+		attr, _ := im.GetSidAttr(ctx.redis, uint64(sid))
+		if nil == attr {
+			continue
+		} else if 0 == attr.GetNid() || attr.GetUid() != uid {
+			continue
+		}
+
+		ctx.send_data(cmd, uint64(sid), attr.GetCid(), attr.GetNid(), seq, data, length)
+		total += 1
+	}
+
+	return total
+}
@@ -8,19 +8,39 @@ import (
 	"beehive-im/src/golang/lib/rtmq"
 )
 
+const (
+	MSGSVR_RECALL_DEF_TIMEOUT = 120 // 默认消息撤回时限(秒)
+	MSGSVR_NOTIFY_DEF_TIMEOUT = 3   // 默认离线通知HTTP回调超时时间(秒)
+	MSGSVR_NOTIFY_DEF_RATE    = 10  // 默认每个用户每分钟最多离线通知次数
+
+	MSGSVR_NOTIFY_DEF_WORKER_NUM = 4      // 默认离线通知发送协程数
+	MSGSVR_NOTIFY_DEF_CHAN_LEN   = 100000 // 默认离线通知队列长度
+	MSGSVR_STORAGE_DEF_CHAN_LEN  = 100000 // 默认消息存储队列长度
+)
+
+/* 离线通知方式 */
+const (
+	MSGSVR_NOTIFY_TYPE_NONE    = "none"    // 不通知
+	MSGSVR_NOTIFY_TYPE_WEBHOOK = "webhook" // HTTP回调
+	MSGSVR_NOTIFY_TYPE_FILE    = "file"    // 写入文件(用于测试)
+)
+
 /* 在线中心配置 */
 type MsgSvrConf struct {
-	Id       uint32          // 结点ID
-	Gid      uint32          // 分组ID
-	WorkPath string          // 工作路径(自动获取)
-	AppPath  string          // 程序路径(自动获取)
-	ConfPath string          // 配置路径(自动获取)
-	Redis    MsgSvrRedisConf // Redis配置
-	Mysql    MsgSvrMysqlConf // Mysql配置
-	Mongo    MsgSvrMongoConf // Mongo配置
-	Cipher   string          // 私密密钥
-	Log      log.Conf        // 日志配置
-	Frwder   rtmq.ProxyConf  // RTMQ配置
+	Id       uint32            // 结点ID
+	Gid      uint32            // 分组ID
+	WorkPath string            // 工作路径(自动获取)
+	AppPath  string            // 程序路径(自动获取)
+	ConfPath string            // 配置路径(自动获取)
+	Redis    MsgSvrRedisConf   // Redis配置
+	Mysql    MsgSvrMysqlConf   // Mysql配置
+	Mongo    MsgSvrMongoConf   // Mongo配置
+	Cipher   string            // 私密密钥
+	Recall   MsgSvrRecallConf  // 消息撤回配置
+	Notify   MsgSvrNotifyConf  // 离线通知配置
+	Storage  MsgSvrStorageConf // 消息存储配置
+	Log      log.Conf          // 日志配置
+	Frwder   rtmq.ProxyConf    // RTMQ配置
 }
 
 /******************************************************************************
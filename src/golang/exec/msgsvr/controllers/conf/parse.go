@@ -37,6 +37,28 @@ type MsgSvrMongoConf struct {
 	Passwd string `xml:"PASSWD,attr"` // 登录密码
 }
 
+/* 消息撤回配置 */
+type MsgSvrRecallConf struct {
+	Timeout int64 `xml:"TIMEOUT,attr"` // 撤回时限(秒): 超过该时长的消息不允许撤回
+}
+
+/* 离线通知配置 */
+type MsgSvrNotifyConf struct {
+	Type      string `xml:"TYPE,attr"`       // 通知方式(none:不通知 webhook:HTTP回调 file:写入文件)
+	Url       string `xml:"URL,attr"`        // HTTP回调地址(webhook时有效)
+	Path      string `xml:"PATH,attr"`       // 文件路径(file时有效)
+	Timeout   int64  `xml:"TIMEOUT,attr"`    // HTTP回调超时时间(秒)
+	Rate      int64  `xml:"RATE,attr"`       // 每个用户每分钟最多通知次数
+	Level     uint32 `xml:"LEVEL,attr"`      // 高级别消息阈值: 群聊消息级别不小于该值时通知所有离线成员(0:不启用)
+	WorkerNum uint32 `xml:"WORKER-NUM,attr"` // 发送协程数
+	ChanLen   uint32 `xml:"CHAN-LEN,attr"`   // 通知队列长度(队列满时丢弃新通知)
+}
+
+/* 消息存储配置 */
+type MsgSvrStorageConf struct {
+	ChanLen uint32 `xml:"CHAN-LEN,attr"` // 私聊/群聊消息存储队列长度
+}
+
 /* 鉴权配置 */
 type MsgSvrConfRtmqAuthXmlData struct {
 	Usr    string `xml:"USR,attr"`    // 用户名
@@ -54,14 +76,17 @@ type MsgSvrConfRtmqProxyXmlData struct {
 
 /* 在线中心XML配置 */
 type MsgSvrConfXmlData struct {
-	Id     uint32                     `xml:"ID,attr"`  // 结点ID
-	Gid    uint32                     `xml:"GID,attr"` // 分组ID
-	Redis  MsgSvrRedisConf            `xml:"REDIS"`    // REDIS配置
-	Mysql  MsgSvrMysqlConf            `xml:"MYSQL"`    // MYsQL配置
-	Mongo  MsgSvrMongoConf            `xml:"MONGO"`    // MONGO配置
-	Cipher string                     `xml:"CIPHER"`   // 私密密钥
-	Log    MsgSvrConfLogXmlData       `xml:"LOG"`      // 日志配置
-	Frwder MsgSvrConfRtmqProxyXmlData `xml:"FRWDER"`   // RTMQ PROXY配置
+	Id      uint32                     `xml:"ID,attr"`  // 结点ID
+	Gid     uint32                     `xml:"GID,attr"` // 分组ID
+	Redis   MsgSvrRedisConf            `xml:"REDIS"`    // REDIS配置
+	Mysql   MsgSvrMysqlConf            `xml:"MYSQL"`    // MYsQL配置
+	Mongo   MsgSvrMongoConf            `xml:"MONGO"`    // MONGO配置
+	Cipher  string                     `xml:"CIPHER"`   // 私密密钥
+	Recall  MsgSvrRecallConf           `xml:"RECALL"`   // 消息撤回配置
+	Notify  MsgSvrNotifyConf           `xml:"NOTIFY"`   // 离线通知配置
+	Storage MsgSvrStorageConf          `xml:"STORAGE"`  // 消息存储配置
+	Log     MsgSvrConfLogXmlData       `xml:"LOG"`      // 日志配置
+	Frwder  MsgSvrConfRtmqProxyXmlData `xml:"FRWDER"`   // RTMQ PROXY配置
 }
 
 /******************************************************************************
@@ -168,6 +193,48 @@ func (conf *MsgSvrConf) parse() (err error) {
 		return errors.New("Get cipher failed!")
 	}
 
+	/* > 消息撤回配置 */
+	conf.Recall.Timeout = node.Recall.Timeout
+	if 0 >= conf.Recall.Timeout {
+		conf.Recall.Timeout = MSGSVR_RECALL_DEF_TIMEOUT
+	}
+
+	/* > 离线通知配置 */
+	conf.Notify = node.Notify
+	switch conf.Notify.Type {
+	case "", MSGSVR_NOTIFY_TYPE_NONE:
+		conf.Notify.Type = MSGSVR_NOTIFY_TYPE_NONE
+	case MSGSVR_NOTIFY_TYPE_WEBHOOK:
+		if 0 == len(conf.Notify.Url) {
+			return errors.New("Get notify url failed!")
+		}
+	case MSGSVR_NOTIFY_TYPE_FILE:
+		if 0 == len(conf.Notify.Path) {
+			return errors.New("Get notify path failed!")
+		}
+	default:
+		return errors.New("Notify type is invalid!")
+	}
+
+	if 0 >= conf.Notify.Timeout {
+		conf.Notify.Timeout = MSGSVR_NOTIFY_DEF_TIMEOUT
+	}
+	if 0 >= conf.Notify.Rate {
+		conf.Notify.Rate = MSGSVR_NOTIFY_DEF_RATE
+	}
+	if 0 == conf.Notify.WorkerNum {
+		conf.Notify.WorkerNum = MSGSVR_NOTIFY_DEF_WORKER_NUM
+	}
+	if 0 == conf.Notify.ChanLen {
+		conf.Notify.ChanLen = MSGSVR_NOTIFY_DEF_CHAN_LEN
+	}
+
+	/* > 消息存储配置 */
+	conf.Storage.ChanLen = node.Storage.ChanLen
+	if 0 == conf.Storage.ChanLen {
+		conf.Storage.ChanLen = MSGSVR_STORAGE_DEF_CHAN_LEN
+	}
+
 	/* 日志配置 */
 	conf.Log.Level = log.GetLevel(node.Log.Level)
 
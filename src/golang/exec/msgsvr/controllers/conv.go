@@ -0,0 +1,373 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
+	"beehive-im/src/golang/lib/mesg"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// 会话列表
+
+/******************************************************************************
+ **函数名称: conv_list_parse
+ **功    能: 解析会话列表请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) conv_list_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgConvList, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of conv list is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgConvList{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal conv list failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() {
+		ctx.log.Error("Paramter isn't right! uid:%d", req.GetUid())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: conv_list_ack
+ **功    能: 发送会话列表应答
+ **输入参数:
+ **     head: 协议头
+ **     req: 会话列表请求
+ **     list: 会话列表
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 uid = 1;        // M|用户ID|数字|
+ **         required string list = 2;       // M|会话列表|字串|JSON
+ **         optional uint32 code = 3;       // O|错误码|数字|
+ **         optional string errmsg = 4;     // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) conv_list_ack(head *comm.MesgHeader,
+	req *mesg.MesgConvList, list []*im.ConvItem, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	if nil == list {
+		list = make([]*im.ConvItem, 0)
+	}
+
+	data, _ := json.Marshal(list)
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgConvListAck{
+		Uid:    proto.Uint64(req.GetUid()),
+		List:   proto.String(string(data)),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_CONV_LIST_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrConvListHandler
+ **功    能: 会话列表的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述: 按最近消息时间倒序返回会话列表
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func MsgSvrConvListHandler(cmd uint32, nid uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析会话列表请求 */
+	head, req, code, err := ctx.conv_list_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse conv list failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse conv list failed! errmsg:%s", err.Error())
+		ctx.conv_list_ack(head, req, nil, code, err.Error())
+		return -1
+	}
+
+	/* > 校验会话列表请求 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		ctx.conv_list_ack(head, req, nil, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if req.GetUid() != attr.GetUid() {
+		ctx.log.Error("Get conv list failed! uid:%d/%d sid:%d",
+			req.GetUid(), attr.GetUid(), head.GetSid())
+		ctx.conv_list_ack(head, req, nil, comm.ERR_SVR_DATA_COLLISION, "Uid is collision!")
+		return -1
+	}
+
+	/* > 获取会话列表 */
+	num := int(req.GetNum())
+	if 0 == num {
+		num = comm.CHAT_CONV_DEF_NUM
+	} else if num > comm.CHAT_CONV_MAX_NUM {
+		num = comm.CHAT_CONV_MAX_NUM
+	}
+
+	list, err := im.ConvList(ctx.redis, req.GetUid(), num)
+	if nil != err {
+		ctx.log.Error("Get conv list failed! uid:%d errmsg:%s", req.GetUid(), err.Error())
+		ctx.conv_list_ack(head, req, nil, comm.ERR_SYS_DB, err.Error())
+		return -1
+	}
+
+	ctx.conv_list_ack(head, req, list, 0, "Ok")
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 会话已读
+
+/******************************************************************************
+ **函数名称: conv_read_parse
+ **功    能: 解析会话已读请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) conv_read_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgConvRead, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of conv read is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgConvRead{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal conv read failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetId() ||
+		req.GetType() < comm.CHAT_CONV_TYPE_CHAT || req.GetType() > comm.CHAT_CONV_TYPE_ROOM {
+		ctx.log.Error("Paramter isn't right! uid:%d type:%d id:%d",
+			req.GetUid(), req.GetType(), req.GetId())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: conv_read_ack
+ **功    能: 发送会话已读应答
+ **输入参数:
+ **     head: 协议头
+ **     req: 会话已读请求
+ **     unread: 剩余未读数
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 uid = 1;        // M|用户ID|数字|
+ **         required uint32 type = 2;       // M|会话类型|数字|
+ **         required uint64 id = 3;         // M|会话ID|数字|
+ **         optional uint32 unread = 4;     // O|剩余未读数|数字|
+ **         optional uint32 code = 5;       // O|错误码|数字|
+ **         optional string errmsg = 6;     // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) conv_read_ack(head *comm.MesgHeader,
+	req *mesg.MesgConvRead, unread uint32, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgConvReadAck{
+		Uid:    proto.Uint64(req.GetUid()),
+		Type:   proto.Uint32(req.GetType()),
+		Id:     proto.Uint64(req.GetId()),
+		Unread: proto.Uint32(unread),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_CONV_READ_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: conv_read_handler
+ **功    能: 会话已读处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 会话已读请求
+ **输出参数: NONE
+ **返    回:
+ **     unread: 剩余未读数
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 减少会话的未读数;
+ **     2. 将剩余未读数同步给该用户的其他会话.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) conv_read_handler(head *comm.MesgHeader,
+	req *mesg.MesgConvRead) (unread uint32, code uint32, err error) {
+	/* > 减少会话的未读数 */
+	unread, err = im.ConvRead(ctx.redis,
+		req.GetUid(), req.GetType(), req.GetId(), req.GetNum())
+	if nil != err {
+		return 0, comm.ERR_SYS_DB, err
+	}
+
+	/* > 同步给该用户的其他会话 */
+	req.Unread = proto.Uint32(unread)
+
+	body, err := proto.Marshal(req)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return unread, 0, nil
+	}
+
+	ctx.send_to_uid_except(comm.CMD_CONV_READ,
+		req.GetUid(), head.GetSid(), head.GetSeq(), body, uint32(len(body)))
+
+	return unread, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrConvReadHandler
+ **功    能: 会话已读的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **注意事项: 只能修改自己的会话未读数
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func MsgSvrConvReadHandler(cmd uint32, nid uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析会话已读请求 */
+	head, req, code, err := ctx.conv_read_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse conv read failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse conv read failed! errmsg:%s", err.Error())
+		ctx.conv_read_ack(head, req, 0, code, err.Error())
+		return -1
+	}
+
+	/* > 校验会话已读请求 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		ctx.conv_read_ack(head, req, 0, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if req.GetUid() != attr.GetUid() {
+		ctx.log.Error("Conv read failed! uid:%d/%d sid:%d",
+			req.GetUid(), attr.GetUid(), head.GetSid())
+		ctx.conv_read_ack(head, req, 0, comm.ERR_SVR_DATA_COLLISION, "Uid is collision!")
+		return -1
+	}
+
+	/* > 进行业务处理 */
+	unread, code, err := ctx.conv_read_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle conv read failed! uid:%d type:%d id:%d errmsg:%s",
+			req.GetUid(), req.GetType(), req.GetId(), err.Error())
+		ctx.conv_read_ack(head, req, 0, code, err.Error())
+		return -1
+	}
+
+	ctx.conv_read_ack(head, req, unread, 0, "Ok")
+
+	return 0
+}
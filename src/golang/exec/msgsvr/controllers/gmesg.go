@@ -1,16 +1,22 @@
 package controllers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
 
 	"github.com/garyburd/redigo/redis"
 	"github.com/golang/protobuf/proto"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/history"
+	"beehive-im/src/golang/lib/im"
 	"beehive-im/src/golang/lib/mesg"
 )
 
@@ -118,6 +124,7 @@ func (ctx *MsgSvrCntx) group_chat_ack(head *comm.MesgHeader, req *mesg.MesgGroup
 	ack := &mesg.MesgGroupChatAck{
 		Code:   proto.Uint32(0),
 		Errmsg: proto.String("Ok"),
+		Msgid:  proto.Uint64(req.GetMsgid()),
 	}
 
 	/* 生成PB数据 */
@@ -131,6 +138,57 @@ func (ctx *MsgSvrCntx) group_chat_ack(head *comm.MesgHeader, req *mesg.MesgGroup
 		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
+/******************************************************************************
+ **函数名称: group_chat_fill
+ **功    能: 分配群消息ID
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-CHAT请求
+ **输出参数: NONE
+ **返    回:
+ **     data: 重新生成的原始数据(协议头+协议体)
+ **     err: 错误描述
+ **实现描述: 群消息ID在群组内递增, 即: 以(gid, msgid)唯一确定一条群聊消息.
+ **          同时记录群聊消息索引(用于校验撤回).
+ **注意事项: 协议头中的报体长度将被同步更新
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_chat_fill(
+	head *comm.MesgHeader, req *mesg.MesgGroupChat) (data []byte, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_MSGID_INCR, req.GetGid())
+
+	msgid, err := redis.Int64(rds.Do("INCR", key))
+	if nil != err {
+		return nil, err
+	}
+
+	req.Msgid = proto.Uint64(uint64(msgid))
+	req.Revoked = nil
+
+	/* > 记录群聊消息索引 */
+	ctm := time.Now().Unix()
+
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_SENT_ZSET, req.GetGid())
+	member := fmt.Sprintf(comm.CHAT_FMT_GROUP_SENT_STR, msgid, req.GetUid())
+
+	rds.Send("ZADD", key, ctm, member)
+	rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm-comm.CHAT_SENT_TTL)
+	_, err = rds.Do("ZREMRANGEBYRANK", key, 0, -(comm.CHAT_SENT_MAX_NUM + 1))
+	if nil != err {
+		return nil, err
+	}
+
+	body, err := proto.Marshal(req)
+	if nil != err {
+		return nil, err
+	}
+
+	return ctx.mesg_pack(head, body), nil
+}
+
 /******************************************************************************
  **函数名称: group_chat_handler
  **功    能: GROUP-MSG处理
@@ -141,6 +199,7 @@ func (ctx *MsgSvrCntx) group_chat_ack(head *comm.MesgHeader, req *mesg.MesgGroup
  **输出参数: NONE
  **返    回: 错误信息
  **实现描述:
+ **     0. 分配群消息ID
  **     1. 将消息存放在聊天室历史消息表中
  **     2. 遍历rid->nid列表, 并转发群聊消息
  **注意事项:
@@ -148,6 +207,13 @@ func (ctx *MsgSvrCntx) group_chat_ack(head *comm.MesgHeader, req *mesg.MesgGroup
  ******************************************************************************/
 func (ctx *MsgSvrCntx) group_chat_handler(
 	head *comm.MesgHeader, req *mesg.MesgGroupChat, data []byte) (err error) {
+	/* > 分配群消息ID */
+	data, err = ctx.group_chat_fill(head, req)
+	if nil != err {
+		ctx.log.Error("Fill group chat failed! errmsg:%s", err.Error())
+		return err
+	}
+
 	/* > 放入存储队列 */
 	item := &MesgGroupItem{
 		head: head,
@@ -260,76 +326,1146 @@ func MsgSvrGroupChatAckHandler(cmd uint32, nid uint32,
 }
 
 ////////////////////////////////////////////////////////////////////////////////
-////////////////////////////////////////////////////////////////////////////////
+// 群聊消息撤回
 
 /******************************************************************************
- **函数名称: task_group_mesg_chan_pop
- **功    能: 群聊消息的存储任务
- **输入参数: NONE
+ **函数名称: group_recall_parse
+ **功    能: 解析群聊消息撤回
+ **输入参数:
+ **     data: 接收的数据
  **输出参数: NONE
  **返    回:
- **实现描述: 从群聊消息队列中取出消息, 并进行存储处理
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
  **注意事项:
- **作    者: # Qifeng.zou # 2016.12.27 23:45:01 #
+ **作    者: # agent # 2026.10.18 07:06:41 #
  ******************************************************************************/
-func (ctx *MsgSvrCntx) task_group_mesg_chan_pop() {
-	for item := range ctx.group_mesg_chan {
-		item.storage(ctx)
+func (ctx *MsgSvrCntx) group_recall_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupRecall, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of group recall is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupRecall{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group recall failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() || 0 == req.GetMsgid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d msgid:%d",
+			req.GetUid(), req.GetGid(), req.GetMsgid())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_recall_ack
+ **功    能: 发送群聊消息撤回应答
+ **输入参数:
+ **     head: 协议头
+ **     req: 撤回请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 gid = 1;        // M|群组ID|数字|
+ **         required uint64 msgid = 2;      // M|群消息ID|数字|
+ **         required uint32 code = 3;       // M|错误码|数字|
+ **         required string errmsg = 4;     // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_recall_ack(head *comm.MesgHeader,
+	req *mesg.MesgGroupRecall, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupRecallAck{
+		Gid:    proto.Uint64(req.GetGid()),
+		Msgid:  proto.Uint64(req.GetMsgid()),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
 	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_GROUP_RECALL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
-type GroupChatRow struct {
-	Gid  uint64 "gid"  // 群组ID
-	Uid  uint64 "uid"  // 用户UID
-	Ctm  int64  "ctm"  // 发送时间
-	Data []byte "data" // 原始数据包
+/******************************************************************************
+ **函数名称: group_revoke
+ **功    能: 将群聊消息标记为已撤回
+ **输入参数:
+ **     req: 撤回请求
+ **     ctm: 撤回时间
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 优先从群聊消息索引(REDIS)中查找消息, 校验撤回时限后记录撤回标识,
+ **        再尝试更新MONGO(消息尚未入库时, 由存储任务补标撤回);
+ **     2. 索引中不存在时, 从MONGO中查找并更新:
+ **        消息不存在时, 返回ERR_SVR_MESG_NOT_EXIST;
+ **        撤回发起方不是原消息的发送方时, 返回ERR_SVR_PERM_DENIED;
+ **        超过撤回时限时, 返回ERR_SVR_RECALL_TIMEOUT.
+ **注意事项: 以服务端记录的发送时间计算撤回时限
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_revoke(req *mesg.MesgGroupRecall, ctm int64) (code uint32, err error) {
+	row := &history.GroupMesgTabRow{}
+	cond := bson.M{"gid": req.GetGid(), "msgid": req.GetMsgid()}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 查找群聊消息索引 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_SENT_ZSET, req.GetGid())
+	member := fmt.Sprintf(comm.CHAT_FMT_GROUP_SENT_STR, req.GetMsgid(), req.GetUid())
+
+	stm, err := redis.Int64(rds.Do("ZSCORE", key, member))
+	if nil == err {
+		if stm+ctx.conf.Recall.Timeout < ctm {
+			return comm.ERR_SVR_RECALL_TIMEOUT, errors.New("Recall timeout!")
+		}
+
+		/* 记录撤回标识(供存储任务补标撤回) */
+		key = fmt.Sprintf(comm.CHAT_KEY_GROUP_REVOKE_ZSET, req.GetGid())
+
+		rds.Send("ZADD", key, ctm, req.GetMsgid())
+		_, err = rds.Do("ZREMRANGEBYSCORE", key, "-inf", ctm-comm.CHAT_SENT_TTL)
+		if nil != err {
+			return comm.ERR_SYS_DB, err
+		}
+
+		cb := func(c *mgo.Collection) (err error) {
+			err = c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": ctm}})
+			if mgo.ErrNotFound == err {
+				return nil /* 尚未入库 */
+			}
+			return err
+		}
+
+		err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_GROUP_MESG, cb)
+		if nil != err {
+			return comm.ERR_SYS_DB, err
+		}
+		return 0, nil
+	} else if redis.ErrNil != err {
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 查找MONGO存储 */
+	code = comm.ERR_SYS_DB
+
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Find(cond).One(row)
+		if mgo.ErrNotFound == err {
+			code = comm.ERR_SVR_MESG_NOT_EXIST
+			return errors.New("Message not exist!")
+		} else if nil != err {
+			return err
+		} else if row.Uid != req.GetUid() {
+			code = comm.ERR_SVR_PERM_DENIED
+			return errors.New("Only sender can recall message!")
+		} else if row.Ctm+ctx.conf.Recall.Timeout < ctm {
+			code = comm.ERR_SVR_RECALL_TIMEOUT
+			return errors.New("Recall timeout!")
+		}
+
+		return c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": ctm}})
+	}
+
+	err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_GROUP_MESG, cb)
+	if nil != err {
+		return code, err
+	}
+
+	return 0, nil
 }
 
 /******************************************************************************
- **函数名称: storage
- **功    能: 群聊消息的存储处理
+ **函数名称: group_mesg_queue_revoke
+ **功    能: 将群聊缓存消息标记为已撤回
  **输入参数:
- **     ctx: 全局对象
+ **     gid: 群组ID
+ **     msgid: 群消息ID
  **输出参数: NONE
- **返    回: NONE
- **实现描述: 将消息存入聊天室缓存和数据库
+ **返    回: 错误描述
+ **实现描述: 查找缓存队列中的对应消息, 设置撤回标识并清除消息内容.
  **注意事项:
- **作    者: # Qifeng.zou # 2016.12.28 22:05:51 #
+ **     1. 新消息从队列头部插入, 会改变下标, 因此通过WATCH保证查找和修改的原子性;
+ **     2. 消息已被清理出缓存队列时, 无需处理.
+ **作    者: # agent # 2026.10.18 07:06:41 #
  ******************************************************************************/
-func (item *MesgGroupItem) storage(ctx *MsgSvrCntx) {
+func (ctx *MsgSvrCntx) group_mesg_queue_revoke(gid uint64, msgid uint64) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, gid)
+
+	for retry := 0; retry < 3; retry += 1 {
+		rds.Do("WATCH", key)
+
+		list, err := redis.ByteSlices(rds.Do("LRANGE", key, 0, -1))
+		if nil != err {
+			rds.Do("UNWATCH")
+			return err
+		}
+
+		/* > 查找对应消息 */
+		idx := 0
+		num := len(list)
+		chat := &mesg.MesgGroupChat{}
+		for ; idx < num; idx += 1 {
+			chat.Reset()
+			err = proto.Unmarshal(list[idx], chat)
+			if nil == err && chat.GetMsgid() == msgid {
+				break
+			}
+		}
+
+		if idx == num {
+			rds.Do("UNWATCH")
+			return nil /* 已被清理出缓存队列 */
+		}
+
+		/* > 设置撤回标识 */
+		chat.Revoked = proto.Uint32(1)
+		chat.Text = proto.String("")
+		chat.Data = nil
+
+		body, err := proto.Marshal(chat)
+		if nil != err {
+			rds.Do("UNWATCH")
+			return err
+		}
+
+		rds.Send("MULTI")
+		rds.Send("LSET", key, idx, body)
+		reply, err := rds.Do("EXEC")
+		if nil != err {
+			return err
+		} else if nil != reply {
+			return nil
+		}
+		/* 队列已被修改, 重新查找 */
+	}
+
+	return errors.New("Group message queue is busy!")
+}
+
+/******************************************************************************
+ **函数名称: group_revoked
+ **功    能: 判断群聊消息是否已被撤回
+ **输入参数:
+ **     gid: 群组ID
+ **     msgid: 群消息ID
+ **输出参数: NONE
+ **返    回:
+ **     rtm: 撤回时间
+ **     ok: 是否已被撤回
+ **实现描述: 查询撤回标识(见group_revoke)
+ **注意事项: 供存储任务使用: 消息入库前被撤回时, 由存储任务补标撤回
+ **作    者: # agent # 2026.10.18 07:52:38 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_revoked(gid uint64, msgid uint64) (rtm int64, ok bool) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_REVOKE_ZSET, gid)
+
+	rtm, err := redis.Int64(rds.Do("ZSCORE", key, msgid))
+	if nil != err {
+		return 0, false
+	}
+
+	return rtm, true
+}
+
+/******************************************************************************
+ **函数名称: group_conv_revoke
+ **功    能: 撤回群成员会话列表中的最近消息预览
+ **输入参数:
+ **     gid: 群组ID
+ **     uid: 被撤回消息的发送者UID
+ **     msgid: 群消息ID
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 遍历群组成员, 最近消息正是被撤回的消息时替换为撤回标识.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 08:04:00 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_conv_revoke(gid uint64, uid uint64, msgid uint64) error {
 	pl := ctx.redis.Get()
 	defer func() {
 		pl.Do("")
 		pl.Close()
 	}()
 
-	/* > 解析PB协议 */
-	chat := &mesg.MesgGroupChat{}
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
 
-	err := proto.Unmarshal(item.raw[comm.MESG_HEAD_SIZE:], chat)
+	uids, err := redis.Strings(pl.Do("ZRANGE", key, 0, -1))
 	if nil != err {
-		ctx.log.Error("Unmarshal group-mesg failed!")
-		return
+		return err
 	}
 
-	/* > 提交REDIS缓存 */
-	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, item.req.GetGid())
-	pl.Send("LPUSH", key, item.raw[comm.MESG_HEAD_SIZE:])
+	for _, str := range uids {
+		member, _ := strconv.ParseUint(str, 10, 64)
+		if 0 == member {
+			continue
+		}
+		im.ConvRevoke(pl, member, comm.CHAT_CONV_TYPE_GROUP, gid, uid, msgid)
+	}
 
-	/* > 提交MONGO存储 */
-	data := &GroupChatRow{
-		Gid:  chat.GetGid(),
-		Uid:  chat.GetUid(),
-		Ctm:  time.Now().Unix(),
-		Data: item.raw,
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: group_recall_handler
+ **功    能: 群聊消息撤回处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 撤回请求
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 校验撤回发起方是否为原消息的发送方;
+ **     2. 校验撤回时限, 并将消息标记为已撤回;
+ **     3. 将缓存队列中的消息标记为已撤回;
+ **     4. 更新群成员会话列表中的最近消息预览;
+ **     5. 遍历gid->nid列表, 下发撤回通知(与群聊消息的下发方式一致).
+ **注意事项: 已收到原消息的终端据此隐藏该消息
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_recall_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupRecall) (code uint32, err error) {
+	/* > 校验撤回发起方 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Recall uid is collision! uid:%d/%d sid:%d",
+			req.GetUid(), attr.GetUid(), head.GetSid())
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only sender can recall message!")
 	}
 
-	cb := func(c *mgo.Collection) (err error) {
-		c.Insert(data)
-		return err
+	/* > 标记为已撤回 */
+	ctm := time.Now().Unix()
+
+	code, err = ctx.group_revoke(req, ctm)
+	if nil != err {
+		ctx.log.Error("Revoke group message failed! gid:%d msgid:%d errmsg:%s",
+			req.GetGid(), req.GetMsgid(), err.Error())
+		return code, err
+	}
+
+	err = ctx.group_mesg_queue_revoke(req.GetGid(), req.GetMsgid())
+	if nil != err {
+		ctx.log.Error("Revoke group message queue failed! gid:%d msgid:%d errmsg:%s",
+			req.GetGid(), req.GetMsgid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	err = ctx.group_conv_revoke(req.GetGid(), req.GetUid(), req.GetMsgid())
+	if nil != err {
+		ctx.log.Error("Revoke group conv failed! gid:%d msgid:%d errmsg:%s",
+			req.GetGid(), req.GetMsgid(), err.Error())
+	}
+
+	/* > 下发撤回通知 */
+	req.Time = proto.Uint64(uint64(ctm))
+
+	body, err := proto.Marshal(req)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	ctx.group.node.RLock()
+	defer ctx.group.node.RUnlock()
+
+	nid_list, ok := ctx.group.node.m[req.GetGid()]
+	if !ok {
+		return 0, nil
+	}
+
+	for _, nid := range nid_list {
+		ctx.log.Debug("gid:%d nid:%d", req.GetGid(), nid)
+
+		ctx.send_data(comm.CMD_GROUP_RECALL, head.GetSid(), head.GetCid(),
+			nid, head.GetSeq(), body, uint32(len(body)))
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrGroupRecallHandler
+ **功    能: 群聊消息撤回的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述: 撤回消息并通知群组成员后, 给撤回发起方回复应答.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func MsgSvrGroupRecallHandler(cmd uint32, nid uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析撤回请求 */
+	head, req, code, err := ctx.group_recall_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse group recall failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse group recall failed! errmsg:%s", err.Error())
+		ctx.group_recall_ack(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 进行业务处理 */
+	code, err = ctx.group_recall_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle group recall failed! errmsg:%s", err.Error())
+		ctx.group_recall_ack(head, req, code, err.Error())
+		return -1
 	}
 
-	ctx.mongo.Exec(ctx.conf.Mongo.DbName, "group-mesg", cb)
+	ctx.group_recall_ack(head, req, 0, "Ok")
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 群聊消息同步
+
+/* 群聊消息同步结果 */
+type GroupSyncItem struct {
+	Gid  uint64 `json:"gid"`  // 群组ID
+	Next uint64 `json:"next"` // 下页游标(本页最后一条群消息ID)
+	More uint32 `json:"more"` // 是否还有未读群消息(0:否 1:是)
+}
+
+/******************************************************************************
+ **函数名称: group_sync_parse
+ **功    能: 解析群聊消息同步请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupSync, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of group sync is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupSync{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group sync failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() {
+		ctx.log.Error("Paramter isn't right! uid:%d", req.GetUid())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_sync_ack
+ **功    能: 发送群聊消息同步应答
+ **输入参数:
+ **     head: 协议头
+ **     req: 同步请求
+ **     list: 同步结果
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 uid = 1;        // M|用户ID|数字|
+ **         required string list = 2;       // M|同步结果|字串|JSON
+ **         optional uint32 code = 3;       // O|错误码|数字|
+ **         optional string errmsg = 4;     // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_ack(head *comm.MesgHeader,
+	req *mesg.MesgGroupSync, list []*GroupSyncItem, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	if nil == list {
+		list = make([]*GroupSyncItem, 0)
+	}
+
+	data, _ := json.Marshal(list)
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupSyncAck{
+		Uid:    proto.Uint64(req.GetUid()),
+		List:   proto.String(string(data)),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_GROUP_SYNC_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+// 推进群聊消息同步游标(KEYS[1]:用户群组列表 ARGV[1]:群组ID ARGV[2]:新游标)
+// 返回: 推进后的游标(只增不减) -1:不是群组成员
+var group_sync_cursor_script = redis.NewScript(1, `
+local cur = redis.call("HGET", KEYS[1], ARGV[1])
+if not cur then
+    return -1
+end
+if tonumber(ARGV[2]) > tonumber(cur) then
+    redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+    return tonumber(ARGV[2])
+end
+return tonumber(cur)`)
+
+/******************************************************************************
+ **函数名称: group_sync_advance
+ **功    能: 推进用户在指定群组的同步游标
+ **输入参数:
+ **     uid: 用户ID
+ **     gid: 群组ID
+ **     since: 新游标(已读的最大群消息ID)
+ **输出参数: NONE
+ **返    回:
+ **     msgid: 推进后的同步游标
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 通过LUA脚本原子地比较并更新, 游标只增不减.
+ **注意事项: 用户不是群组成员时, 返回ERR_SVR_PERM_DENIED
+ **作    者: # agent # 2026.10.18 07:54:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_advance(uid uint64,
+	gid uint64, since uint64) (msgid uint64, code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid)
+
+	cur, err := redis.Int64(group_sync_cursor_script.Do(rds, key, gid, since))
+	if nil != err {
+		return 0, comm.ERR_SYS_DB, err
+	} else if cur < 0 {
+		return 0, comm.ERR_SVR_PERM_DENIED, errors.New("Not member of group!")
+	}
+
+	return uint64(cur), 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_sync_cursor
+ **功    能: 获取用户各群组的同步游标
+ **输入参数:
+ **     uid: 用户ID
+ **     gid: 群组ID(0:所有群组)
+ **     since: 客户端确认的同步游标(gid不为0时有效)
+ **输出参数: NONE
+ **返    回:
+ **     cursor: 同步游标(群组ID -> 已读的最大群消息ID)
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 客户端确认的游标大于服务端记录的游标时, 更新服务端记录(见group_sync_advance).
+ **注意事项: 用户不是群组成员时, 返回ERR_SVR_PERM_DENIED
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_cursor(uid uint64,
+	gid uint64, since uint64) (cursor map[uint64]uint64, code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid)
+
+	cursor = make(map[uint64]uint64)
+
+	/* > 获取所有群组的同步游标 */
+	if 0 == gid {
+		vals, err := redis.Strings(rds.Do("HGETALL", key))
+		if nil != err {
+			return nil, comm.ERR_SYS_DB, err
+		}
+
+		num := len(vals)
+		for idx := 0; idx+1 < num; idx += 2 {
+			gid, _ := strconv.ParseUint(vals[idx], 10, 64)
+			msgid, _ := strconv.ParseUint(vals[idx+1], 10, 64)
+			if 0 == gid {
+				continue
+			}
+			cursor[gid] = msgid
+		}
+		return cursor, 0, nil
+	}
+
+	/* > 获取并推进指定群组的同步游标 */
+	msgid, code, err := ctx.group_sync_advance(uid, gid, since)
+	if nil != err {
+		return nil, code, err
+	}
+
+	cursor[gid] = msgid
+
+	return cursor, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_sync_fetch
+ **功    能: 获取指定群消息ID之后的群聊消息
+ **输入参数:
+ **     gid: 群组ID
+ **     cursor: 同步游标(已读的最大群消息ID)
+ **     num: 最多获取的条数
+ **输出参数: NONE
+ **返    回:
+ **     list: 群聊消息列表(按群消息ID从小到大排列)
+ **     err: 错误描述
+ **实现描述:
+ **     1. 优先从缓存队列CHAT_KEY_GROUP_MESG_QUEUE中获取;
+ **     2. 缓存队列已不包含cursor之后的第一条消息时(已被清理), 从MONGO中获取.
+ **注意事项: 已撤回的消息只下发撤回标识, 不下发消息内容.
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_fetch(gid uint64,
+	cursor uint64, num int) (list []*mesg.MesgGroupChat, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 从缓存队列中获取(队列头部为最新消息) */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, gid)
+
+	vals, err := redis.ByteSlices(rds.Do("LRANGE", key, 0, -1))
+	if nil != err {
+		return nil, err
+	}
+
+	hit := false
+	list = make([]*mesg.MesgGroupChat, 0, num)
+	for idx := len(vals) - 1; idx >= 0 && len(list) < num; idx -= 1 {
+		chat := &mesg.MesgGroupChat{}
+
+		err = proto.Unmarshal(vals[idx], chat)
+		if nil != err || 0 == chat.GetMsgid() {
+			continue
+		} else if chat.GetMsgid() <= cursor+1 {
+			hit = true /* 缓存中包含cursor之后的第一条消息 */
+		}
+
+		if chat.GetMsgid() > cursor && (hit || 0 != len(list)) {
+			list = append(list, chat)
+		}
+	}
+
+	if hit {
+		return list, nil
+	}
+
+	/* > 从MONGO中获取 */
+	rows := make([]history.GroupMesgTabRow, 0)
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Find(bson.M{"gid": gid, "msgid": bson.M{"$gt": cursor}}).
+			Sort("msgid").Limit(num).All(&rows)
+	}
+
+	err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_GROUP_MESG, cb)
+	if nil != err {
+		return nil, err
+	}
+
+	list = make([]*mesg.MesgGroupChat, 0, len(rows))
+	for idx := 0; idx < len(rows); idx += 1 {
+		if len(rows[idx].Data) < comm.MESG_HEAD_SIZE {
+			continue
+		}
+
+		chat := &mesg.MesgGroupChat{}
+
+		err = proto.Unmarshal(rows[idx].Data[comm.MESG_HEAD_SIZE:], chat)
+		if nil != err {
+			continue
+		} else if 0 != rows[idx].Revoked {
+			chat.Revoked = proto.Uint32(1)
+			chat.Text = proto.String("")
+			chat.Data = nil
+		}
+
+		list = append(list, chat)
+	}
+
+	return list, nil
+}
+
+/******************************************************************************
+ **函数名称: group_sync_handler
+ **功    能: 群聊消息同步处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 同步请求
+ **输出参数: NONE
+ **返    回:
+ **     list: 同步结果(只包含有未读群消息的群组)
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 获取各群组的同步游标及当前最大群消息ID;
+ **     2. 对有未读群消息的群组, 从同步游标开始获取一页群聊消息并逐条下发;
+ **     3. 同步所有群组(gid为0)时, 下发后即推进各群组的同步游标.
+ **注意事项: 同步指定群组时, 同步游标只在客户端确认(since)时更新, 因此重复同步会重复下发.
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_sync_handler(head *comm.MesgHeader,
+	req *mesg.MesgGroupSync) (list []*GroupSyncItem, code uint32, err error) {
+	/* > 获取同步游标 */
+	cursor, code, err := ctx.group_sync_cursor(req.GetUid(), req.GetGid(), req.GetSince())
+	if nil != err {
+		ctx.log.Error("Get group sync cursor failed! uid:%d gid:%d errmsg:%s",
+			req.GetUid(), req.GetGid(), err.Error())
+		return nil, code, err
+	}
+
+	num := int(req.GetNum())
+	if 0 == num {
+		num = comm.CHAT_SYNC_DEF_NUM
+	} else if num > comm.CHAT_SYNC_MAX_NUM {
+		num = comm.CHAT_SYNC_MAX_NUM
+	}
+
+	/* > 获取当前最大群消息ID */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	gid_list := make([]uint64, 0, len(cursor))
+	for gid := range cursor {
+		gid_list = append(gid_list, gid)
+		rds.Send("GET", fmt.Sprintf(comm.CHAT_KEY_GROUP_MSGID_INCR, gid))
+	}
+
+	rds.Flush()
+
+	last := make(map[uint64]uint64)
+	for _, gid := range gid_list {
+		msgid, err := redis.Uint64(rds.Receive())
+		if nil != err {
+			continue
+		}
+		last[gid] = msgid
+	}
+
+	/* > 下发未读群消息 */
+	list = make([]*GroupSyncItem, 0)
+	for _, gid := range gid_list {
+		if last[gid] <= cursor[gid] {
+			continue
+		}
+
+		mesg_list, err := ctx.group_sync_fetch(gid, cursor[gid], num)
+		if nil != err {
+			ctx.log.Error("Fetch group message failed! gid:%d cursor:%d errmsg:%s",
+				gid, cursor[gid], err.Error())
+			continue
+		}
+
+		item := &GroupSyncItem{Gid: gid, Next: cursor[gid]}
+
+		for _, chat := range mesg_list {
+			body, err := proto.Marshal(chat)
+			if nil != err {
+				continue
+			}
+
+			ctx.send_data(comm.CMD_GROUP_CHAT, head.GetSid(), head.GetCid(),
+				head.GetNid(), chat.GetMsgid(), body, uint32(len(body)))
+
+			item.Next = chat.GetMsgid()
+		}
+
+		if item.Next < last[gid] {
+			item.More = 1
+		}
+
+		/* > 推进同步游标(同步所有群组时, 无法由客户端逐一确认) */
+		if 0 == req.GetGid() && item.Next > cursor[gid] {
+			_, _, err = ctx.group_sync_advance(req.GetUid(), gid, item.Next)
+			if nil != err {
+				ctx.log.Error("Advance group sync cursor failed! uid:%d gid:%d next:%d errmsg:%s",
+					req.GetUid(), gid, item.Next, err.Error())
+			}
+		}
+
+		list = append(list, item)
+	}
+
+	return list, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrGroupSyncHandler
+ **功    能: 群聊消息同步的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述: 下发各群组的未读群消息后, 回复同步应答.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:12:39 #
+ ******************************************************************************/
+func MsgSvrGroupSyncHandler(cmd uint32, nid uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析同步请求 */
+	head, req, code, err := ctx.group_sync_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse group sync failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse group sync failed! errmsg:%s", err.Error())
+		ctx.group_sync_ack(head, req, nil, code, err.Error())
+		return -1
+	}
+
+	/* > 校验同步请求 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		ctx.group_sync_ack(head, req, nil, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if req.GetUid() != attr.GetUid() {
+		ctx.log.Error("Group sync failed! uid:%d/%d sid:%d",
+			req.GetUid(), attr.GetUid(), head.GetSid())
+		ctx.group_sync_ack(head, req, nil, comm.ERR_SVR_DATA_COLLISION, "Uid is collision!")
+		return -1
+	}
+
+	/* > 进行业务处理 */
+	list, code, err := ctx.group_sync_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle group sync failed! errmsg:%s", err.Error())
+		ctx.group_sync_ack(head, req, nil, code, err.Error())
+		return -1
+	}
+
+	ctx.group_sync_ack(head, req, list, 0, "Ok")
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////
+
+/******************************************************************************
+ **函数名称: task_group_mesg_chan_pop
+ **功    能: 群聊消息的存储任务
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回:
+ **实现描述: 从群聊消息队列中取出消息, 并进行存储处理
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.12.27 23:45:01 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) task_group_mesg_chan_pop() {
+	for item := range ctx.group_mesg_chan {
+		item.storage(ctx)
+	}
+}
+
+/******************************************************************************
+ **函数名称: storage
+ **功    能: 群聊消息的存储处理
+ **输入参数:
+ **     ctx: 全局对象
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 更新群成员的会话列表, 发送离线通知, 并将消息存入缓存和数据库
+ **注意事项: 入库前已被撤回的消息以撤回状态存入缓存和数据库, 不更新会话列表;
+ **          入库过程中被撤回的消息, 入库后补标撤回.
+ **作    者: # Qifeng.zou # 2016.12.28 22:05:51 #
+ ******************************************************************************/
+func (item *MesgGroupItem) storage(ctx *MsgSvrCntx) {
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	/* > 解析PB协议 */
+	chat := &mesg.MesgGroupChat{}
+
+	err := proto.Unmarshal(item.raw[comm.MESG_HEAD_SIZE:], chat)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-mesg failed!")
+		return
+	}
+
+	ctm := time.Now().Unix()
+
+	/* > 入库前已被撤回 */
+	rtm, ok := ctx.group_revoked(chat.GetGid(), chat.GetMsgid())
+	if ok {
+		chat.Revoked = proto.Uint32(1)
+		chat.Text = proto.String("")
+		chat.Data = nil
+
+		body, err := proto.Marshal(chat)
+		if nil != err {
+			ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+			return
+		}
+
+		key := fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, chat.GetGid())
+		pl.Send("LPUSH", key, body)
+
+		item.insert(ctx, chat, ctm, rtm)
+		return
+	}
+
+	/* > 更新群成员的会话列表(发送者不增加未读数) */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, item.req.GetGid())
+
+	uids, err := redis.Strings(pl.Do("ZRANGE", key, 0, -1))
+	if nil != err {
+		ctx.log.Error("Get group user list failed! gid:%d errmsg:%s",
+			item.req.GetGid(), err.Error())
+	}
+
+	last := im.ConvPreview(chat.GetUid(), chat.GetMsgid(), chat.GetText())
+	for _, str := range uids {
+		uid, _ := strconv.ParseUint(str, 10, 64)
+		if 0 == uid {
+			continue
+		}
+		im.ConvUpdate(pl, uid, comm.CHAT_CONV_TYPE_GROUP,
+			chat.GetGid(), ctm, last, uid != chat.GetUid())
+	}
+
+	/* > 通知被@及高级别消息的离线成员 */
+	ctx.group_chat_notify(chat, uids, last.Text, ctm)
+
+	/* > 提交REDIS缓存 */
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, item.req.GetGid())
+	pl.Send("LPUSH", key, item.raw[comm.MESG_HEAD_SIZE:])
+
+	pl.Do("") /* 确保缓存先于入库, 以便补标撤回时能找到消息 */
+
+	/* > 提交MONGO存储 */
+	item.insert(ctx, chat, ctm, 0)
+
+	/* > 入库过程中被撤回 */
+	rtm, ok = ctx.group_revoked(chat.GetGid(), chat.GetMsgid())
+	if !ok {
+		return
+	}
+
+	cond := bson.M{"gid": chat.GetGid(), "msgid": chat.GetMsgid()}
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": rtm}})
+	}
+
+	ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_GROUP_MESG, cb)
+
+	ctx.group_mesg_queue_revoke(chat.GetGid(), chat.GetMsgid())
+	ctx.group_conv_revoke(chat.GetGid(), chat.GetUid(), chat.GetMsgid())
+}
+
+/******************************************************************************
+ **函数名称: insert
+ **功    能: 群聊消息入库(MONGO)
+ **输入参数:
+ **     ctx: 全局对象
+ **     chat: 群聊消息
+ **     ctm: 发送时间
+ **     rtm: 撤回时间(0:未撤回)
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:52:38 #
+ ******************************************************************************/
+func (item *MesgGroupItem) insert(ctx *MsgSvrCntx, chat *mesg.MesgGroupChat, ctm int64, rtm int64) {
+	data := &history.GroupMesgTabRow{
+		Gid:   chat.GetGid(),
+		Uid:   chat.GetUid(),
+		Msgid: chat.GetMsgid(),
+		Ctm:   ctm,
+		Rtm:   rtm,
+		Data:  item.raw,
+	}
+
+	if 0 != rtm {
+		data.Revoked = 1
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Insert(data)
+	}
+
+	err := ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_GROUP_MESG, cb)
+	if nil != err {
+		ctx.log.Error("Insert group message failed! gid:%d msgid:%d errmsg:%s",
+			chat.GetGid(), chat.GetMsgid(), err.Error())
+	}
+}
+
+/******************************************************************************
+ **函数名称: group_chat_notify
+ **功    能: 群聊消息的离线通知
+ **输入参数:
+ **     chat: 群聊消息
+ **     uids: 群成员列表
+ **     text: 消息预览
+ **     ctm: 发送时间
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 消息级别不小于配置的阈值时, 通知所有离线成员;
+ **     2. 否则只通知被@的离线成员.
+ **注意事项: 不通知发送者; 被@的用户不是群成员时忽略.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) group_chat_notify(chat *mesg.MesgGroupChat,
+	uids []string, text string, ctm int64) {
+	if nil == ctx.notifier || 0 == len(uids) {
+		return
+	}
+
+	/* > 确定需要通知的成员 */
+	reason := MSGSVR_NOTIFY_REASON_MENTION
+	level := ctx.conf.Notify.Level
+
+	targets := make([]uint64, 0)
+	if 0 != level && chat.GetLevel() >= level {
+		reason = MSGSVR_NOTIFY_REASON_LEVEL
+		for _, str := range uids {
+			uid, _ := strconv.ParseUint(str, 10, 64)
+			if 0 == uid || uid == chat.GetUid() {
+				continue
+			}
+			targets = append(targets, uid)
+		}
+	} else if 0 != len(chat.GetAt()) {
+		members := make(map[string]bool, len(uids))
+		for _, str := range uids {
+			members[str] = true
+		}
+
+		mentioned := make(map[uint64]bool)
+		for _, str := range strings.Split(chat.GetAt(), ",") {
+			str = strings.TrimSpace(str)
+			uid, _ := strconv.ParseUint(str, 10, 64)
+			if 0 == uid || uid == chat.GetUid() || !members[str] || mentioned[uid] {
+				continue
+			}
+			mentioned[uid] = true
+			targets = append(targets, uid)
+		}
+	}
+
+	if 0 == len(targets) {
+		return
+	}
+
+	/* > 只通知离线成员 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	for _, uid := range targets {
+		rds.Send("SCARD", fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid))
+	}
+
+	rds.Flush()
+
+	for _, uid := range targets {
+		num, err := redis.Int(rds.Receive())
+		if nil != err || 0 != num {
+			continue
+		}
+
+		ctx.notify_push(&MsgSvrNotifyItem{
+			Uid:    uid,
+			Type:   comm.CHAT_CONV_TYPE_GROUP,
+			Id:     chat.GetGid(),
+			Suid:   chat.GetUid(),
+			Msgid:  chat.GetMsgid(),
+			Level:  chat.GetLevel(),
+			Reason: reason,
+			Text:   text,
+			Ctm:    ctm,
+		})
+	}
 }
 
 /******************************************************************************
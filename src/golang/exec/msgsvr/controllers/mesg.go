@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/garyburd/redigo/redis"
 	"github.com/golang/protobuf/proto"
@@ -178,6 +177,64 @@ func (ctx *MsgSvrCntx) sync_parse(data []byte) (
 	return head, req, 0, nil
 }
 
+/* 离线消息 */
+type MesgOfflineItem struct {
+	member string // 离线队列成员(格式:CHAT_FMT_OFFLINE_STR)
+	suid   uint64 // 消息发送者的UID
+	sid    uint64 // 消息发送者的SID
+	msgid  uint64 // 消息发送者的消息ID
+}
+
+/******************************************************************************
+ **函数名称: sync_trim
+ **功    能: 清理已确认的离线消息
+ **输入参数:
+ **     uid: 用户ID
+ **     since: 同步游标
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 序列号不大于since的离线消息已被客户端确认, 将其从离线队列及发送方
+ **          离线消息中删除.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:08:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) sync_trim(uid uint64, since uint64) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, uid)
+
+	mesg_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, "-inf", since))
+	if nil != err {
+		return err
+	} else if 0 == len(mesg_list) {
+		return nil
+	}
+
+	rds.Send("MULTI")
+
+	num := len(mesg_list)
+	for idx := 0; idx < num; idx += 1 {
+		var suid, sid, msgid uint64
+
+		n, _ := fmt.Sscanf(mesg_list[idx], comm.CHAT_FMT_OFFLINE_STR, &suid, &sid, &msgid)
+		if 3 != n {
+			continue
+		}
+
+		mesg_key := fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, suid)
+		data_key := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, sid, msgid)
+
+		rds.Send("HDEL", mesg_key, data_key)
+	}
+
+	rds.Send("ZREMRANGEBYSCORE", key, "-inf", since)
+
+	_, err = rds.Do("EXEC")
+
+	return err
+}
+
 /******************************************************************************
  **函数名称: sync_handler
  **功    能: 处理SYNC请求
@@ -186,14 +243,36 @@ func (ctx *MsgSvrCntx) sync_parse(data []byte) (
  **     req: SYNC请求
  **输出参数: NONE
  **返    回:
+ **     next: 下页游标(本页最后一条离线消息的序列号)
+ **     more: 是否还有离线消息(0:否 1:是)
  **     code: 错误码
  **     err: 错误描述
  **实现描述:
- **注意事项:
+ **     1. 清理序列号不大于since的离线消息(客户端已确认);
+ **     2. 按序列号从小到大取出一页离线消息, 并批量获取消息内容;
+ **     3. 逐条下发离线消息.
+ **注意事项: 所发消息的回执由receipt_resend分页补发
  **作    者: # Qifeng.zou # 2017.01.15 00:32:53 #
  ******************************************************************************/
-func (ctx *MsgSvrCntx) sync_handler(
-	head *comm.MesgHeader, req *mesg.MesgSync) (code uint32, err error) {
+func (ctx *MsgSvrCntx) sync_handler(head *comm.MesgHeader,
+	req *mesg.MesgSync) (next uint64, more uint32, code uint32, err error) {
+	/* > 清理已确认的离线消息 */
+	if 0 != req.GetSince() {
+		err = ctx.sync_trim(req.GetUid(), req.GetSince())
+		if nil != err {
+			ctx.log.Error("Trim offline message failed! uid:%d since:%d errmsg:%s",
+				req.GetUid(), req.GetSince(), err.Error())
+			return 0, 0, comm.ERR_SYS_DB, err
+		}
+	}
+
+	num := int(req.GetNum())
+	if 0 == num {
+		num = comm.CHAT_SYNC_DEF_NUM
+	} else if num > comm.CHAT_SYNC_MAX_NUM {
+		num = comm.CHAT_SYNC_MAX_NUM
+	}
+
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
@@ -203,69 +282,77 @@ func (ctx *MsgSvrCntx) sync_handler(
 		pl.Close()
 	}()
 
-	/* > 获取离线消息ID列表 */
+	/* > 获取一页离线消息ID列表(多取一条用于判断是否还有离线消息) */
 	key := fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, req.GetUid())
 
-	mesg_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, 0, "+inf"))
+	vals, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key,
+		fmt.Sprintf("(%d", req.GetSince()), "+inf", "WITHSCORES", "LIMIT", 0, num+1))
 	if nil != err {
 		ctx.log.Error("Get offline message failed! errmsg:%s", err.Error())
-		return comm.ERR_SYS_SYSTEM, err
+		return 0, 0, comm.ERR_SYS_SYSTEM, err
 	}
 
-	/* > 遍历离线消息ID列表 */
-	num := len(mesg_list)
-	for idx := 0; idx < num; idx += 1 {
-		vals := strings.Split(mesg_list[idx], ":")
-		uid, err := strconv.ParseInt(vals[0], 10, 64)   // 消息发送者的UID
-		sid, err := strconv.ParseInt(vals[1], 10, 64)   // 消息发送者的SID
-		msgid, err := strconv.ParseInt(vals[2], 10, 64) // 消息发送者的消息ID
-		if 0 == msgid || 0 == uid {
-			ctx.log.Error("Parse offline message failed! mesg:%s", mesg_list[idx])
-			pl.Send("ZREM", mesg_list[idx])
+	total := len(vals) / 2
+	if total > num {
+		total = num
+		more = 1
+	}
+
+	next = req.GetSince()
+
+	/* > 批量获取离线消息 */
+	list := make([]*MesgOfflineItem, 0, total)
+	for idx := 0; idx < total; idx += 1 {
+		item := &MesgOfflineItem{member: vals[2*idx]}
+
+		next, _ = strconv.ParseUint(vals[2*idx+1], 10, 64)
+
+		n, _ := fmt.Sscanf(item.member, comm.CHAT_FMT_OFFLINE_STR,
+			&item.suid, &item.sid, &item.msgid)
+		if 3 != n || 0 == item.suid || 0 == item.msgid {
+			ctx.log.Error("Parse offline message failed! mesg:%s", item.member)
+			pl.Send("ZREM", key, item.member)
 			continue
 		}
 
-		/* > 获取离线消息*/
-		mesg_key := fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, uid)
-		data_key := fmt.Sprintf("%d:%d", sid, msgid)
+		mesg_key := fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, item.suid)
+		data_key := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, item.sid, item.msgid)
 
-		data, err := redis.String(rds.Do("HGET", mesg_key, data_key))
+		rds.Send("HGET", mesg_key, data_key)
+
+		list = append(list, item)
+	}
+
+	rds.Flush()
+
+	/* > 逐条下发离线消息 */
+	for _, item := range list {
+		data, err := redis.Bytes(rds.Receive())
 		if nil != err {
-			ctx.log.Error("Get offline message failed! mesg:%s", mesg_list[idx])
-			pl.Send("ZREM", key, mesg_list[idx])
-			pl.Send("HDEL", mesg_key, msgid)
+			ctx.log.Error("Get offline message failed! mesg:%s", item.member)
+			pl.Send("ZREM", key, item.member)
 			continue
 		}
 
 		/* > 判断消息合法性 */
-		hhead := comm.MesgHeadNtoh([]byte(data))
-		if !hhead.IsValid(1) {
-			ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
-				head.GetCmd(), head.GetNid())
-			pl.Send("ZREM", key, mesg_list[idx])
-			pl.Send("HDEL", mesg_key, msgid)
+		hhead := comm.MesgHeadNtoh(data)
+		if !hhead.IsValid(1) ||
+			len(data) < comm.MESG_HEAD_SIZE+int(hhead.GetLength()) {
+			ctx.log.Error("Offline message is invalid! cmd:0x%04X mesg:%s",
+				hhead.GetCmd(), item.member)
+			mesg_key := fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, item.suid)
+			data_key := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, item.sid, item.msgid)
+			pl.Send("ZREM", key, item.member)
+			pl.Send("HDEL", mesg_key, data_key)
 			continue
 		}
 
-		switch hhead.GetCmd() {
-		case comm.CMD_CHAT: // 私聊消息
-			msg := &mesg.MesgChat{}
-
-			err = proto.Unmarshal([]byte(data[comm.MESG_HEAD_SIZE:]), msg)
-			if nil != err {
-				ctx.log.Error("Unmarshal offline message failed! uid:%d msgid:%d errmsg:%s",
-					uid, msgid, err.Error())
-				pl.Send("ZREM", key, mesg_list[idx])
-				pl.Send("HDEL", mesg_key, msgid)
-				continue
-			}
-
-			/* > 下发离线消息*/
-			ctx.send_data(comm.CMD_CHAT, head.GetSid(), head.GetCid(), head.GetNid(),
-				uint64(msgid), []byte(data[comm.MESG_HEAD_SIZE:]), hhead.GetLength())
-		}
+		/* > 下发离线消息 */
+		ctx.send_data(hhead.GetCmd(), head.GetSid(), head.GetCid(), head.GetNid(),
+			item.msgid, data[comm.MESG_HEAD_SIZE:], hhead.GetLength())
 	}
-	return 0, nil
+
+	return next, more, 0, nil
 }
 
 /******************************************************************************
@@ -335,6 +422,10 @@ func (ctx *MsgSvrCntx) sync_failed(head *comm.MesgHeader,
  **输入参数:
  **     head: 协议头
  **     req: SYNC请求
+ **     next: 下页游标
+ **     more: 是否还有离线消息
+ **     rnext: 回执下页游标
+ **     rmore: 是否还有回执
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述: 生成PB格式消息应答 并发送应答.
@@ -343,16 +434,25 @@ func (ctx *MsgSvrCntx) sync_failed(head *comm.MesgHeader,
  **         required uint64 uid = 1;    // M|用户ID|数字|
  **         required uint32 code = 2;   // M|错误码|数字|
  **         required string errmsg = 3; // M|错误描述|字串|
+ **         optional uint64 next = 4;   // O|下页游标|数字|
+ **         optional uint32 more = 5;   // O|是否还有离线消息|数字|
+ **         optional uint64 receipt_next = 6; // O|回执下页游标|数字|
+ **         optional uint32 receipt_more = 7; // O|是否还有回执|数字|
  **     }
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.14 23:08:08 #
  ******************************************************************************/
-func (ctx *MsgSvrCntx) sync_ack(head *comm.MesgHeader, req *mesg.MesgSync) int {
+func (ctx *MsgSvrCntx) sync_ack(head *comm.MesgHeader,
+	req *mesg.MesgSync, next uint64, more uint32, rnext uint64, rmore uint32) int {
 	/* > 设置协议体 */
 	ack := &mesg.MesgSyncAck{
-		Uid:    proto.Uint64(req.GetUid()),
-		Code:   proto.Uint32(0),
-		Errmsg: proto.String("Ok"),
+		Uid:         proto.Uint64(req.GetUid()),
+		Code:        proto.Uint32(0),
+		Errmsg:      proto.String("Ok"),
+		Next:        proto.Uint64(next),
+		More:        proto.Uint32(more),
+		ReceiptNext: proto.Uint64(rnext),
+		ReceiptMore: proto.Uint32(rmore),
 	}
 
 	/* 生成PB数据 */
@@ -391,7 +491,7 @@ func (ctx *MsgSvrCntx) sync_ack(head *comm.MesgHeader, req *mesg.MesgSync) int {
  **     param: 附加参
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
- **实现描述: 收到同步请求后, 下发离线消息.
+ **实现描述: 收到同步请求后, 分页下发离线消息及所发消息的回执.
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.14 22:49:17 #
  ******************************************************************************/
@@ -426,14 +526,22 @@ func MsgSvrSyncHandler(cmd uint32, nid uint32,
 	}
 
 	/* > 处理消息同步请求 */
-	code, err = ctx.sync_handler(head, req)
+	next, more, code, err := ctx.sync_handler(head, req)
 	if nil != err {
 		ctx.log.Error("Handle sync request failed! errmsg:%s", err.Error())
 		ctx.sync_failed(head, req, code, err.Error())
 		return -1
 	}
 
-	ctx.sync_ack(head, req)
+	/* > 补发所发消息的回执 */
+	rnext, rmore, code, err := ctx.receipt_resend(head, req)
+	if nil != err {
+		ctx.log.Error("Resend receipt failed! errmsg:%s", err.Error())
+		ctx.sync_failed(head, req, code, err.Error())
+		return -1
+	}
+
+	ctx.sync_ack(head, req, next, more, rnext, rmore)
 
 	return 0
 }
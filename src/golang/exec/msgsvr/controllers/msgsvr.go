@@ -45,14 +45,16 @@ type MesgGroupItem struct {
 
 /* MSGSVR上下文 */
 type MsgSvrCntx struct {
-	conf            *conf.MsgSvrConf    /* 配置信息 */
-	log             *logs.BeeLogger     /* 日志对象 */
-	frwder          *rtmq.Proxy         /* 代理对象 */
-	redis           *redis.Pool         /* REDIS连接池 */
-	mongo           *mongo.Pool         /* MONGO连接池 */
-	group           GroupMap            /* 群组映射 */
-	group_mesg_chan chan *MesgGroupItem /* 组聊消息存储队列 */
-	chat_chan       chan *MesgChatItem  /* 私聊消息存储队列 */
+	conf            *conf.MsgSvrConf       /* 配置信息 */
+	log             *logs.BeeLogger        /* 日志对象 */
+	frwder          *rtmq.Proxy            /* 代理对象 */
+	redis           *redis.Pool            /* REDIS连接池 */
+	mongo           *mongo.Pool            /* MONGO连接池 */
+	group           GroupMap               /* 群组映射 */
+	group_mesg_chan chan *MesgGroupItem    /* 组聊消息存储队列 */
+	chat_chan       chan *MesgChatItem     /* 私聊消息存储队列 */
+	notifier        MsgSvrNotifier         /* 离线通知对象(nil:不通知) */
+	notify_chan     chan *MsgSvrNotifyItem /* 离线通知队列 */
 }
 
 /******************************************************************************
@@ -105,8 +107,15 @@ func MsgSvrInit(conf *conf.MsgSvrConf) (ctx *MsgSvrCntx, err error) {
 	}
 
 	/* > 初始化存储队列 */
-	ctx.group_mesg_chan = make(chan *MesgGroupItem, 100000)
-	ctx.chat_chan = make(chan *MesgChatItem, 100000)
+	ctx.group_mesg_chan = make(chan *MesgGroupItem, conf.Storage.ChanLen)
+	ctx.chat_chan = make(chan *MesgChatItem, conf.Storage.ChanLen)
+
+	/* > 初始化离线通知 */
+	err = ctx.notify_init()
+	if nil != err {
+		ctx.log.Error("Init notify failed! type:%s errmsg:%s", conf.Notify.Type, err.Error())
+		return nil, err
+	}
 
 	return ctx, nil
 }
@@ -124,6 +133,8 @@ func MsgSvrInit(conf *conf.MsgSvrConf) (ctx *MsgSvrCntx, err error) {
 func (ctx *MsgSvrCntx) Register() {
 	/* > 通用消息 */
 	ctx.frwder.Register(comm.CMD_SYNC, MsgSvrSyncHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CONV_LIST, MsgSvrConvListHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CONV_READ, MsgSvrConvReadHandler, ctx)
 	//ctx.frwder.Register(comm.CMD_P2P, MsgSvrP2pHandler, ctx)
 
 	//ctx.frwder.Register(comm.CMD_P2P_ACK, MsgSvrP2pAckHandler, ctx)
@@ -131,10 +142,15 @@ func (ctx *MsgSvrCntx) Register() {
 	/* > 私聊消息 */
 	ctx.frwder.Register(comm.CMD_CHAT, MsgSvrChatHandler, ctx)
 	ctx.frwder.Register(comm.CMD_CHAT_ACK, MsgSvrChatAckHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECV, MsgSvrChatReceiptHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_READ, MsgSvrChatReceiptHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECALL, MsgSvrChatRecallHandler, ctx)
 
 	/* > 群聊消息 */
 	ctx.frwder.Register(comm.CMD_GROUP_CHAT, MsgSvrGroupChatHandler, ctx)
 	ctx.frwder.Register(comm.CMD_GROUP_CHAT_ACK, MsgSvrGroupChatAckHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_RECALL, MsgSvrGroupRecallHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_SYNC, MsgSvrGroupSyncHandler, ctx)
 
 	/* > 推送消息 */
 	ctx.frwder.Register(comm.CMD_BC, MsgSvrBcHandler, ctx)
@@ -0,0 +1,439 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"beehive-im/src/golang/lib/comm"
+
+	"beehive-im/src/golang/exec/msgsvr/controllers/conf"
+)
+
+/* 离线通知原因 */
+const (
+	MSGSVR_NOTIFY_REASON_CHAT    = "chat"    // 私聊消息
+	MSGSVR_NOTIFY_REASON_MENTION = "mention" // 群聊中被@
+	MSGSVR_NOTIFY_REASON_LEVEL   = "level"   // 高级别群聊消息
+)
+
+/* 设备TOKEN */
+type MsgSvrDevToken struct {
+	Token    string `json:"token"`    // 设备TOKEN
+	Platform string `json:"platform"` // 设备平台(如: ios android)
+}
+
+/* 离线通知项 */
+type MsgSvrNotifyItem struct {
+	Uid    uint64           `json:"uid"`    // 接收通知的UID
+	Tokens []MsgSvrDevToken `json:"tokens"` // 设备TOKEN列表
+	Type   uint32           `json:"type"`   // 会话类型(1:私聊 2:群聊)
+	Id     uint64           `json:"id"`     // 会话ID(对端UID/GID)
+	Suid   uint64           `json:"suid"`   // 发送者UID
+	Msgid  uint64           `json:"msgid"`  // 消息ID
+	Level  uint32           `json:"level"`  // 消息级别
+	Reason string           `json:"reason"` // 通知原因(chat:私聊 mention:被@ level:高级别消息)
+	Text   string           `json:"text"`   // 消息预览
+	Ctm    int64            `json:"ctm"`    // 发送时间
+}
+
+/* 离线通知接口 */
+type MsgSvrNotifier interface {
+	Notify(item *MsgSvrNotifyItem) error // 发送离线通知
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// HTTP回调通知
+
+/* HTTP回调通知 */
+type MsgSvrWebhookNotifier struct {
+	url    string       /* 回调地址 */
+	client *http.Client /* HTTP客户端 */
+}
+
+/******************************************************************************
+ **函数名称: CreateWebhookNotifier
+ **功    能: 创建HTTP回调通知对象
+ **输入参数:
+ **     url: 回调地址
+ **     timeout: 超时时间(秒)
+ **输出参数: NONE
+ **返    回: 通知对象
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func CreateWebhookNotifier(url string, timeout int64) *MsgSvrWebhookNotifier {
+	return &MsgSvrWebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+}
+
+/******************************************************************************
+ **函数名称: Notify
+ **功    能: 发送离线通知
+ **输入参数:
+ **     item: 通知项
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 以JSON格式POST给推送网关
+ **注意事项: 推送网关返回非2XX状态码时, 视为发送失败.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (n *MsgSvrWebhookNotifier) Notify(item *MsgSvrNotifyItem) error {
+	data, err := json.Marshal(item)
+	if nil != err {
+		return err
+	}
+
+	rsp, err := n.client.Post(n.url, "application/json", bytes.NewReader(data))
+	if nil != err {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return errors.New(fmt.Sprintf("Webhook return status %d!", rsp.StatusCode))
+	}
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 文件通知(用于测试)
+
+/* 文件通知 */
+type MsgSvrFileNotifier struct {
+	sync.Mutex          /* 互斥锁 */
+	fp         *os.File /* 文件句柄 */
+}
+
+/******************************************************************************
+ **函数名称: CreateFileNotifier
+ **功    能: 创建文件通知对象
+ **输入参数:
+ **     path: 文件路径
+ **输出参数: NONE
+ **返    回:
+ **     n: 通知对象
+ **     err: 错误信息
+ **实现描述: 以追加方式打开文件
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func CreateFileNotifier(path string) (n *MsgSvrFileNotifier, err error) {
+	fp, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if nil != err {
+		return nil, err
+	}
+
+	return &MsgSvrFileNotifier{fp: fp}, nil
+}
+
+/******************************************************************************
+ **函数名称: Notify
+ **功    能: 发送离线通知
+ **输入参数:
+ **     item: 通知项
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 每条通知以一行JSON写入文件
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (n *MsgSvrFileNotifier) Notify(item *MsgSvrNotifyItem) error {
+	data, err := json.Marshal(item)
+	if nil != err {
+		return err
+	}
+
+	n.Lock()
+	defer n.Unlock()
+
+	_, err = n.fp.Write(append(data, '\n'))
+
+	return err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 离线通知处理
+
+/******************************************************************************
+ **函数名称: notify_init
+ **功    能: 初始化离线通知
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 根据配置创建通知对象及通知队列(长度为CHAN-LEN)
+ **注意事项: 通知方式为none时, 不创建通知对象, 也不下发离线通知.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_init() (err error) {
+	cf := &ctx.conf.Notify
+
+	switch cf.Type {
+	case conf.MSGSVR_NOTIFY_TYPE_WEBHOOK:
+		ctx.notifier = CreateWebhookNotifier(cf.Url, cf.Timeout)
+	case conf.MSGSVR_NOTIFY_TYPE_FILE:
+		ctx.notifier, err = CreateFileNotifier(cf.Path)
+		if nil != err {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	ctx.notify_chan = make(chan *MsgSvrNotifyItem, cf.ChanLen)
+
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: notify_push
+ **功    能: 将离线通知放入通知队列
+ **输入参数:
+ **     item: 通知项
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **注意事项: 不阻塞消息处理流程, 队列已满时直接丢弃.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_push(item *MsgSvrNotifyItem) {
+	if nil == ctx.notifier {
+		return
+	}
+
+	select {
+	case ctx.notify_chan <- item:
+	default:
+		ctx.log.Error("Notify queue is full! uid:%d type:%d id:%d msgid:%d",
+			item.Uid, item.Type, item.Id, item.Msgid)
+	}
+}
+
+/******************************************************************************
+ **函数名称: notify_tokens
+ **功    能: 获取用户的设备TOKEN列表
+ **输入参数:
+ **     rds: REDIS连接
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回:
+ **     tokens: 设备TOKEN列表
+ **     err: 错误信息
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_tokens(rds redis.Conn, uid uint64) (tokens []MsgSvrDevToken, err error) {
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_DEV_TOKEN_TAB, uid)
+
+	vals, err := redis.Strings(rds.Do("HGETALL", key))
+	if nil != err {
+		return nil, err
+	}
+
+	tokens = make([]MsgSvrDevToken, 0, len(vals)/2)
+	for idx := 0; idx+1 < len(vals); idx += 2 {
+		tokens = append(tokens, MsgSvrDevToken{Token: vals[idx], Platform: vals[idx+1]})
+	}
+
+	return tokens, nil
+}
+
+/******************************************************************************
+ **函数名称: notify_quiet_at
+ **功    能: 判断某时刻是否处于免打扰时段
+ **输入参数:
+ **     start: 免打扰起始时间(HHMM)
+ **     end: 免打扰截止时间(HHMM)
+ **     tz: 用户时区(UTC偏移分钟数)
+ **     now: 当前时间
+ **输出参数: NONE
+ **返    回: true:免打扰 false:非免打扰
+ **实现描述: 将当前时间换算为用户时区的HHMM后比较, 起始时间大于截止时间时表示跨越零点.
+ **注意事项: 起止时间相同时, 表示不启用免打扰.
+ **作    者: # agent # 2026.10.18 08:05:45 #
+ ******************************************************************************/
+func notify_quiet_at(start int64, end int64, tz int64, now time.Time) bool {
+	if start == end {
+		return false
+	}
+
+	local := now.UTC().Add(time.Duration(tz) * time.Minute)
+
+	hhmm := int64(local.Hour()*100 + local.Minute())
+	if start < end {
+		return hhmm >= start && hhmm < end
+	}
+
+	return hhmm >= start || hhmm < end /* 跨越零点 */
+}
+
+/******************************************************************************
+ **函数名称: notify_is_quiet
+ **功    能: 判断当前是否处于用户的免打扰时段
+ **输入参数:
+ **     rds: REDIS连接
+ **     uid: 用户ID
+ **     now: 当前时间
+ **输出参数: NONE
+ **返    回: true:免打扰 false:非免打扰
+ **实现描述: 免打扰时段以用户时区的HHMM表示(见notify_quiet_at).
+ **注意事项: 未设置时区时(早期设置的免打扰时段), 按本机时区计算.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_is_quiet(rds redis.Conn, uid uint64, now time.Time) bool {
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_NOTIFY_ATTR, uid)
+
+	vals, err := redis.Strings(rds.Do("HMGET", key, comm.CHAT_NOTIFY_ATTR_QUIET_START,
+		comm.CHAT_NOTIFY_ATTR_QUIET_END, comm.CHAT_NOTIFY_ATTR_QUIET_TZ))
+	if nil != err || 3 != len(vals) {
+		return false
+	}
+
+	start, err := strconv.ParseInt(vals[0], 10, 32)
+	if nil != err {
+		return false
+	}
+
+	end, err := strconv.ParseInt(vals[1], 10, 32)
+	if nil != err {
+		return false
+	}
+
+	tz, err := strconv.ParseInt(vals[2], 10, 32)
+	if nil != err {
+		_, offset := now.Zone()
+		tz = int64(offset / 60)
+	}
+
+	return notify_quiet_at(start, end, tz, now)
+}
+
+/******************************************************************************
+ **函数名称: notify_is_limited
+ **功    能: 判断用户的离线通知是否超过频率限制
+ **输入参数:
+ **     rds: REDIS连接
+ **     uid: 用户ID
+ **     now: 当前时间
+ **输出参数: NONE
+ **返    回: true:超过限制 false:未超过限制
+ **实现描述: 以分钟为单位计数, 计数KEY在2分钟后自动过期.
+ **注意事项: 计数失败时不做限制
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_is_limited(rds redis.Conn, uid uint64, now time.Time) bool {
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_NOTIFY_RATE, uid, now.Unix()/60)
+
+	num, err := redis.Int64(rds.Do("INCR", key))
+	if nil != err {
+		ctx.log.Error("Incr notify rate failed! uid:%d errmsg:%s", uid, err.Error())
+		return false
+	} else if 1 == num {
+		rds.Do("EXPIRE", key, 120)
+	}
+
+	return num > ctx.conf.Notify.Rate
+}
+
+/******************************************************************************
+ **函数名称: notify_handler
+ **功    能: 离线通知的处理
+ **输入参数:
+ **     item: 通知项
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 获取设备TOKEN, 未注册设备TOKEN时不通知.
+ **     2. 处于免打扰时段时不通知.
+ **     3. 超过频率限制时不通知.
+ **     4. 调用通知对象发送离线通知.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) notify_handler(item *MsgSvrNotifyItem) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	now := time.Now()
+
+	/* > 获取设备TOKEN */
+	tokens, err := ctx.notify_tokens(rds, item.Uid)
+	if nil != err {
+		ctx.log.Error("Get device token failed! uid:%d errmsg:%s", item.Uid, err.Error())
+		return
+	} else if 0 == len(tokens) {
+		return
+	}
+	item.Tokens = tokens
+
+	/* > 免打扰及频率限制 */
+	if ctx.notify_is_quiet(rds, item.Uid, now) {
+		ctx.log.Debug("User is in quiet hours! uid:%d", item.Uid)
+		return
+	} else if ctx.notify_is_limited(rds, item.Uid, now) {
+		ctx.log.Debug("Notify rate is limited! uid:%d", item.Uid)
+		return
+	}
+
+	/* > 发送离线通知 */
+	err = ctx.notifier.Notify(item)
+	if nil != err {
+		ctx.log.Error("Send notify failed! uid:%d type:%d id:%d msgid:%d errmsg:%s",
+			item.Uid, item.Type, item.Id, item.Msgid, err.Error())
+		return
+	}
+
+	ctx.log.Debug("Send notify success! uid:%d type:%d id:%d msgid:%d reason:%s",
+		item.Uid, item.Type, item.Id, item.Msgid, item.Reason)
+}
+
+/******************************************************************************
+ **函数名称: task_notify_chan_pop
+ **功    能: 离线通知的发送任务
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回:
+ **实现描述: 启动WORKER-NUM个发送协程, 共同从离线通知队列中取出通知项并发送,
+ **          避免单个推送网关请求超时阻塞后续通知.
+ **注意事项: 未启用离线通知时, 直接退出.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) task_notify_chan_pop() {
+	if nil == ctx.notifier {
+		return
+	}
+
+	num := int(ctx.conf.Notify.WorkerNum)
+	for idx := 0; idx < num; idx += 1 {
+		go ctx.task_notify_worker()
+	}
+}
+
+/******************************************************************************
+ **函数名称: task_notify_worker
+ **功    能: 离线通知的发送协程
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回:
+ **实现描述: 从离线通知队列中取出通知项, 并进行发送处理
+ **注意事项:
+ **作    者: # agent # 2026.10.18 08:05:45 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) task_notify_worker() {
+	for item := range ctx.notify_chan {
+		ctx.notify_handler(item)
+	}
+}
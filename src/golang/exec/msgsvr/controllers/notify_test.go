@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotifyQuietAt(t *testing.T) {
+	utc := func(hour, min int) time.Time {
+		return time.Date(2017, 1, 1, hour, min, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		name  string
+		start int64
+		end   int64
+		tz    int64 // UTC偏移分钟数
+		now   time.Time
+		quiet bool
+	}{
+		{"disabled", 2300, 2300, 0, utc(23, 30), false},
+		{"same day inside", 1200, 1400, 0, utc(13, 0), true},
+		{"same day at start", 1200, 1400, 0, utc(12, 0), true},
+		{"same day at end", 1200, 1400, 0, utc(14, 0), false},
+		{"same day outside", 1200, 1400, 0, utc(15, 0), false},
+		{"overnight before midnight", 2300, 700, 0, utc(23, 30), true},
+		{"overnight after midnight", 2300, 700, 0, utc(6, 59), true},
+		{"overnight outside", 2300, 700, 0, utc(7, 0), false},
+		{"utc+8 inside", 2300, 700, 480, utc(15, 30), true},   /* 本地23:30 */
+		{"utc+8 outside", 2300, 700, 480, utc(23, 30), false}, /* 本地次日07:30 */
+		{"utc-5 inside", 2300, 700, -300, utc(5, 0), true},    /* 本地00:00 */
+		{"utc-5 outside", 2300, 700, -300, utc(13, 0), false}, /* 本地08:00 */
+		{"utc+5:30 half hour", 2300, 700, 330, utc(17, 29), false},
+		{"utc+5:30 half hour inside", 2300, 700, 330, utc(17, 30), true},
+		{"non-utc now", 2300, 700, 480, utc(15, 30).In(time.FixedZone("X", -3600)), true},
+	}
+
+	for _, c := range cases {
+		if quiet := notify_quiet_at(c.start, c.end, c.tz, c.now); quiet != c.quiet {
+			t.Errorf("%s: notify_quiet_at(%d, %d, %d, %s) = %v, want %v",
+				c.name, c.start, c.end, c.tz, c.now, quiet, c.quiet)
+		}
+	}
+}
@@ -6,10 +6,14 @@ import (
 	"strconv"
 	"time"
 
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
 	"github.com/garyburd/redigo/redis"
 	"github.com/golang/protobuf/proto"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/history"
 	"beehive-im/src/golang/lib/im"
 	"beehive-im/src/golang/lib/mesg"
 )
@@ -143,6 +147,80 @@ func (ctx *MsgSvrCntx) chat_ack(head *comm.MesgHeader, req *mesg.MesgChat) int {
 		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
+/******************************************************************************
+ **函数名称: chat_check
+ **功    能: 校验发送方是否有权限给接收方发送私聊消息
+ **输入参数:
+ **     req: CHAT请求
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 发送方在接收方的黑名单中时, 返回ERR_SVR_IN_BLACKLIST;
+ **     2. 发送方被接收方禁言时, 返回ERR_SVR_IN_GAG.
+ **注意事项: 黑名单及禁言列表由USRSVR维护
+ **作    者: # agent # 2026.10.18 06:58:05 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_check(req *mesg.MesgChat) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_BLACKLIST_TAB, req.GetDuid())
+	rds.Send("HEXISTS", key, req.GetSuid())
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_GAG_ZSET, req.GetDuid())
+	rds.Send("ZSCORE", key, req.GetSuid())
+
+	rds.Flush()
+
+	/* > 是否在黑名单中 */
+	ok, err := redis.Bool(rds.Receive())
+	if nil != err {
+		ctx.log.Error("Check blacklist failed! errmsg:%s", err.Error())
+		rds.Receive()
+		return comm.ERR_SYS_DB, err
+	} else if ok {
+		rds.Receive()
+		return comm.ERR_SVR_IN_BLACKLIST, errors.New("In blacklist of the user!")
+	}
+
+	/* > 是否被禁言 */
+	_, err = redis.Int64(rds.Receive())
+	if nil == err {
+		return comm.ERR_SVR_IN_GAG, errors.New("Gagged by the user!")
+	} else if redis.ErrNil != err {
+		ctx.log.Error("Check gag failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: chat_fill
+ **功    能: 填写发送方会话SID及消息ID
+ **输入参数:
+ **     head: 协议头
+ **     req: CHAT请求
+ **输出参数: NONE
+ **返    回:
+ **     data: 重新生成的原始数据(协议头+协议体)
+ **     err: 错误描述
+ **实现描述: 接收方据此发送消息回执, 即: 以(suid, sid, msgid)唯一确定一条私聊消息.
+ **注意事项: 协议头中的报体长度将被同步更新
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_fill(head *comm.MesgHeader, req *mesg.MesgChat) (data []byte, err error) {
+	req.Sid = proto.Uint64(head.GetSid())
+	req.Msgid = proto.Uint64(head.GetSeq())
+
+	body, err := proto.Marshal(req)
+	if nil != err {
+		return nil, err
+	}
+
+	return ctx.mesg_pack(head, body), nil
+}
+
 /******************************************************************************
  **函数名称: chat_handler
  **功    能: CHAT处理
@@ -153,13 +231,15 @@ func (ctx *MsgSvrCntx) chat_ack(head *comm.MesgHeader, req *mesg.MesgChat) int {
  **输出参数: NONE
  **返    回: 错误码+错误描述
  **实现描述:
+ **     0. 校验黑名单及禁言(校验失败时不存储, 也不下发给任何终端),
+ **        并填写发送方会话SID及消息ID, 同时记录已发消息索引(用于校验回执)
  **     1. 将消息放入UID离线队列
  **	    2. 发送给"发送方"的其他终端.
  **        > 如果在线, 则直接下发消息
  **        > 如果不在线, 则无需下发消息
  **     3. 判断接收方是否在线.
  **        > 如果在线, 则直接下发消息
- **        > 如果不在线, 则无需下发消息
+ **        > 如果不在线, 则发送离线通知
  **注意事项:
  **作    者: # Qifeng.zou # 2016.12.18 20:33:18 #
  ******************************************************************************/
@@ -167,9 +247,36 @@ func (ctx *MsgSvrCntx) chat_handler(head *comm.MesgHeader,
 	req *mesg.MesgChat, data []byte) (code uint32, err error) {
 	var key string
 
+	/* > 校验黑名单及禁言 */
+	code, err = ctx.chat_check(req)
+	if nil != err {
+		return code, err
+	}
+
+	/* > 填写发送方会话SID及消息ID(用于消息回执) */
+	data, err = ctx.chat_fill(head, req)
+	if nil != err {
+		ctx.log.Error("Fill chat message failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
+	/* > 记录已发消息索引(用于校验回执) */
+	ctm := time.Now().Unix()
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_SENT_ZSET, req.GetSuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_SENT_STR, head.GetSid(), head.GetSeq(), req.GetDuid())
+
+	rds.Send("ZADD", key, ctm, member)
+	rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm-comm.CHAT_SENT_TTL)
+	_, err = rds.Do("ZREMRANGEBYRANK", key, 0, -(comm.CHAT_SENT_MAX_NUM + 1))
+	if nil != err {
+		ctx.log.Error("Add sent index failed! uid:%d errmsg:%s", req.GetSuid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
 	/* > 将消息放入离线队列 */
 	item := &MesgChatItem{
 		head: head,
@@ -225,6 +332,7 @@ func (ctx *MsgSvrCntx) chat_handler(head *comm.MesgHeader,
 		return comm.ERR_SYS_DB, err
 	}
 
+	total := 0
 	num = len(sid_list)
 	for idx := 0; idx < num; idx += 1 {
 		sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
@@ -247,6 +355,22 @@ func (ctx *MsgSvrCntx) chat_handler(head *comm.MesgHeader,
 
 		ctx.send_data(comm.CMD_CHAT, uint64(sid), attr.GetCid(), uint32(attr.GetNid()),
 			head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+		total += 1
+	}
+
+	/* > 接收方不在线时, 发送离线通知 */
+	if 0 == total {
+		ctx.notify_push(&MsgSvrNotifyItem{
+			Uid:    req.GetDuid(),
+			Type:   comm.CHAT_CONV_TYPE_CHAT,
+			Id:     req.GetSuid(),
+			Suid:   req.GetSuid(),
+			Msgid:  head.GetSeq(),
+			Level:  req.GetLevel(),
+			Reason: MSGSVR_NOTIFY_REASON_CHAT,
+			Text:   im.ConvPreview(req.GetSuid(), head.GetSeq(), req.GetText()).Text,
+			Ctm:    ctm,
+		})
 	}
 
 	return 0, nil
@@ -355,7 +479,7 @@ func (ctx *MsgSvrCntx) chat_ack_parse(data []byte) (
  **输出参数: NONE
  **返    回: 错误码+错误信息
  **实现描述: 清理离线消息
- **注意事项:
+ **注意事项: 消息由(发送方UID, 发送方会话SID, 消息ID)唯一确定, 其中会话SID取自应答中的sid字段
  **作    者: # Qifeng.zou # 2016.12.26 21:01:12 #
  ******************************************************************************/
 func (ctx *MsgSvrCntx) chat_ack_handler(
@@ -373,11 +497,12 @@ func (ctx *MsgSvrCntx) chat_ack_handler(
 
 	/* 清理离线消息 */
 	key := fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, req.GetDuid())
-	field := fmt.Sprintf(comm.CHAT_FMT_UID_MSGID_STR, req.GetSuid(), head.GetSeq())
-	rds.Send("ZREM", key, field)
+	member := fmt.Sprintf(comm.CHAT_FMT_OFFLINE_STR, req.GetSuid(), req.GetSid(), head.GetSeq())
+	rds.Send("ZREM", key, member)
 
 	key = fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, req.GetSuid())
-	rds.Send("HDEL", key, head.GetSeq())
+	field := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, req.GetSid(), head.GetSeq())
+	rds.Send("HDEL", key, field)
 
 	return 0, nil
 }
@@ -423,37 +548,340 @@ func MsgSvrChatAckHandler(cmd uint32, orig uint32,
 }
 
 ////////////////////////////////////////////////////////////////////////////////
-// 定时任务
+// 消息回执
 
 /******************************************************************************
- **函数名称: task_chat_chan_pop
- **功    能: 私聊消息的存储任务
- **输入参数: NONE
+ **函数名称: receipt_parse
+ **功    能: 解析消息回执
+ **输入参数:
+ **     data: 接收的数据
  **输出参数: NONE
  **返    回:
- **实现描述: 从私聊消息队列中取出消息, 并进行存储处理
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
  **注意事项:
- **作    者: # Qifeng.zou # 2016.12.27 11:03:42 #
+ **作    者: # agent # 2026.10.18 07:01:34 #
  ******************************************************************************/
-func (ctx *MsgSvrCntx) task_chat_chan_pop() {
-	for item := range ctx.chat_chan {
-		item.storage(ctx)
+func (ctx *MsgSvrCntx) receipt_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgChatReceipt, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of receipt is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgChatReceipt{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal receipt failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetSuid() || 0 == req.GetSid() || 0 == req.GetDuid() {
+		ctx.log.Error("Paramter isn't right! suid:%d sid:%d duid:%d",
+			req.GetSuid(), req.GetSid(), req.GetDuid())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
 	}
+
+	return head, req, 0, nil
 }
 
 /******************************************************************************
- **函数名称: storage
- **功    能: 私聊消息的存储处理
+ **函数名称: receipt_ack
+ **功    能: 发送消息回执应答
  **输入参数:
- **     ctx: 全局对象
+ **     cmd: 应答命令(CMD_CHAT_RECV_ACK/CMD_CHAT_READ_ACK)
+ **     head: 协议头
+ **     req: 消息回执
+ **     code: 错误码
+ **     errmsg: 错误描述
  **输出参数: NONE
- **返    回: NONE
- **实现描述: 将私聊消息存入缓存和数据库
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 suid = 1;       // M|原消息发送方UID|数字|
+ **         required uint64 sid = 2;        // M|原消息发送方会话SID|数字|
+ **         required uint64 msgid = 3;      // M|原消息ID|数字|
+ **         required uint32 code = 4;       // M|错误码|数字|
+ **         required string errmsg = 5;     // M|错误描述|字串|
+ **     }
  **注意事项:
- **作    者: # Qifeng.zou # 2016.12.27 11:03:42 #
+ **作    者: # agent # 2026.10.18 07:01:34 #
  ******************************************************************************/
-func (item *MesgChatItem) storage(ctx *MsgSvrCntx) {
-	var key string
+func (ctx *MsgSvrCntx) receipt_ack(cmd uint32, head *comm.MesgHeader,
+	req *mesg.MesgChatReceipt, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgChatReceiptAck{
+		Suid:   proto.Uint64(req.GetSuid()),
+		Sid:    proto.Uint64(req.GetSid()),
+		Msgid:  proto.Uint64(req.GetMsgid()),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(cmd, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: receipt_check
+ **功    能: 校验回执所指的消息是否确实发给了回执发送方
+ **输入参数:
+ **     req: 消息回执
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 优先查询发送方的已发消息索引(CHAT_KEY_USR_SENT_ZSET);
+ **     2. 索引中不存在时(已超过CHAT_SENT_TTL), 再从MONGO中查询原消息.
+ **注意事项: 消息由(suid, sid, msgid)唯一确定, 其接收方须与回执中的duid一致
+ **作    者: # agent # 2026.10.18 07:51:04 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) receipt_check(req *mesg.MesgChatReceipt) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 查询已发消息索引 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_SENT_ZSET, req.GetSuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_SENT_STR, req.GetSid(), req.GetMsgid(), req.GetDuid())
+
+	_, err = redis.Int64(rds.Do("ZSCORE", key, member))
+	if nil == err {
+		return 0, nil
+	} else if redis.ErrNil != err {
+		ctx.log.Error("Get sent index failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 查询原消息(MONGO) */
+	row := &history.ChatMesgTabRow{}
+	cond := bson.M{"suid": req.GetSuid(), "sid": req.GetSid(), "msgid": req.GetMsgid()}
+
+	code = comm.ERR_SYS_DB
+
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Find(cond).One(row)
+		if mgo.ErrNotFound == err {
+			code = comm.ERR_SVR_MESG_NOT_EXIST
+			return errors.New("Message not exist!")
+		} else if nil != err {
+			return err
+		} else if row.Duid != req.GetDuid() {
+			code = comm.ERR_SVR_DATA_COLLISION
+			return errors.New("Duid is collision!")
+		}
+		return nil
+	}
+
+	err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_CHAT_MESG, cb)
+	if nil != err {
+		return code, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: receipt_handler
+ **功    能: 消息回执处理
+ **输入参数:
+ **     cmd: 命令类型(CMD_CHAT_RECV/CMD_CHAT_READ)
+ **     head: 协议头
+ **     req: 消息回执
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 校验回执发送方是否为原消息的接收方(见receipt_check);
+ **     2. 分配回执序列号并记录回执(只保留最近的CHAT_RECEIPT_MAX_NUM条回执);
+ **     3. 转发给原消息发送方的所有在线会话.
+ **注意事项: 发送方不在线时, 待其SYNC时再补发(见receipt_resend)
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) receipt_handler(cmd uint32,
+	head *comm.MesgHeader, req *mesg.MesgChatReceipt) (code uint32, err error) {
+	/* > 校验回执发送方 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if attr.GetUid() != req.GetDuid() {
+		ctx.log.Error("Receipt uid is collision! uid:%d/%d sid:%d",
+			req.GetDuid(), attr.GetUid(), head.GetSid())
+		return comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!")
+	}
+
+	code, err = ctx.receipt_check(req)
+	if nil != err {
+		ctx.log.Error("Check receipt failed! suid:%d sid:%d msgid:%d duid:%d errmsg:%s",
+			req.GetSuid(), req.GetSid(), req.GetMsgid(), req.GetDuid(), err.Error())
+		return code, err
+	}
+
+	/* > 记录消息回执 */
+	ctm := time.Now().Unix()
+
+	req.Time = proto.Uint64(uint64(ctm))
+
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_RECEIPT_SEQ_INCR, req.GetSuid())
+
+	seq, err := redis.Int64(pl.Do("INCR", key))
+	if nil != err {
+		ctx.log.Error("Alloc receipt seq failed! uid:%d errmsg:%s", req.GetSuid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_RECEIPT_ZSET, req.GetSuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_RECEIPT_STR,
+		cmd, req.GetSid(), req.GetMsgid(), req.GetDuid(), ctm)
+
+	pl.Send("ZADD", key, seq, member)
+	pl.Send("ZREMRANGEBYRANK", key, 0, -(comm.CHAT_RECEIPT_MAX_NUM + 1))
+
+	/* > 转发给原消息发送方 */
+	body, err := proto.Marshal(req)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	ctx.send_to_uid(cmd, req.GetSuid(), 0, body, uint32(len(body)))
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrChatReceiptHandler
+ **功    能: 消息回执的处理(送达回执/已读回执)
+ **输入参数:
+ **     cmd: 消息类型
+ **     orig: 帧听层ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述: 记录并转发消息回执后, 给回执发送方回复应答.
+ **注意事项: 应答命令为回执命令+1
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func MsgSvrChatReceiptHandler(cmd uint32, orig uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析消息回执 */
+	head, req, code, err := ctx.receipt_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse receipt failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse receipt failed! errmsg:%s", err.Error())
+		ctx.receipt_ack(cmd+1, head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 进行业务处理 */
+	code, err = ctx.receipt_handler(cmd, head, req)
+	if nil != err {
+		ctx.log.Error("Handle receipt failed! errmsg:%s", err.Error())
+		ctx.receipt_ack(cmd+1, head, req, code, err.Error())
+		return -1
+	}
+
+	ctx.receipt_ack(cmd+1, head, req, 0, "Ok")
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: receipt_trim
+ **功    能: 清理已确认的消息回执
+ **输入参数:
+ **     uid: 原消息发送方UID
+ **     since: 回执同步游标
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 序列号不大于since的回执已被客户端确认, 将其从回执列表中删除.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:51:04 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) receipt_trim(uid uint64, since uint64) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_RECEIPT_ZSET, uid)
+
+	_, err := rds.Do("ZREMRANGEBYSCORE", key, "-inf", since)
+
+	return err
+}
+
+/******************************************************************************
+ **函数名称: receipt_resend
+ **功    能: 补发消息回执
+ **输入参数:
+ **     head: SYNC请求的协议头
+ **     req: SYNC请求
+ **输出参数: NONE
+ **返    回:
+ **     next: 下页游标(本页最后一条回执的序列号)
+ **     more: 是否还有回执(0:否 1:是)
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 清理序列号不大于receipt_since的回执(客户端已确认);
+ **     2. 按序列号从小到大取出一页回执, 并逐条下发给发起SYNC的会话.
+ **注意事项:
+ **     1. 同一条消息可能同时有送达回执和已读回执, 客户端以已读为准;
+ **     2. 超过CHAT_RECEIPT_TTL的回执不再下发, 并直接清理.
+ **作    者: # agent # 2026.10.18 07:01:34 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) receipt_resend(head *comm.MesgHeader,
+	req *mesg.MesgSync) (next uint64, more uint32, code uint32, err error) {
+	/* > 清理已确认的回执 */
+	if 0 != req.GetReceiptSince() {
+		err = ctx.receipt_trim(req.GetUid(), req.GetReceiptSince())
+		if nil != err {
+			ctx.log.Error("Trim receipt failed! uid:%d since:%d errmsg:%s",
+				req.GetUid(), req.GetReceiptSince(), err.Error())
+			return 0, 0, comm.ERR_SYS_DB, err
+		}
+	}
+
+	num := int(req.GetNum())
+	if 0 == num {
+		num = comm.CHAT_SYNC_DEF_NUM
+	} else if num > comm.CHAT_SYNC_MAX_NUM {
+		num = comm.CHAT_SYNC_MAX_NUM
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
 
 	pl := ctx.redis.Get()
 	defer func() {
@@ -461,16 +889,616 @@ func (item *MesgChatItem) storage(ctx *MsgSvrCntx) {
 		pl.Close()
 	}()
 
+	/* > 获取一页回执(多取一条用于判断是否还有回执) */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_RECEIPT_ZSET, req.GetUid())
+
+	vals, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key,
+		fmt.Sprintf("(%d", req.GetReceiptSince()), "+inf", "WITHSCORES", "LIMIT", 0, num+1))
+	if nil != err {
+		ctx.log.Error("Get receipt list failed! uid:%d errmsg:%s", req.GetUid(), err.Error())
+		return 0, 0, comm.ERR_SYS_DB, err
+	}
+
+	total := len(vals) / 2
+	if total > num {
+		total = num
+		more = 1
+	}
+
+	next = req.GetReceiptSince()
+
+	/* > 逐条下发回执 */
 	ctm := time.Now().Unix()
 
-	/* > 加入接收者离线列表 */
-	key = fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, item.req.GetDuid())
-	member := fmt.Sprintf(comm.CHAT_FMT_UID_MSGID_STR, item.req.GetSuid(), item.head.GetSeq())
-	pl.Send("ZADD", key, member, ctm)
+	for idx := 0; idx < total; idx += 1 {
+		var cmd uint32
+		var sid, msgid, duid, tm uint64
 
-	/* > 存储发送者离线消息 */
-	key = fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, item.req.GetSuid())
-	pl.Send("HSETNX", key, item.raw)
+		member := vals[2*idx]
+
+		next, _ = strconv.ParseUint(vals[2*idx+1], 10, 64)
+
+		n, _ := fmt.Sscanf(member, comm.CHAT_FMT_RECEIPT_STR, &cmd, &sid, &msgid, &duid, &tm)
+		if 5 != n {
+			ctx.log.Error("Parse receipt failed! receipt:%s", member)
+			pl.Send("ZREM", key, member)
+			continue
+		} else if int64(tm)+comm.CHAT_RECEIPT_TTL < ctm {
+			pl.Send("ZREM", key, member)
+			continue
+		}
+
+		receipt := &mesg.MesgChatReceipt{
+			Suid:  proto.Uint64(req.GetUid()),
+			Sid:   proto.Uint64(sid),
+			Msgid: proto.Uint64(msgid),
+			Duid:  proto.Uint64(duid),
+			Time:  proto.Uint64(tm),
+		}
+
+		body, err := proto.Marshal(receipt)
+		if nil != err {
+			continue
+		}
+
+		ctx.send_data(cmd, head.GetSid(), head.GetCid(), head.GetNid(),
+			0, body, uint32(len(body)))
+	}
+
+	return next, more, 0, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 消息撤回
+
+/******************************************************************************
+ **函数名称: chat_recall_parse
+ **功    能: 解析私聊消息撤回
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_recall_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgChatRecall, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of recall is invalid!")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgChatRecall{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal recall failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetSuid() || 0 == req.GetSid() || 0 == req.GetDuid() {
+		ctx.log.Error("Paramter isn't right! suid:%d sid:%d duid:%d",
+			req.GetSuid(), req.GetSid(), req.GetDuid())
+		return head, req, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: chat_recall_ack
+ **功    能: 发送私聊消息撤回应答
+ **输入参数:
+ **     head: 协议头
+ **     req: 撤回请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 suid = 1;       // M|原消息发送方UID|数字|
+ **         required uint64 sid = 2;        // M|原消息发送方会话SID|数字|
+ **         required uint64 msgid = 3;      // M|原消息ID|数字|
+ **         required uint32 code = 4;       // M|错误码|数字|
+ **         required string errmsg = 5;     // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_recall_ack(head *comm.MesgHeader,
+	req *mesg.MesgChatRecall, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgChatRecallAck{
+		Suid:   proto.Uint64(req.GetSuid()),
+		Sid:    proto.Uint64(req.GetSid()),
+		Msgid:  proto.Uint64(req.GetMsgid()),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_CHAT_RECALL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: chat_revoke
+ **功    能: 将私聊消息标记为已撤回
+ **输入参数:
+ **     req: 撤回请求
+ **     ctm: 撤回时间
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 优先从已发消息索引(REDIS)中查找消息, 校验撤回时限后记录撤回标识,
+ **        再尝试更新MONGO(消息尚未入库时, 由存储任务补标撤回);
+ **     2. 索引中不存在时, 从MONGO中查找并更新:
+ **        消息不存在时, 返回ERR_SVR_MESG_NOT_EXIST;
+ **        超过撤回时限时, 返回ERR_SVR_RECALL_TIMEOUT.
+ **注意事项: 以服务端记录的发送时间计算撤回时限, 不信任客户端填写的发送时间.
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_revoke(req *mesg.MesgChatRecall, ctm int64) (code uint32, err error) {
+	row := &history.ChatMesgTabRow{}
+	cond := bson.M{"suid": req.GetSuid(), "sid": req.GetSid(), "msgid": req.GetMsgid()}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 查找已发消息索引 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_SENT_ZSET, req.GetSuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_SENT_STR, req.GetSid(), req.GetMsgid(), req.GetDuid())
+
+	stm, err := redis.Int64(rds.Do("ZSCORE", key, member))
+	if nil == err {
+		if stm+ctx.conf.Recall.Timeout < ctm {
+			return comm.ERR_SVR_RECALL_TIMEOUT, errors.New("Recall timeout!")
+		}
+
+		/* 记录撤回标识(供存储任务补标撤回) */
+		key = fmt.Sprintf(comm.CHAT_KEY_USR_REVOKE_ZSET, req.GetSuid())
+		field := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, req.GetSid(), req.GetMsgid())
+
+		rds.Send("ZADD", key, ctm, field)
+		_, err = rds.Do("ZREMRANGEBYSCORE", key, "-inf", ctm-comm.CHAT_SENT_TTL)
+		if nil != err {
+			return comm.ERR_SYS_DB, err
+		}
+
+		cb := func(c *mgo.Collection) (err error) {
+			err = c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": ctm}})
+			if mgo.ErrNotFound == err {
+				return nil /* 尚未入库 */
+			}
+			return err
+		}
+
+		err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_CHAT_MESG, cb)
+		if nil != err {
+			return comm.ERR_SYS_DB, err
+		}
+		return 0, nil
+	} else if redis.ErrNil != err {
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 查找MONGO存储 */
+	code = comm.ERR_SYS_DB
+
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Find(cond).One(row)
+		if mgo.ErrNotFound == err {
+			code = comm.ERR_SVR_MESG_NOT_EXIST
+			return errors.New("Message not exist!")
+		} else if nil != err {
+			return err
+		} else if row.Duid != req.GetDuid() {
+			code = comm.ERR_SVR_DATA_COLLISION
+			return errors.New("Duid is collision!")
+		} else if row.Ctm+ctx.conf.Recall.Timeout < ctm {
+			code = comm.ERR_SVR_RECALL_TIMEOUT
+			return errors.New("Recall timeout!")
+		}
+
+		return c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": ctm}})
+	}
+
+	err = ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_CHAT_MESG, cb)
+	if nil != err {
+		return code, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: chat_recall_handler
+ **功    能: 私聊消息撤回处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 撤回请求
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 校验撤回发起方是否为原消息的发送方;
+ **     2. 校验撤回时限, 并将消息标记为已撤回;
+ **     3. 清理接收方离线队列及发送方离线消息;
+ **     4. 更新双方会话列表中的最近消息预览;
+ **     5. 下发撤回通知给双方所有在线会话(发起撤回的会话除外).
+ **注意事项: 已收到原消息的终端据此隐藏该消息
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_recall_handler(
+	head *comm.MesgHeader, req *mesg.MesgChatRecall) (code uint32, err error) {
+	/* > 校验撤回发起方 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get session attr failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if attr.GetUid() != req.GetSuid() {
+		ctx.log.Error("Recall uid is collision! uid:%d/%d sid:%d",
+			req.GetSuid(), attr.GetUid(), head.GetSid())
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only sender can recall message!")
+	}
+
+	/* > 标记为已撤回 */
+	ctm := time.Now().Unix()
+
+	code, err = ctx.chat_revoke(req, ctm)
+	if nil != err {
+		ctx.log.Error("Revoke chat message failed! suid:%d sid:%d msgid:%d errmsg:%s",
+			req.GetSuid(), req.GetSid(), req.GetMsgid(), err.Error())
+		return code, err
+	}
+
+	/* > 清理离线消息 */
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, req.GetDuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_OFFLINE_STR, req.GetSuid(), req.GetSid(), req.GetMsgid())
+	pl.Send("ZREM", key, member)
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, req.GetSuid())
+	field := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, req.GetSid(), req.GetMsgid())
+	pl.Send("HDEL", key, field)
+
+	/* > 更新会话最近消息 */
+	im.ConvRevoke(pl, req.GetDuid(), comm.CHAT_CONV_TYPE_CHAT,
+		req.GetSuid(), req.GetSuid(), req.GetMsgid())
+	im.ConvRevoke(pl, req.GetSuid(), comm.CHAT_CONV_TYPE_CHAT,
+		req.GetDuid(), req.GetSuid(), req.GetMsgid())
+
+	/* > 下发撤回通知 */
+	req.Time = proto.Uint64(uint64(ctm))
+
+	body, err := proto.Marshal(req)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	ctx.send_to_uid_except(comm.CMD_CHAT_RECALL,
+		req.GetSuid(), head.GetSid(), 0, body, uint32(len(body)))
+	ctx.send_to_uid(comm.CMD_CHAT_RECALL, req.GetDuid(), 0, body, uint32(len(body)))
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: MsgSvrChatRecallHandler
+ **功    能: 私聊消息撤回的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     orig: 帧听层ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述: 撤回消息并通知双方后, 给撤回发起方回复应答.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:06:41 #
+ ******************************************************************************/
+func MsgSvrChatRecallHandler(cmd uint32, orig uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*MsgSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析撤回请求 */
+	head, req, code, err := ctx.chat_recall_parse(data)
+	if nil == head {
+		ctx.log.Error("Parse recall failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil != err {
+		ctx.log.Error("Parse recall failed! errmsg:%s", err.Error())
+		ctx.chat_recall_ack(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 进行业务处理 */
+	code, err = ctx.chat_recall_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle recall failed! errmsg:%s", err.Error())
+		ctx.chat_recall_ack(head, req, code, err.Error())
+		return -1
+	}
+
+	ctx.chat_recall_ack(head, req, 0, "Ok")
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: chat_revoked
+ **功    能: 判断私聊消息是否已被撤回
+ **输入参数:
+ **     suid: 发送方UID
+ **     sid: 发送方会话SID
+ **     msgid: 消息ID
+ **输出参数: NONE
+ **返    回:
+ **     rtm: 撤回时间
+ **     ok: 是否已被撤回
+ **实现描述: 查询撤回标识(见chat_revoke)
+ **注意事项: 供存储任务使用: 消息入库前被撤回时, 由存储任务补标撤回
+ **作    者: # agent # 2026.10.18 07:52:38 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) chat_revoked(suid uint64, sid uint64, msgid uint64) (rtm int64, ok bool) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_REVOKE_ZSET, suid)
+	field := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, sid, msgid)
+
+	rtm, err := redis.Int64(rds.Do("ZSCORE", key, field))
+	if nil != err {
+		return 0, false
+	}
+
+	return rtm, true
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 定时任务
+
+/******************************************************************************
+ **函数名称: task_chat_chan_pop
+ **功    能: 私聊消息的存储任务
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回:
+ **实现描述: 从私聊消息队列中取出消息, 并进行存储处理
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.12.27 11:03:42 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) task_chat_chan_pop() {
+	for item := range ctx.chat_chan {
+		item.storage(ctx)
+	}
+}
+
+// 分配离线消息序列号(KEYS[1]:序列号增量器 ARGV[1]:初始值(空:不初始化))
+// 返回: 分配的序列号 -1:增量器不存在且未指定初始值
+var offline_seq_incr_script = redis.NewScript(1, `
+if 0 == redis.call("EXISTS", KEYS[1]) then
+    if "" == ARGV[1] then
+        return -1
+    end
+    redis.call("SET", KEYS[1], ARGV[1])
+end
+return redis.call("INCR", KEYS[1])`)
+
+/******************************************************************************
+ **函数名称: offline_seq_seed
+ **功    能: 计算离线消息序列号增量器的初始值
+ **输入参数:
+ **     top: 离线队列中的最大分值(队列为空时为0)
+ **     ctm: 当前时间
+ **输出参数: NONE
+ **返    回: 增量器初始值
+ **实现描述: 取最大分值与当前时间中的较大者.
+ **注意事项: 旧版离线队列以时间戳为分值, 客户端持有的同步游标也可能是时间戳;
+ **     以此作为初始值, 保证新分配的序列号大于所有旧分值和旧游标.
+ **作    者: # agent # 2026.10.18 08:22:18 #
+ ******************************************************************************/
+func offline_seq_seed(top int64, ctm int64) int64 {
+	if top > ctm {
+		return top
+	}
+	return ctm
+}
+
+/******************************************************************************
+ **函数名称: offline_seq_alloc
+ **功    能: 分配离线消息序列号
+ **输入参数:
+ **     rds: REDIS连接
+ **     uid: 接收者UID
+ **     ctm: 当前时间
+ **输出参数: NONE
+ **返    回:
+ **     seq: 离线消息序列号
+ **     err: 错误描述
+ **实现描述:
+ **     1. 增量器存在时直接递增;
+ **     2. 增量器不存在时, 以offline_seq_seed计算的值初始化后再递增.
+ **注意事项: 并发初始化时只有一方生效, 另一方直接递增.
+ **作    者: # agent # 2026.10.18 08:22:18 #
+ ******************************************************************************/
+func (ctx *MsgSvrCntx) offline_seq_alloc(rds redis.Conn, uid uint64, ctm int64) (seq int64, err error) {
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_SEQ_INCR, uid)
+
+	seq, err = redis.Int64(offline_seq_incr_script.Do(rds, key, ""))
+	if nil != err {
+		return 0, err
+	} else if -1 != seq {
+		return seq, nil
+	}
+
+	/* > 以离线队列中的最大分值初始化 */
+	var top int64
+
+	vals, err := redis.Strings(rds.Do("ZREVRANGE",
+		fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, uid), 0, 0, "WITHSCORES"))
+	if nil != err {
+		return 0, err
+	} else if 2 == len(vals) {
+		score, _ := strconv.ParseFloat(vals[1], 64)
+		top = int64(score)
+	}
+
+	return redis.Int64(offline_seq_incr_script.Do(rds, key, offline_seq_seed(top, ctm)))
+}
+
+/******************************************************************************
+ **函数名称: storage
+ **功    能: 私聊消息的存储处理
+ **输入参数:
+ **     ctx: 全局对象
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 将私聊消息存入缓存和数据库, 并更新双方的会话列表
+ **注意事项: 入库前已被撤回的消息只入库(标记为已撤回), 不再放入离线队列;
+ **          入库过程中被撤回的消息, 入库后补标撤回并清理离线消息和会话最近消息.
+ **作    者: # Qifeng.zou # 2016.12.27 11:03:42 #
+ ******************************************************************************/
+func (item *MesgChatItem) storage(ctx *MsgSvrCntx) {
+	var key string
+
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	ctm := time.Now().Unix()
+
+	/* > 入库前已被撤回 */
+	rtm, ok := ctx.chat_revoked(item.req.GetSuid(), item.head.GetSid(), item.head.GetSeq())
+	if ok {
+		item.insert(ctx, ctm, rtm)
+		return
+	}
+
+	/* > 分配离线消息序列号(用作同步游标) */
+	seq, err := ctx.offline_seq_alloc(pl, item.req.GetDuid(), ctm)
+	if nil != err {
+		ctx.log.Error("Alloc offline seq failed! uid:%d errmsg:%s",
+			item.req.GetDuid(), err.Error())
+		return
+	}
+
+	/* > 加入接收者离线列表 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, item.req.GetDuid())
+	member := fmt.Sprintf(comm.CHAT_FMT_OFFLINE_STR,
+		item.req.GetSuid(), item.head.GetSid(), item.head.GetSeq())
+	pl.Send("ZADD", key, seq, member)
+
+	/* > 存储发送者离线消息 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, item.req.GetSuid())
+	field := fmt.Sprintf(comm.CHAT_FMT_SID_MSGID_STR, item.head.GetSid(), item.head.GetSeq())
+	pl.Send("HSETNX", key, field, item.raw)
+
+	/* > 更新双方的会话列表(只增加接收者的未读数) */
+	last := im.ConvPreview(item.req.GetSuid(), item.head.GetSeq(), item.req.GetText())
+
+	im.ConvUpdate(pl, item.req.GetDuid(), comm.CHAT_CONV_TYPE_CHAT,
+		item.req.GetSuid(), ctm, last, true)
+	im.ConvUpdate(pl, item.req.GetSuid(), comm.CHAT_CONV_TYPE_CHAT,
+		item.req.GetDuid(), ctm, last, false)
+
+	/* > 提交MONGO存储 */
+	item.insert(ctx, ctm, 0)
+
+	/* > 入库过程中被撤回 */
+	rtm, ok = ctx.chat_revoked(item.req.GetSuid(), item.head.GetSid(), item.head.GetSeq())
+	if !ok {
+		return
+	}
+
+	cond := bson.M{"suid": item.req.GetSuid(), "sid": item.head.GetSid(), "msgid": item.head.GetSeq()}
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Update(cond, bson.M{"$set": bson.M{"revoked": 1, "rtm": rtm}})
+	}
+
+	ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_CHAT_MESG, cb)
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_OFFLINE_ZSET, item.req.GetDuid())
+	pl.Send("ZREM", key, member)
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_SEND_MESG_HTAB, item.req.GetSuid())
+	pl.Send("HDEL", key, field)
+
+	im.ConvRevoke(pl, item.req.GetDuid(), comm.CHAT_CONV_TYPE_CHAT,
+		item.req.GetSuid(), item.req.GetSuid(), item.head.GetSeq())
+	im.ConvRevoke(pl, item.req.GetSuid(), comm.CHAT_CONV_TYPE_CHAT,
+		item.req.GetDuid(), item.req.GetSuid(), item.head.GetSeq())
+}
+
+/******************************************************************************
+ **函数名称: insert
+ **功    能: 私聊消息入库(MONGO)
+ **输入参数:
+ **     ctx: 全局对象
+ **     ctm: 发送时间
+ **     rtm: 撤回时间(0:未撤回)
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:52:38 #
+ ******************************************************************************/
+func (item *MesgChatItem) insert(ctx *MsgSvrCntx, ctm int64, rtm int64) {
+	data := &history.ChatMesgTabRow{
+		Suid:  item.req.GetSuid(),
+		Duid:  item.req.GetDuid(),
+		Sid:   item.head.GetSid(),
+		Msgid: item.head.GetSeq(),
+		Ctm:   ctm,
+		Rtm:   rtm,
+		Data:  item.raw,
+	}
+
+	if 0 != rtm {
+		data.Revoked = 1
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Insert(data)
+	}
+
+	err := ctx.mongo.Exec(ctx.conf.Mongo.DbName, history.TAB_CHAT_MESG, cb)
+	if nil != err {
+		ctx.log.Error("Insert chat message failed! suid:%d sid:%d msgid:%d errmsg:%s",
+			item.req.GetSuid(), item.head.GetSid(), item.head.GetSeq(), err.Error())
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
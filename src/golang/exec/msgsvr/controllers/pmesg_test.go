@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"testing"
+)
+
+func TestOfflineSeqSeed(t *testing.T) {
+	cases := []struct {
+		name string
+		top  int64 // 离线队列中的最大分值
+		ctm  int64 // 当前时间
+		want int64
+	}{
+		{"empty queue", 0, 1500000000, 1500000000},
+		{"legacy timestamp before now", 1499999000, 1500000000, 1500000000},
+		{"legacy timestamp after now", 1500000100, 1500000000, 1500000100},
+		{"lost counter with small seq", 20, 1500000000, 1500000000},
+	}
+
+	for _, c := range cases {
+		if seed := offline_seq_seed(c.top, c.ctm); seed != c.want {
+			t.Errorf("%s: offline_seq_seed(%d, %d) = %d, want %d",
+				c.name, c.top, c.ctm, seed, c.want)
+		}
+	}
+}
+
+func TestOfflineSeqMixedScore(t *testing.T) {
+	legacy := []int64{1500000000, 1500000005, 1500000100} // 旧版以时间戳为分值
+	since := int64(1500000005)                            // 客户端持有的旧游标
+
+	seq := offline_seq_seed(legacy[len(legacy)-1], 1500000050)
+
+	/* > 新分配的序列号须大于所有旧分值, 否则会排在旧消息之前 */
+	for idx := 0; idx < 3; idx += 1 {
+		seq += 1
+		for _, score := range legacy {
+			if seq <= score {
+				t.Errorf("seq:%d isn't greater than legacy score:%d", seq, score)
+			}
+		}
+
+		/* > 以旧游标清理时不能误删新消息 */
+		if seq <= since {
+			t.Errorf("seq:%d would be trimmed by legacy since:%d", seq, since)
+		}
+	}
+}
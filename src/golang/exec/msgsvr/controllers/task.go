@@ -54,7 +54,7 @@ func (ctx *MsgSvrCntx) update_gid_to_nid_map() {
  **输入参数: NONE
  **输出参数: NONE
  **返    回: VOID
- **实现描述: 依次启动私聊、群聊、聊天室的存储任务协程
+ **实现描述: 依次启动私聊、群聊、聊天室的存储任务协程及离线通知任务协程
  **注意事项:
  **作    者: # Qifeng.zou # 2016.12.27 11:43:03 #
  ******************************************************************************/
@@ -63,4 +63,6 @@ func (ctx *MsgSvrCntx) task() {
 
 	go ctx.task_group_mesg_chan_pop()
 	go ctx.task_group_mesg_queue_clean()
+
+	go ctx.task_notify_chan_pop()
 }
@@ -8,6 +8,15 @@ import (
 	"beehive-im/src/golang/lib/rtmq"
 )
 
+const (
+	USRSVR_IPLIST_DEF_NUM = 3 // 默认返回侦听层地址的最大个数
+)
+
+/* 多设备登录策略 */
+type UsrSvrLoginConf struct {
+	Apps map[string]*UsrSvrLoginAppConf // APP名 -> 登录策略
+}
+
 /* 在线中心配置 */
 type UsrSvrConf struct {
 	Id       uint32           // 结点ID
@@ -21,6 +30,9 @@ type UsrSvrConf struct {
 	UserDb   UsrSvrMysqlConf  // USERDB配置(MYSQL)
 	Mongo    UsrSvrMongoConf  // MONGO配置
 	Cipher   string           // 私密密钥
+	Token    UsrSvrTokenConf  // TOKEN配置
+	Iplist   UsrSvrIplistConf // IP列表配置
+	Login    UsrSvrLoginConf  // 多设备登录策略
 	Log      log.Conf         // 日志配置
 	Frwder   rtmq.ProxyConf   // RTMQ配置
 }
@@ -62,3 +74,23 @@ func (conf *UsrSvrConf) GetNid() uint32 {
 func (conf *UsrSvrConf) GetGid() uint32 {
 	return conf.Gid
 }
+
+/******************************************************************************
+ **函数名称: GetLoginPolicy
+ **功    能: 获取APP的多设备登录策略
+ **输入参数:
+ **     app: APP名
+ **输出参数: NONE
+ **返    回: 登录策略(未配置时返回nil, 表示不限制)
+ **实现描述: 优先使用APP自身的策略, 否则使用默认策略("*")
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:52:39 #
+ ******************************************************************************/
+func (conf *UsrSvrConf) GetLoginPolicy(app string) *UsrSvrLoginAppConf {
+	if policy, ok := conf.Login.Apps[app]; ok {
+		return policy
+	} else if policy, ok := conf.Login.Apps["*"]; ok {
+		return policy
+	}
+	return nil
+}
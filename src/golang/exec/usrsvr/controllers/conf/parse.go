@@ -43,6 +43,37 @@ type UsrSvrMongoConf struct {
 	Passwd string `xml:"PASSWD,attr"` // 登录密码
 }
 
+/* TOKEN密钥配置 */
+type UsrSvrTokenKeyConf struct {
+	Id  string `xml:"ID,attr"`   // 密钥ID
+	Key string `xml:",chardata"` // 密钥
+}
+
+/* TOKEN配置 */
+type UsrSvrTokenConf struct {
+	Ttl    int64                `xml:"TTL,attr"`    // 有效时长(秒)
+	Kid    string               `xml:"KID,attr"`    // 签名密钥ID
+	Legacy int                  `xml:"LEGACY,attr"` // 是否兼容旧版TOKEN(0:否 1:是)
+	Keys   []UsrSvrTokenKeyConf `xml:"KEY"`         // 密钥列表
+}
+
+/* IP列表配置 */
+type UsrSvrIplistConf struct {
+	Num int `xml:"NUM,attr"` // 返回侦听层地址的最大个数
+}
+
+/* APP登录策略配置 */
+type UsrSvrLoginAppConf struct {
+	Name    string `xml:"NAME,attr"`     // APP名("*"表示默认策略)
+	Max     int    `xml:"MAX,attr"`      // 同一用户最多在线会话数(0:不限制)
+	TermMax int    `xml:"TERM-MAX,attr"` // 同一用户同一终端类型最多在线会话数(0:不限制)
+}
+
+/* 多设备登录策略配置 */
+type UsrSvrLoginXmlConf struct {
+	Apps []UsrSvrLoginAppConf `xml:"APP"` // APP登录策略列表
+}
+
 /* 鉴权配置 */
 type UsrSvrRtmqAuthConf struct {
 	Usr    string `xml:"USR,attr"`    // 用户名
@@ -68,6 +99,9 @@ type UsrSvrConfXmlData struct {
 	UserDb UsrSvrMysqlConf     `xml:"USERDB"`    // USERDB配置
 	Mongo  UsrSvrMongoConf     `xml:"MONGO"`     // Mongo配置
 	Cipher string              `xml:"CIPHER"`    // 私密密钥
+	Token  UsrSvrTokenConf     `xml:"TOKEN"`     // TOKEN配置
+	Iplist UsrSvrIplistConf    `xml:"IPLIST"`    // IP列表配置
+	Login  UsrSvrLoginXmlConf  `xml:"LOGIN"`     // 多设备登录策略
 	Log    UsrSvrLogConf       `xml:"LOG"`       // 日志配置
 	Frwder UsrSvrRtmqProxyConf `xml:"FRWDER"`    // RTMQ PROXY配置
 }
@@ -187,12 +221,48 @@ func (conf *UsrSvrConf) parse() (err error) {
 		return errors.New("Get password of mongo failed!")
 	}
 
-	/* > 私密密钥 */
+	/* > TOKEN配置 */
+	conf.Token.Ttl = node.Token.Ttl
+	if 0 >= conf.Token.Ttl {
+		return errors.New("Get token ttl failed!")
+	}
+
+	conf.Token.Kid = node.Token.Kid
+	if 0 == len(conf.Token.Kid) {
+		return errors.New("Get token kid failed!")
+	}
+
+	conf.Token.Keys = node.Token.Keys
+	if 0 == len(conf.Token.Keys) {
+		return errors.New("Get token keys failed!")
+	}
+
+	conf.Token.Legacy = node.Token.Legacy
+
+	/* > 私密密钥(用于校验旧版TOKEN) */
 	conf.Cipher = node.Cipher
-	if 0 == len(conf.Cipher) {
+	if 0 != conf.Token.Legacy && 0 == len(conf.Cipher) {
 		return errors.New("Get chiper failed!")
 	}
 
+	/* > IP列表配置 */
+	conf.Iplist.Num = node.Iplist.Num
+	if 0 >= conf.Iplist.Num {
+		conf.Iplist.Num = USRSVR_IPLIST_DEF_NUM
+	}
+
+	/* > 多设备登录策略 */
+	conf.Login.Apps = make(map[string]*UsrSvrLoginAppConf)
+	for idx := 0; idx < len(node.Login.Apps); idx += 1 {
+		app := &node.Login.Apps[idx]
+		if 0 == len(app.Name) || 0 > app.Max || 0 > app.TermMax {
+			return errors.New("Get login policy failed!")
+		} else if _, ok := conf.Login.Apps[app.Name]; ok {
+			return errors.New("Login policy of app is duplicate!")
+		}
+		conf.Login.Apps[app.Name] = app
+	}
+
 	/* 日志配置 */
 	conf.Log.Level = log.GetLevel(node.Log.Level)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/garyburd/redigo/redis"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
 )
 
 /* 系统配置 */
@@ -27,9 +28,19 @@ func (this *UsrSvrConfigCtrl) Config() {
 		return
 	case "listend": // 侦听层操作
 		this.Listend(ctx)
+		return
 	case "user-statis": // 用户数据统计
 		this.UserStatis(ctx)
 		return
+	case "token": // TOKEN操作
+		this.Token(ctx)
+		return
+	case "ipdict": // IP字典操作
+		this.IpDict(ctx)
+		return
+	case "notify": // 离线通知操作
+		this.Notify(ctx)
+		return
 	}
 
 	errmsg := fmt.Sprintf("Unsupport this option:%s.", option)
@@ -179,6 +190,9 @@ func (this *UsrSvrConfigCtrl) Listend(ctx *UsrSvrCntx) {
 	case "list": // 侦听层列表
 		this.ListListend(ctx)
 		return
+	case "status": // 设置侦听层状态
+		this.listend_status(ctx)
+		return
 	}
 
 	errmsg := fmt.Sprintf("Unsupport this action:%s.", action)
@@ -285,6 +299,67 @@ func (this *UsrSvrConfigCtrl) ListListend(ctx *UsrSvrCntx) {
 	return
 }
 
+/******************************************************************************
+ **函数名称: listend_status
+ **功    能: 设置侦听层状态
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 更新侦听层属性中的人工状态字段(设为正常时清除人工状态)
+ **注意事项: 处于繁忙状态(PROC_STATUS_BUSY)的结点不会再出现在IP列表中,
+ **          可用于结点下线前的流量排空. 人工状态优先于侦听层上报的负载状态.
+ **作    者: # agent # 2026.10.18 06:47:28 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) listend_status(ctx *UsrSvrCntx) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	nid, _ := strconv.ParseInt(this.GetString("nid"), 10, 32)
+	if 0 >= nid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [nid] is invalid!")
+		return
+	}
+
+	status, err := strconv.ParseInt(this.GetString("status"), 10, 32)
+	if nil != err || (comm.PROC_STATUS_EXEC != status && comm.PROC_STATUS_BUSY != status) {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [status] is invalid!")
+		return
+	}
+
+	/* > 判断结点是否存在 */
+	key := fmt.Sprintf(comm.IM_KEY_LSND_ATTR, nid)
+
+	ok, err := redis.Bool(rds.Do("HEXISTS", key, comm.IM_LSND_ATTR_ADDR))
+	if nil != err {
+		ctx.log.Error("Get listend attribute failed! nid:%d errmsg:%s", nid, err.Error())
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	} else if !ok {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Listend isn't exist!")
+		return
+	}
+
+	/* > 更新结点状态 */
+	if comm.PROC_STATUS_EXEC == status {
+		_, err = rds.Do("HDEL", key, comm.IM_LSND_ATTR_MANUAL) /* 恢复为上报状态 */
+	} else {
+		_, err = rds.Do("HSET", key, comm.IM_LSND_ATTR_MANUAL, status)
+	}
+	if nil != err {
+		ctx.log.Error("Set listend status failed! nid:%d errmsg:%s", nid, err.Error())
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	ctx.log.Debug("Set listend status success! nid:%d status:%d", nid, status)
+
+	/* > 回复处理应答 */
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 用户统计配置
 
@@ -734,3 +809,450 @@ func (this *UsrSvrConfigCtrl) user_statis_list(ctx *UsrSvrCntx) {
 
 	return
 }
+
+////////////////////////////////////////////////////////////////////////////////
+// TOKEN操作
+
+/******************************************************************************
+ **函数名称: Token
+ **功    能: TOKEN操作
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 根据action调用对应的处理函数
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) Token(ctx *UsrSvrCntx) {
+	action := this.GetString("action")
+	switch action {
+	case "revoke": // 吊销TOKEN
+		this.token_revoke(ctx)
+		return
+	}
+
+	errmsg := fmt.Sprintf("Unsupport this action:%s.", action)
+
+	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+}
+
+/******************************************************************************
+ **函数名称: token_revoke
+ **功    能: 吊销TOKEN
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 吊销之前签发给该用户(或会话)的所有TOKEN, 之后上线需重新获取TOKEN.
+ **注意事项: uid和sid至少指定一个
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) token_revoke(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	sid, _ := strconv.ParseInt(this.GetString("sid"), 10, 64)
+	if (0 >= uid && 0 >= sid) || 0 > uid || 0 > sid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid/sid] is invalid!")
+		return
+	}
+
+	/* > 记录吊销信息 */
+	err := im.TokenRevoke(ctx.redis, uint64(uid), uint64(sid), ctx.conf.Token.Ttl)
+	if nil != err {
+		ctx.log.Error("Revoke token failed! uid:%d sid:%d errmsg:%s", uid, sid, err.Error())
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	ctx.log.Debug("Revoke token success! uid:%d sid:%d", uid, sid)
+
+	/* > 回复处理应答 */
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// IP字典操作
+
+/******************************************************************************
+ **函数名称: IpDict
+ **功    能: IP字典操作
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 根据action调用对应的处理函数
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) IpDict(ctx *UsrSvrCntx) {
+	action := this.GetString("action")
+	switch action {
+	case "reload": // 重新加载IP字典
+		this.ipdict_reload(ctx)
+		return
+	case "status": // 查询IP字典状态
+		this.ipdict_status(ctx)
+		return
+	}
+
+	errmsg := fmt.Sprintf("Unsupport this action:%s.", action)
+
+	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+}
+
+/* IP字典状态应答 */
+type IpDictStatusRsp struct {
+	*UsrSvrIpDictStatus
+	Code   int    `json:"code"`   // 错误码
+	ErrMsg string `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: ipdict_reload
+ **功    能: 重新加载IP字典
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 加载失败时保留原字典, 并返回失败原因.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) ipdict_reload(ctx *UsrSvrCntx) {
+	err := ctx.ipdict_reload()
+	if nil != err {
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	this.ipdict_status(ctx)
+
+	return
+}
+
+/******************************************************************************
+ **函数名称: ipdict_status
+ **功    能: 查询IP字典状态
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) ipdict_status(ctx *UsrSvrCntx) {
+	rsp := &IpDictStatusRsp{
+		UsrSvrIpDictStatus: ctx.ipdict_status(),
+		Code:               0,
+		ErrMsg:             "Ok",
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+
+	return
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 离线通知操作
+
+/******************************************************************************
+ **函数名称: Notify
+ **功    能: 离线通知操作
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 根据action调用对应的处理函数
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) Notify(ctx *UsrSvrCntx) {
+	action := this.GetString("action")
+	switch action {
+	case "add": // 注册设备TOKEN
+		this.notify_add(ctx)
+		return
+	case "del": // 注销设备TOKEN
+		this.notify_del(ctx)
+		return
+	case "quiet": // 设置免打扰时段
+		this.notify_quiet(ctx)
+		return
+	case "get": // 查询离线通知配置
+		this.notify_get(ctx)
+		return
+	}
+
+	errmsg := fmt.Sprintf("Unsupport this action:%s.", action)
+
+	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+}
+
+// 注册设备TOKEN(KEYS[1]:TOKEN->UID索引 KEYS[2]:用户设备TOKEN表 ARGV[1]:设备TOKEN
+// ARGV[2]:用户ID ARGV[3]:平台 ARGV[4]:用户设备TOKEN表的键值格式)
+// 返回: 设备TOKEN的原所属用户(0:无)
+var notify_token_add_script = redis.NewScript(2, `
+local prev = redis.call("HGET", KEYS[1], ARGV[1])
+if prev and prev ~= ARGV[2] then
+    redis.call("HDEL", string.format(ARGV[4], tonumber(prev)), ARGV[1])
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+redis.call("HSET", KEYS[2], ARGV[1], ARGV[3])
+return tonumber(prev or 0)`)
+
+/******************************************************************************
+ **函数名称: notify_add
+ **功    能: 注册设备TOKEN
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 通过TOKEN->UID索引找到设备的原所属用户, 并从其设备TOKEN表中移除,
+ **          避免同一设备切换账号后, 仍向原账号推送离线通知.
+ **注意事项: 设备TOKEN已存在时, 更新其所属平台.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) notify_add(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	token := this.GetString("token")
+	platform := this.GetString("platform")
+	if 0 >= uid || 0 == len(token) || 0 == len(platform) {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid/token/platform] is invalid!")
+		return
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_DEV_TOKEN_TAB, uid)
+
+	prev, err := redis.Int64(notify_token_add_script.Do(rds, comm.CHAT_KEY_DEV_TOKEN_TO_UID_TAB,
+		key, token, uid, platform, comm.CHAT_KEY_USR_DEV_TOKEN_TAB))
+	if nil != err {
+		ctx.log.Error("Add device token failed! uid:%d errmsg:%s", uid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	} else if 0 != prev && prev != uid {
+		ctx.log.Debug("Device token is moved! uid:%d -> %d", prev, uid)
+	}
+
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
+// 注销设备TOKEN(KEYS[1]:TOKEN->UID索引 KEYS[2]:用户设备TOKEN表 ARGV[1]:设备TOKEN ARGV[2]:用户ID)
+// 返回: 1:成功
+var notify_token_del_script = redis.NewScript(2, `
+redis.call("HDEL", KEYS[2], ARGV[1])
+if redis.call("HGET", KEYS[1], ARGV[1]) == ARGV[2] then
+    redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return 1`)
+
+/******************************************************************************
+ **函数名称: notify_del
+ **功    能: 注销设备TOKEN
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 从用户设备TOKEN表中移除; TOKEN->UID索引仍指向该用户时一并移除.
+ **注意事项: 用户退出登录或卸载应用时, 应注销设备TOKEN.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) notify_del(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	token := this.GetString("token")
+	if 0 >= uid || 0 == len(token) {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid/token] is invalid!")
+		return
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_DEV_TOKEN_TAB, uid)
+
+	_, err := notify_token_del_script.Do(rds, comm.CHAT_KEY_DEV_TOKEN_TO_UID_TAB, key, token, uid)
+	if nil != err {
+		ctx.log.Error("Delete device token failed! uid:%d errmsg:%s", uid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
+/******************************************************************************
+ **函数名称: notify_hhmm
+ **功    能: 解析HHMM格式的时间
+ **输入参数:
+ **     str: 时间字串
+ **输出参数: NONE
+ **返    回:
+ **     hhmm: 时间
+ **     ok: 是否合法
+ **实现描述:
+ **注意事项: 取值范围[0, 2359], 且分钟不超过59.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func notify_hhmm(str string) (hhmm int64, ok bool) {
+	hhmm, err := strconv.ParseInt(str, 10, 32)
+	if nil != err || 0 > hhmm || hhmm/100 > 23 || hhmm%100 > 59 {
+		return 0, false
+	}
+
+	return hhmm, true
+}
+
+/******************************************************************************
+ **函数名称: notify_tz
+ **功    能: 解析时区(UTC偏移分钟数)
+ **输入参数:
+ **     str: 时区字串
+ **输出参数: NONE
+ **返    回:
+ **     tz: 时区
+ **     ok: 是否合法
+ **实现描述:
+ **注意事项: 取值范围[-720, 840], 即UTC-12:00 ~ UTC+14:00.
+ **作    者: # agent # 2026.10.18 08:06:13 #
+ ******************************************************************************/
+func notify_tz(str string) (tz int64, ok bool) {
+	tz, err := strconv.ParseInt(str, 10, 32)
+	if nil != err || -720 > tz || tz > 840 {
+		return 0, false
+	}
+
+	return tz, true
+}
+
+/******************************************************************************
+ **函数名称: notify_quiet
+ **功    能: 设置免打扰时段
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 起止时间为用户时区(tz)下的时间, 起始时间大于截止时间时表示跨越零点(如: 2300~0700).
+ **注意事项: 起止时间相同时, 表示取消免打扰.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) notify_quiet(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	start, ok1 := notify_hhmm(this.GetString("start"))
+	end, ok2 := notify_hhmm(this.GetString("end"))
+	tz, ok3 := notify_tz(this.GetString("tz"))
+	if 0 >= uid || !ok1 || !ok2 || (start != end && !ok3) {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid/start/end/tz] is invalid!")
+		return
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_NOTIFY_ATTR, uid)
+
+	var err error
+	if start == end {
+		_, err = rds.Do("HDEL", key, comm.CHAT_NOTIFY_ATTR_QUIET_START,
+			comm.CHAT_NOTIFY_ATTR_QUIET_END, comm.CHAT_NOTIFY_ATTR_QUIET_TZ)
+	} else {
+		_, err = rds.Do("HMSET", key, comm.CHAT_NOTIFY_ATTR_QUIET_START, start,
+			comm.CHAT_NOTIFY_ATTR_QUIET_END, end, comm.CHAT_NOTIFY_ATTR_QUIET_TZ, tz)
+	}
+	if nil != err {
+		ctx.log.Error("Set quiet hours failed! uid:%d errmsg:%s", uid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
+/* 设备TOKEN */
+type NotifyTokenItem struct {
+	Token    string `json:"token"`    // 设备TOKEN
+	Platform string `json:"platform"` // 设备平台
+}
+
+/* 离线通知配置应答 */
+type NotifyGetRsp struct {
+	Uid    uint64            `json:"uid"`    // 用户ID
+	Tokens []NotifyTokenItem `json:"tokens"` // 设备TOKEN列表
+	Start  int64             `json:"start"`  // 免打扰起始时间(HHMM)
+	End    int64             `json:"end"`    // 免打扰截止时间(HHMM)
+	Tz     int64             `json:"tz"`     // 免打扰时段所用时区(UTC偏移分钟数)
+	Code   int               `json:"code"`   // 错误码
+	ErrMsg string            `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: notify_get
+ **功    能: 查询离线通知配置
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 返回设备TOKEN列表及免打扰时段
+ **注意事项: 未设置免打扰时, start、end和tz均为0.
+ **作    者: # agent # 2026.10.18 07:22:27 #
+ ******************************************************************************/
+func (this *UsrSvrConfigCtrl) notify_get(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	if 0 >= uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalid!")
+		return
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 获取设备TOKEN */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_DEV_TOKEN_TAB, uid)
+
+	vals, err := redis.Strings(rds.Do("HGETALL", key))
+	if nil != err {
+		ctx.log.Error("Get device token failed! uid:%d errmsg:%s", uid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	rsp := &NotifyGetRsp{
+		Uid:    uint64(uid),
+		Tokens: make([]NotifyTokenItem, 0, len(vals)/2),
+	}
+
+	for idx := 0; idx+1 < len(vals); idx += 2 {
+		rsp.Tokens = append(rsp.Tokens, NotifyTokenItem{Token: vals[idx], Platform: vals[idx+1]})
+	}
+
+	/* > 获取免打扰时段 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_NOTIFY_ATTR, uid)
+
+	vals, err = redis.Strings(rds.Do("HMGET", key, comm.CHAT_NOTIFY_ATTR_QUIET_START,
+		comm.CHAT_NOTIFY_ATTR_QUIET_END, comm.CHAT_NOTIFY_ATTR_QUIET_TZ))
+	if nil == err && 3 == len(vals) {
+		rsp.Start, _ = strconv.ParseInt(vals[0], 10, 64)
+		rsp.End, _ = strconv.ParseInt(vals[1], 10, 64)
+		rsp.Tz, _ = strconv.ParseInt(vals[2], 10, 64)
+	}
+
+	/* > 回复处理应答 */
+	rsp.Code = 0
+	rsp.ErrMsg = "Ok"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+
+	return
+}
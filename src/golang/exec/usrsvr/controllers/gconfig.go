@@ -34,6 +34,9 @@ func (this *UsrSvrGroupConfigCtrl) Config() {
 	case "capacity": // 群组容量
 		this.Capacity(ctx)
 		return
+	case "invite-audit": // 邀请入群审核
+		this.InviteAudit(ctx)
+		return
 	}
 
 	errmsg := fmt.Sprintf("Unsupport this option:%s.", option)
@@ -816,6 +819,63 @@ func (this *UsrSvrGroupConfigCtrl) switch_off(ctx *UsrSvrCntx) {
 	return
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 邀请入群审核开关
+
+/******************************************************************************
+ **函数名称: InviteAudit
+ **功    能: 邀请入群审核开关
+ **输入参数:
+ **     ctx: 全局对象
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 1.抽取请求参数 2.修改群组属性INVITE-AUDIT
+ **注意事项: 开启后, 普通成员邀请他人入群需经群主或管理员审核
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (this *UsrSvrGroupConfigCtrl) InviteAudit(ctx *UsrSvrCntx) {
+	var audit int
+
+	action := this.GetString("action")
+	switch action {
+	case "on": // 需要审核
+		audit = 1
+	case "off": // 无需审核
+		audit = 0
+	default:
+		errmsg := fmt.Sprintf("Unsupport this action:%s.", action)
+		this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+		return
+	}
+
+	req := &GroupSwitchReq{ctrl: this}
+
+	param, err := req.parse_param()
+	if nil != err {
+		ctx.log.Error("Parse invite audit failed! errmsg:%s", err.Error())
+		this.Error(comm.ERR_SVR_INVALID_PARAM, err.Error())
+		return
+	}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 设置群组属性 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GID_ATTR, param.gid)
+
+	_, err = rds.Do("HSET", key, comm.CHAT_GID_ATTR_INVITE_AUDIT, audit)
+	if nil != err {
+		ctx.log.Error("Set invite audit failed! gid:%d errmsg:%s", param.gid, err.Error())
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	/* > 回复处理应答 */
+	this.Error(comm.OK, "Ok")
+
+	return
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 群组容量操作
 
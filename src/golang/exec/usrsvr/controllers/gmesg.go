@@ -1,16 +1,21 @@
 package controllers
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/garyburd/redigo/redis"
 	"github.com/golang/protobuf/proto"
 
 	"beehive-im/src/golang/lib/chat"
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
 	"beehive-im/src/golang/lib/mesg"
+
+	"beehive-im/src/golang/exec/usrsvr/models"
 )
 
 // 群聊处理
@@ -236,6 +241,15 @@ func (ctx *UsrSvrCntx) group_creat_handler(
 
 	pl.Send("HMSET", key, "NAME", req.GetName(), "DESC", req.GetDesc())
 
+	/* > 群主加入成员列表 */
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
+
+	pl.Send("ZADD", key, time.Now().Unix(), req.GetUid())
+
+	key = fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, req.GetUid())
+
+	pl.Send("HSET", key, gid, 0)
+
 	return gid, nil
 }
 
@@ -292,61 +306,4055 @@ func UsrSvrGroupCreatHandler(cmd uint32, nid uint32, data []byte, length uint32,
 
 ////////////////////////////////////////////////////////////////////////////////
 /* 解散群组 */
-func UsrSvrGroupDismissHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
-}
 
-/* 申请入群 */
-func UsrSvrGroupJoinHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
-}
+/******************************************************************************
+ **函数名称: group_dismiss_parse
+ **功    能: 解析GROUP-DISMISS请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupDismiss, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-dismiss is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
 
-/* 退群 */
-func UsrSvrGroupQuitHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
-}
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupDismiss{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-dismiss request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
 
-/* 邀请入群 */
-func UsrSvrGroupInviteHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
+	return head, req, 0, nil
 }
 
-/* 群组踢人 */
-func UsrSvrGroupKickHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
-}
+/******************************************************************************
+ **函数名称: group_dismiss_failed
+ **功    能: 发送GROUP-DISMISS应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-DISMISS请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupDismiss, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupDismissAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_GROUP_DISMISS_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_GROUP_DISMISS_ACK, p.Buff, uint32(len(p.Buff)))
 
-/* 群组禁言 */
-func UsrSvrGroupGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
 	return 0
 }
 
-/* 解除群组禁言 */
-func UsrSvrGroupGagDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+/******************************************************************************
+ **函数名称: group_dismiss_ack
+ **功    能: 发送GROUP-DISMISS应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-DISMISS请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_ack(
+	head *comm.MesgHeader, req *mesg.MesgGroupDismiss) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupDismissAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_GROUP_DISMISS_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_GROUP_DISMISS_ACK, p.Buff, uint32(len(p.Buff)))
+
 	return 0
 }
 
-/* 加入群组黑名单 */
-func UsrSvrGroupBlacklistAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
+/******************************************************************************
+ **函数名称: group_dismiss_clean
+ **功    能: 清理群组缓存数据
+ **输入参数:
+ **     gid: 群组ID
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述:
+ **     1. 移除各成员UID->GID的映射, 并从各成员的会话列表中移除该群
+ **     2. 删除chat:gid:${gid}:*相关键值
+ **     3. 从群组集合中移除该群组
+ **     4. 提交管道命令, 并逐一校验各命令的执行结果
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_clean(gid uint64) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	pl := ctx.redis.Get()
+	defer pl.Close()
+
+	/* > 移除成员UID->GID映射 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
+
+	uid_list, err := redis.Strings(rds.Do("ZRANGE", key, 0, -1))
+	if nil != err {
+		ctx.log.Error("Get uid list of group failed! gid:%d errmsg:%s", gid, err.Error())
+		return err
+	}
+
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid)
+
+	role_list, err := redis.Strings(rds.Do("HKEYS", key))
+	if nil != err {
+		ctx.log.Error("Get role list of group failed! gid:%d errmsg:%s", gid, err.Error())
+		return err
+	}
+
+	uid_list = append(uid_list, role_list...)
+
+	num := len(uid_list)
+	for idx := 0; idx < num; idx += 1 {
+		uid, _ := strconv.ParseInt(uid_list[idx], 10, 64)
+
+		key = fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid)
+		pl.Send("HDEL", key, gid)
+
+		im.ConvRemove(pl, uint64(uid), comm.CHAT_CONV_TYPE_GROUP, gid)
+	}
+
+	/* > 删除群组相关键值 */
+	pl.Send("DEL",
+		fmt.Sprintf(comm.CHAT_KEY_GID_ATTR, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GID_TO_NID_ZSET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GID_TO_UID_ZSET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GID_TO_SID_ZSET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_MESG_QUEUE, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_MSGID_INCR, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_GAG_SET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_BLACKLIST_SET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_INFO_TAB, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid),
+		fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, gid))
+
+	/* > 从群组集合中移除 */
+	pl.Send("ZREM", comm.CHAT_KEY_GID_ZSET, gid)
+	pl.Send("ZREM", comm.CHAT_KEY_GROUP_CAP_ZSET, gid)
+
+	/* > 提交并校验执行结果 */
+	replies, err := redis.Values(pl.Do(""))
+	if nil != err {
+		ctx.log.Error("Clean group data failed! gid:%d errmsg:%s", gid, err.Error())
+		return err
+	}
+
+	for idx := 0; idx < len(replies); idx += 1 {
+		if e, ok := replies[idx].(redis.Error); ok {
+			ctx.log.Error("Clean group data failed! gid:%d errmsg:%s", gid, e.Error())
+			return e
+		}
+	}
+
+	return nil
 }
 
-/* 移除群组黑名单 */
-func UsrSvrGroupBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
+/******************************************************************************
+ **函数名称: group_dismiss_ntf
+ **功    能: 下发解散群组通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 群主ID
+ **     nid_list: 帧听层列表
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|群主ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项: 群组数据清理后无法再查询帧听层列表, 因此由调用方提前获取
+ **作    者: # agent # 2026.10.18 08:18:00 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_ntf(
+	head *comm.MesgHeader, gid uint64, uid uint64, nid_list []uint32) {
+	ntf := &mesg.MesgGroupDismissNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	num := len(nid_list)
+	for idx := 0; idx < num; idx += 1 {
+		ctx.log.Debug("Send group dismiss notify. gid:%d nid:%d", gid, nid_list[idx])
+
+		ctx.send_data(comm.CMD_GROUP_DISMISS_NTF, head.GetSid(), head.GetCid(),
+			nid_list[idx], head.GetSeq(), body, uint32(len(body)))
+	}
 }
 
-/* 添加群组管理员 */
-func UsrSvrGroupMgrAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
-	return 0
+/******************************************************************************
+ **函数名称: group_dismiss_handler
+ **功    能: GROUP-DISMISS处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-DISMISS请求
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作者是否为群主
+ **     2. 获取群组所在帧听层列表(须在清理数据前获取)
+ **     3. 清理缓存数据并记录到数据库
+ **     4. 通过帧听层通知在线成员
+ **注意事项: 已验证了GROUP-DISMISS请求的合法性
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_dismiss_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupDismiss) (code uint32, err error) {
+	/* > 校验操作权限 */
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Get group role failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER != role {
+		ctx.log.Error("Only owner can dismiss group! gid:%d uid:%d role:%d",
+			req.GetGid(), req.GetUid(), role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only owner can dismiss group!")
+	}
+
+	/* > 获取帧听层列表 */
+	nid_list, err := chat.GroupGetGidToNidSet(ctx.redis, req.GetGid())
+	if nil != err {
+		ctx.log.Error("Get nid list of group failed! gid:%d errmsg:%s",
+			req.GetGid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 清理缓存数据 */
+	err = ctx.group_dismiss_clean(req.GetGid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 记录到数据库(MONGODB) */
+	err = models.DbGroupDismiss(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetGid(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Save group dismiss failed! gid:%d errmsg:%s",
+			req.GetGid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 通知在线成员 */
+	ctx.group_dismiss_ntf(head, req.GetGid(), req.GetUid(), nid_list)
+
+	return 0, nil
 }
 
-/* 移除群组管理员 */
-func UsrSvrGroupMgrDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+/******************************************************************************
+ **函数名称: UsrSvrGroupDismissHandler
+ **功    能: 解散群组
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项: 只有群主才能解散群组
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func UsrSvrGroupDismissHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group dismiss request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析解散请求 */
+	head, req, code, err := ctx.group_dismiss_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-dismiss request failed!")
+		ctx.group_dismiss_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_dismiss_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Uid is collision! sid:%d uid:%d/%d",
+			head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.group_dismiss_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!"))
+		return -1
+	}
+
+	/* > 解散群组处理 */
+	code, err = ctx.group_dismiss_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Group dismiss handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_dismiss_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_dismiss_ack(head, req)
+
 	return 0
 }
 
-/* 群组成员列表 */
-func UsrSvrGroupUsrListHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+////////////////////////////////////////////////////////////////////////////////
+/* 群组成员管理 */
+
+/******************************************************************************
+ **函数名称: group_send_to_nid
+ **功    能: 通过帧听层下发群组消息
+ **输入参数:
+ **     cmd: 命令类型
+ **     head: 协议头
+ **     gid: 群组ID
+ **     data: 下发数据(不含协议头)
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 遍历gid->nid列表, 并下发消息
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_send_to_nid(cmd uint32,
+	head *comm.MesgHeader, gid uint64, data []byte, length uint32) {
+	nid_list, err := chat.GroupGetGidToNidSet(ctx.redis, gid)
+	if nil != err {
+		ctx.log.Error("Get nid list of group failed! gid:%d errmsg:%s", gid, err.Error())
+		return
+	}
+
+	num := len(nid_list)
+	for idx := 0; idx < num; idx += 1 {
+		ctx.log.Debug("Send group message. cmd:0x%04X gid:%d nid:%d", cmd, gid, nid_list[idx])
+
+		ctx.send_data(cmd, head.GetSid(), head.GetCid(),
+			nid_list[idx], head.GetSeq(), data, length)
+	}
+}
+
+/******************************************************************************
+ **函数名称: group_send_to_mgr
+ **功    能: 下发消息给群主和管理员
+ **输入参数:
+ **     cmd: 命令类型
+ **     gid: 群组ID
+ **     seq: 序列号
+ **     data: 下发数据(不含协议头)
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 遍历群组角色表, 并下发给各管理人员的所有终端
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_send_to_mgr(cmd uint32,
+	gid uint64, seq uint64, data []byte, length uint32) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid)
+
+	uid_list, err := redis.Strings(rds.Do("HKEYS", key))
+	if nil != err {
+		ctx.log.Error("Get role list of group failed! gid:%d errmsg:%s", gid, err.Error())
+		return err
+	}
+
+	num := len(uid_list)
+	for idx := 0; idx < num; idx += 1 {
+		uid, _ := strconv.ParseInt(uid_list[idx], 10, 64)
+
+		ctx.send_to_uid(cmd, uint64(uid), seq, data, length)
+	}
+
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: group_join_resend
+ **功    能: 重新下发待审核的入群申请
+ **输入参数:
+ **     uid: 用户UID
+ **     sid: 会话SID
+ **     cid: 连接CID
+ **     nid: 结点ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 通过UID->GID映射获取用户所在群组, 并批量查询其在各群组中的角色
+ **     2. 对于其担任群主或管理员的群组, 将待审核列表中的申请下发给该会话
+ **        (邀请人为0时下发GROUP-JOIN, 否则下发GROUP-INVITE)
+ **注意事项: 管理员离线期间收到的入群申请只存放在待审核列表中
+ **作    者: # agent # 2026.10.18 08:19:06 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_resend(uid uint64, sid uint64, cid uint64, nid uint32) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 获取所在群组 */
+	key := fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid)
+
+	gid_list, err := redis.Strings(rds.Do("HKEYS", key))
+	if nil != err {
+		ctx.log.Error("Get gid list of user failed! uid:%d errmsg:%s", uid, err.Error())
+		return
+	}
+
+	num := len(gid_list)
+	if 0 == num {
+		return
+	}
+
+	/* > 批量查询角色 */
+	gids := make([]uint64, num)
+	for idx := 0; idx < num; idx += 1 {
+		gid, _ := strconv.ParseInt(gid_list[idx], 10, 64)
+		gids[idx] = uint64(gid)
+
+		key = fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gids[idx])
+		rds.Send("HGET", key, uid)
+	}
+
+	rds.Flush()
+
+	mgr_list := make([]uint64, 0)
+	for idx := 0; idx < num; idx += 1 {
+		role, err := redis.Int(rds.Receive())
+		if nil != err {
+			continue
+		} else if chat.GROUP_ROLE_OWNER != role && chat.GROUP_ROLE_MANAGER != role {
+			continue
+		}
+
+		mgr_list = append(mgr_list, gids[idx])
+	}
+
+	/* > 下发待审核申请 */
+	num = len(mgr_list)
+	for idx := 0; idx < num; idx += 1 {
+		gid := mgr_list[idx]
+
+		key = fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, gid)
+
+		reqs, err := redis.Int64Map(rds.Do("HGETALL", key))
+		if nil != err {
+			ctx.log.Error("Get join request failed! gid:%d errmsg:%s", gid, err.Error())
+			continue
+		}
+
+		for to, from := range reqs {
+			id, _ := strconv.ParseInt(to, 10, 64)
+			if 0 == id {
+				continue
+			}
+
+			var cmd uint32
+			var body []byte
+
+			if 0 == from {
+				cmd = comm.CMD_GROUP_JOIN
+				body, err = proto.Marshal(&mesg.MesgGroupJoin{
+					Uid: proto.Uint64(uint64(id)),
+					Gid: proto.Uint64(gid),
+				})
+			} else {
+				cmd = comm.CMD_GROUP_INVITE
+				body, err = proto.Marshal(&mesg.MesgGroupInvite{
+					Uid: proto.Uint64(uint64(from)),
+					Gid: proto.Uint64(gid),
+					To:  proto.Uint64(uint64(id)),
+				})
+			}
+
+			if nil != err {
+				ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+				continue
+			}
+
+			ctx.send_data(cmd, sid, cid, nid, 0, body, uint32(len(body)))
+		}
+	}
+}
+
+// 加入群组成员列表(KEYS[1]:成员列表 ARGV[1]:群组容量 ARGV[2]:加入时间 ARGV[3]:用户ID)
+// 返回: 1:加入成功 0:已是群成员 -1:群组已满
+var group_usr_add_script = redis.NewScript(1, `
+if redis.call("ZSCORE", KEYS[1], ARGV[3]) then
+    return 0
+end
+if redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[1]) then
+    return -1
+end
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[3])
+return 1`)
+
+/******************************************************************************
+ **函数名称: group_usr_add
+ **功    能: 将用户加入群组
+ **输入参数:
+ **     gid: 群组ID
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回:
+ **     added: 是否新加入(false:已是群成员)
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验用户是否在群组黑名单中
+ **     2. 校验群组人数是否已达上限
+ **     3. 以当前最大群消息ID作为新成员的群聊消息同步游标
+ **     4. 更新群组成员列表, 并移除待审核的入群申请
+ **注意事项: 未设置群组容量时, 使用默认容量CHAT_GROUP_USR_MAX_NUM;
+ **     容量校验与加入成员列表通过LUA脚本原子执行, 避免并发加入时超出容量.
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_add(gid uint64, uid uint64) (added bool, code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 校验群组黑名单 */
+	ok, err := chat.GroupInBlacklist(ctx.redis, gid, uid)
+	if nil != err {
+		return false, comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return false, comm.ERR_SVR_IN_BLACKLIST, errors.New("User is in blacklist of group!")
+	}
+
+	/* > 校验群组容量 */
+	capacity, err := redis.Int(rds.Do("ZSCORE", comm.CHAT_KEY_GROUP_CAP_ZSET, gid))
+	if redis.ErrNil == err {
+		capacity = comm.CHAT_GROUP_USR_MAX_NUM
+	} else if nil != err {
+		return false, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 获取当前最大群消息ID(新成员不同步入群前的群消息) */
+	msgid, err := redis.Int64(rds.Do("GET", fmt.Sprintf(comm.CHAT_KEY_GROUP_MSGID_INCR, gid)))
+	if redis.ErrNil == err {
+		msgid = 0
+	} else if nil != err {
+		return false, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 加入成员列表(容量校验与加入须原子执行) */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
+
+	ret, err := redis.Int(group_usr_add_script.Do(rds, key, capacity, time.Now().Unix(), uid))
+	if nil != err {
+		return false, comm.ERR_SYS_SYSTEM, err
+	} else if -1 == ret {
+		ctx.log.Error("Group is full! gid:%d capacity:%d", gid, capacity)
+		return false, comm.ERR_SVR_GROUP_FULL, errors.New("Group is full!")
+	}
+
+	/* > 更新同步游标(已是群成员时保留原游标) */
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	if 1 == ret {
+		key = fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid)
+		pl.Send("HSET", key, gid, msgid)
+	}
+
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, gid)
+	pl.Send("HDEL", key, uid)
+
+	return 1 == ret, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_usr_del
+ **功    能: 将用户移出群组
+ **输入参数:
+ **     gid: 群组ID
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述:
+ **     1. 从群组成员列表、在线UID列表以及角色表中移除
+ **     2. 从群组SID列表中移除该用户的所有会话
+ **     3. 移除UID->GID的映射
+ **     4. 从该用户的会话列表中移除该群
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_del(gid uint64, uid uint64) error {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	/* > 获取用户会话列表 */
+	key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+
+	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
+	if nil != err {
+		ctx.log.Error("Get sid set by uid [%d] failed! errmsg:%s", uid, err.Error())
+		return err
+	}
+
+	/* > 移除群组成员数据 */
+	pl.Send("ZREM", fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid), uid)
+	pl.Send("ZREM", fmt.Sprintf(comm.CHAT_KEY_GID_TO_UID_ZSET, gid), uid)
+	pl.Send("HDEL", fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid), uid)
+
+	key = fmt.Sprintf(comm.CHAT_KEY_GID_TO_SID_ZSET, gid)
+
+	num := len(sid_list)
+	for idx := 0; idx < num; idx += 1 {
+		pl.Send("ZREM", key, sid_list[idx])
+	}
+
+	/* > 移除UID->GID映射 */
+	pl.Send("HDEL", fmt.Sprintf(comm.CHAT_KEY_UID_TO_GID, uid), gid)
+
+	/* > 移除该群的会话 */
+	im.ConvRemove(pl, uid, comm.CHAT_CONV_TYPE_GROUP, gid)
+
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: group_moderate_check
+ **功    能: 校验群组管理操作(禁言/黑名单)的权限
+ **输入参数:
+ **     gid: 群组ID
+ **     uid: 操作者UID
+ **     target: 被操作的用户UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 只有群主和管理员才能操作, 且不能操作群主, 管理员也不能操作其他管理员
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_moderate_check(gid uint64, uid uint64, target uint64) (code uint32, err error) {
+	role, err := chat.GroupGetRole(ctx.redis, gid, uid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER != role && chat.GROUP_ROLE_MANAGER != role {
+		ctx.log.Error("Only owner or manager can do it! gid:%d uid:%d role:%d", gid, uid, role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only owner or manager can do it!")
+	} else if uid == target {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Can't operate yourself!")
+	}
+
+	target_role, err := chat.GroupGetRole(ctx.redis, gid, target)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER == target_role ||
+		(chat.GROUP_ROLE_MANAGER == role && chat.GROUP_ROLE_MANAGER == target_role) {
+		ctx.log.Error("Permission denied! gid:%d uid:%d role:%d target:%d role:%d",
+			gid, uid, role, target, target_role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Permission denied!")
+	}
+
+	return 0, nil
+}
+
+/* 群组成员信息 */
+type GroupUsrItem struct {
+	Uid    uint64 `json:"uid"`    // 用户ID
+	Role   int    `json:"role"`   // 角色(0:普通成员 1:群主 2:管理员)
+	Online bool   `json:"online"` // 是否在线
+	Jtm    int64  `json:"jtm"`    // 入群时间
+}
+
+/******************************************************************************
+ **函数名称: group_usr_list
+ **功    能: 分页获取群组成员列表
+ **输入参数:
+ **     gid: 群组ID
+ **     cursor: 分页游标(成员在列表中的偏移)
+ **     num: 获取数目(0:表示获取默认数目)
+ **输出参数: NONE
+ **返    回:
+ **     list: 成员列表
+ **     next: 下页游标(0:表示已无更多成员)
+ **     total: 成员总数
+ **     err: 错误描述
+ **实现描述:
+ **     1. 按入群时间从群组成员列表中取出一页成员
+ **     2. 通过群组角色表获取成员角色
+ **     3. 通过用户UID集合判断成员是否在线(管道批量查询)
+ **注意事项: 每页最多获取CHAT_GROUP_USR_LIST_MAX_NUM个成员;
+ **     游标为成员在列表中的偏移, 翻页期间有成员进出群时, 后续页可能出现重复或遗漏.
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_list(gid uint64, cursor uint64, num uint32) (
+	list []GroupUsrItem, next uint64, total int, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 获取成员总数 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
+
+	total, err = redis.Int(rds.Do("ZCARD", key))
+	if nil != err {
+		ctx.log.Error("Get group user num failed! gid:%d errmsg:%s", gid, err.Error())
+		return nil, 0, 0, err
+	} else if uint64(total) <= cursor {
+		return nil, 0, total, nil
+	}
+
+	/* > 获取一页成员 */
+	if 0 == num {
+		num = comm.CHAT_GROUP_USR_LIST_DEF_NUM
+	} else if num > comm.CHAT_GROUP_USR_LIST_MAX_NUM {
+		num = comm.CHAT_GROUP_USR_LIST_MAX_NUM
+	}
+
+	stop := int64(cursor) + int64(num) - 1
+
+	vals, err := redis.Strings(rds.Do("ZRANGE", key, cursor, stop, "WITHSCORES"))
+	if nil != err {
+		ctx.log.Error("Get group user list failed! gid:%d errmsg:%s", gid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 获取角色列表 */
+	key = fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid)
+
+	roles, err := redis.IntMap(rds.Do("HGETALL", key))
+	if nil != err {
+		ctx.log.Error("Get group role list failed! gid:%d errmsg:%s", gid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 批量获取在线状态(管道) */
+	num_vals := len(vals)
+	for idx := 0; idx+1 < num_vals; idx += 2 {
+		rds.Send("ZSCORE", comm.IM_KEY_UID_ZSET, vals[idx])
+	}
+
+	ttls, err := redis.Values(rds.Do(""))
+	if nil != err {
+		ctx.log.Error("Get online status failed! gid:%d errmsg:%s", gid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 生成成员列表 */
+	ctm := time.Now().Unix()
+
+	for idx := 0; idx+1 < num_vals; idx += 2 {
+		uid, _ := strconv.ParseInt(vals[idx], 10, 64)
+		jtm, _ := strconv.ParseInt(vals[idx+1], 10, 64)
+
+		ttl, _ := redis.Int64(ttls[idx/2], nil) /* 不在线时为nil */
+
+		item := GroupUsrItem{
+			Uid:    uint64(uid),
+			Role:   roles[vals[idx]],
+			Online: ttl >= ctm,
+			Jtm:    jtm,
+		}
+
+		list = append(list, item)
+	}
+
+	next = cursor + uint64(len(list))
+	if next >= uint64(total) {
+		next = 0
+	}
+
+	return list, next, total, nil
+}
+
+/******************************************************************************
+ **函数名称: group_join_ntf
+ **功    能: 下发入群通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 入群用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupJoinNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_JOIN_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_quit_ntf
+ **功    能: 下发退群通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 退群用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_quit_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupQuitNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_QUIT_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_kick_ntf
+ **功    能: 下发踢人通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 被踢用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_kick_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupKickNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_KICK_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_add_ntf
+ **功    能: 下发添加管理员通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 管理员UID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_add_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupMgrAddNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_MGR_ADD_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_del_ntf
+ **功    能: 下发解除管理员通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 管理员UID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_del_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupMgrDelNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_MGR_DEL_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_add_ntf
+ **功    能: 下发禁言通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 被禁言用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_add_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupGagAddNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_GAG_ADD_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_del_ntf
+ **功    能: 下发解除禁言通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 被解除禁言用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_del_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupGagDelNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_GAG_DEL_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_add_ntf
+ **功    能: 下发加入黑名单通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 被加入黑名单用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_add_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupBlAddNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_BL_ADD_NTF, head, gid, body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_del_ntf
+ **功    能: 下发移除黑名单通知
+ **输入参数:
+ **     head: 协议头
+ **     gid: 群组ID
+ **     uid: 被移出黑名单用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **通知协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_del_ntf(head *comm.MesgHeader, gid uint64, uid uint64) {
+	ntf := &mesg.MesgGroupBlDelNtf{
+		Uid: proto.Uint64(uid),
+		Gid: proto.Uint64(gid),
+	}
+
+	body, err := proto.Marshal(ntf)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return
+	}
+
+	ctx.group_send_to_nid(comm.CMD_GROUP_BL_DEL_NTF, head, gid, body, uint32(len(body)))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 申请入群 */
+
+/******************************************************************************
+ **函数名称: group_join_parse
+ **功    能: 解析GROUP-JOIN请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupJoin, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-join is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupJoin{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-join request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_join_failed
+ **功    能: 发送GROUP-JOIN应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupJoin, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupJoinAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_JOIN_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_join_ack
+ **功    能: 发送GROUP-JOIN应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项: 应答成功仅表示申请已提交, 需等待群主或管理员审核
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_ack(head *comm.MesgHeader, req *mesg.MesgGroupJoin) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupJoinAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_JOIN_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_join_handler
+ **功    能: GROUP-JOIN处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN请求
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验群组是否存在、是否已是成员以及是否在黑名单中
+ **     2. 将申请放入待审核列表
+ **     3. 将申请转发给群主和管理员
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupJoin, data []byte) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 校验申请合法性 */
+	ok, err := chat.GroupIsExist(ctx.redis, req.GetGid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Group isn't exist!")
+	}
+
+	ok, err = chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return comm.ERR_SVR_DATA_COLLISION, errors.New("Already in group!")
+	}
+
+	ok, err = chat.GroupInBlacklist(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return comm.ERR_SVR_IN_BLACKLIST, errors.New("User is in blacklist of group!")
+	}
+
+	/* > 放入待审核列表 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, req.GetGid())
+
+	_, err = rds.Do("HSET", key, req.GetUid(), 0)
+	if nil != err {
+		ctx.log.Error("Add join request into pending table failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 转发给群主和管理员 */
+	ctx.group_send_to_mgr(comm.CMD_GROUP_JOIN, req.GetGid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupJoinHandler
+ **功    能: 申请入群
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项: 入群申请须经群主或管理员审核(GROUP-JOIN-AUDIT)
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func UsrSvrGroupJoinHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group join request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析入群申请 */
+	head, req, code, err := ctx.group_join_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-join request failed!")
+		ctx.group_join_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_join_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Uid is collision! sid:%d uid:%d/%d",
+			head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.group_join_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!"))
+		return -1
+	}
+
+	/* > 入群申请处理 */
+	code, err = ctx.group_join_handler(head, req, data)
+	if nil != err {
+		ctx.log.Error("Group join handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_join_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_join_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 退群 */
+
+/******************************************************************************
+ **函数名称: group_quit_parse
+ **功    能: 解析GROUP-QUIT请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_quit_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupQuit, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-quit is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupQuit{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-quit request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_quit_failed
+ **功    能: 发送GROUP-QUIT应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-QUIT请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_quit_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupQuit, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupQuitAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_QUIT_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_quit_ack
+ **功    能: 发送GROUP-QUIT应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-QUIT请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_quit_ack(head *comm.MesgHeader, req *mesg.MesgGroupQuit) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupQuitAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_QUIT_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_quit_handler
+ **功    能: GROUP-QUIT处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-QUIT请求
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验用户是否为群成员
+ **     2. 将用户移出群组
+ **     3. 下发退群通知
+ **注意事项: 群主不能退群, 只能解散群组
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_quit_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupQuit) (code uint32, err error) {
+	/* > 校验请求合法性 */
+	ok, err := chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Isn't member of group!")
+	}
+
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER == role {
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Owner can't quit group, please dismiss it!")
+	}
+
+	/* > 移出群组 */
+	err = ctx.group_usr_del(req.GetGid(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete user from group failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发退群通知 */
+	ctx.group_quit_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupQuitHandler
+ **功    能: 退群
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func UsrSvrGroupQuitHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group quit request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析退群请求 */
+	head, req, code, err := ctx.group_quit_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-quit request failed!")
+		ctx.group_quit_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_quit_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Uid is collision! sid:%d uid:%d/%d",
+			head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.group_quit_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!"))
+		return -1
+	}
+
+	/* > 退群处理 */
+	code, err = ctx.group_quit_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Group quit handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_quit_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_quit_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 邀请入群 */
+
+/******************************************************************************
+ **函数名称: group_invite_parse
+ **功    能: 解析GROUP-INVITE请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_invite_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupInvite, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-invite is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupInvite{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-invite request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() || 0 == req.GetTo() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d to:%d",
+			req.GetUid(), req.GetGid(), req.GetTo())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_invite_failed
+ **功    能: 发送GROUP-INVITE应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-INVITE请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_invite_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupInvite, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupInviteAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_INVITE_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_invite_ack
+ **功    能: 发送GROUP-INVITE应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-INVITE请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_invite_ack(head *comm.MesgHeader, req *mesg.MesgGroupInvite) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupInviteAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_INVITE_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_invite_handler
+ **功    能: GROUP-INVITE处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-INVITE请求
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验邀请人是否为群成员, 被邀请人是否已是成员或在黑名单中
+ **     2. 群组开启了"邀请需审核"且邀请人为普通成员时, 放入待审核列表并
+ **        转发给群主和管理员; 否则直接将被邀请人加入群组.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_invite_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupInvite, data []byte) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 校验邀请合法性 */
+	ok, err := chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Inviter isn't member of group!")
+	}
+
+	ok, err = chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetTo())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return comm.ERR_SVR_DATA_COLLISION, errors.New("Already in group!")
+	}
+
+	ok, err = chat.GroupInBlacklist(ctx.redis, req.GetGid(), req.GetTo())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return comm.ERR_SVR_IN_BLACKLIST, errors.New("User is in blacklist of group!")
+	}
+
+	/* > 判断是否需要审核 */
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GID_ATTR, req.GetGid())
+
+	audit, err := redis.Int(rds.Do("HGET", key, comm.CHAT_GID_ATTR_INVITE_AUDIT))
+	if nil != err && redis.ErrNil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	if 0 != audit && 0 == role {
+		/* > 放入待审核列表 */
+		key = fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, req.GetGid())
+
+		_, err = rds.Do("HSET", key, req.GetTo(), req.GetUid())
+		if nil != err {
+			return comm.ERR_SYS_SYSTEM, err
+		}
+
+		/* > 转发给群主和管理员 */
+		ctx.group_send_to_mgr(comm.CMD_GROUP_INVITE, req.GetGid(),
+			head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+		return 0, nil
+	}
+
+	/* > 直接加入群组 */
+	added, code, err := ctx.group_usr_add(req.GetGid(), req.GetTo())
+	if nil != err {
+		ctx.log.Error("Add user into group failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetTo(), err.Error())
+		return code, err
+	} else if !added {
+		return 0, nil /* 已是群成员 */
+	}
+
+	ctx.send_to_uid(comm.CMD_GROUP_INVITE, req.GetTo(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
+	ctx.group_join_ntf(head, req.GetGid(), req.GetTo())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupInviteHandler
+ **功    能: 邀请入群
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **        required uint64 to = 3;     // M|被邀请用户ID|数字|
+ **     }
+ **注意事项: 群组属性INVITE-AUDIT决定普通成员的邀请是否需要审核
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func UsrSvrGroupInviteHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group invite request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析邀请请求 */
+	head, req, code, err := ctx.group_invite_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-invite request failed!")
+		ctx.group_invite_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_invite_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Uid is collision! sid:%d uid:%d/%d",
+			head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.group_invite_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!"))
+		return -1
+	}
+
+	/* > 邀请处理 */
+	code, err = ctx.group_invite_handler(head, req, data)
+	if nil != err {
+		ctx.log.Error("Group invite handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_invite_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_invite_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 入群审核 */
+
+/******************************************************************************
+ **函数名称: group_join_audit_parse
+ **功    能: 解析GROUP-JOIN-AUDIT请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_audit_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupJoinAudit, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-join-audit is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupJoinAudit{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-join-audit request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() || 0 == req.GetTo() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d to:%d",
+			req.GetUid(), req.GetGid(), req.GetTo())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_join_audit_failed
+ **功    能: 发送GROUP-JOIN-AUDIT应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN-AUDIT请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_audit_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupJoinAudit, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupJoinAuditAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_JOIN_AUDIT_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_join_audit_ack
+ **功    能: 发送GROUP-JOIN-AUDIT应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN-AUDIT请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_audit_ack(
+	head *comm.MesgHeader, req *mesg.MesgGroupJoinAudit) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupJoinAuditAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_JOIN_AUDIT_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_join_audit_handler
+ **功    能: GROUP-JOIN-AUDIT处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-JOIN-AUDIT请求
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验审核人是否为群主或管理员
+ **     2. 校验是否存在待审核的入群申请
+ **     3. 审核通过则将申请人加入群组并下发入群通知; 否则移除该申请.
+ **     4. 将审核结果转发给申请人
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_join_audit_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupJoinAudit, data []byte) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 校验操作权限 */
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER != role && chat.GROUP_ROLE_MANAGER != role {
+		ctx.log.Error("Only owner or manager can audit! gid:%d uid:%d role:%d",
+			req.GetGid(), req.GetUid(), role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only owner or manager can audit!")
+	}
+
+	/* > 校验入群申请 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_JOIN_PENDING_TAB, req.GetGid())
+
+	ok, err := redis.Bool(rds.Do("HEXISTS", key, req.GetTo()))
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Join request isn't exist!")
+	}
+
+	/* > 处理审核结果 */
+	if 0 != req.GetPass() {
+		added, code, err := ctx.group_usr_add(req.GetGid(), req.GetTo())
+		if nil != err {
+			ctx.log.Error("Add user into group failed! gid:%d uid:%d errmsg:%s",
+				req.GetGid(), req.GetTo(), err.Error())
+			return code, err
+		} else if added {
+			ctx.group_join_ntf(head, req.GetGid(), req.GetTo())
+		}
+	} else {
+		rds.Do("HDEL", key, req.GetTo())
+	}
+
+	/* > 通知申请人 */
+	ctx.send_to_uid(comm.CMD_GROUP_JOIN_AUDIT, req.GetTo(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupJoinAuditHandler
+ **功    能: 入群审核
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|审核人ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **        required uint64 to = 3;     // M|申请人ID|数字|
+ **        required uint32 pass = 4;   // M|审核结果|数字|(0:拒绝 1:通过)|
+ **     }
+ **注意事项: 只有群主或管理员才能审核入群申请
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func UsrSvrGroupJoinAuditHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group join audit! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析审核请求 */
+	head, req, code, err := ctx.group_join_audit_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-join-audit request failed!")
+		ctx.group_join_audit_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_join_audit_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		ctx.log.Error("Uid is collision! sid:%d uid:%d/%d",
+			head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.group_join_audit_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Uid is collision!"))
+		return -1
+	}
+
+	/* > 审核处理 */
+	code, err = ctx.group_join_audit_handler(head, req, data)
+	if nil != err {
+		ctx.log.Error("Group join audit handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_join_audit_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_join_audit_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 群组踢人 */
+
+/******************************************************************************
+ **函数名称: group_kick_parse
+ **功    能: 解析GROUP-KICK请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_kick_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupKick, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-kick is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupKick{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-kick request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_kick_failed
+ **功    能: 发送GROUP-KICK应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-KICK请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_kick_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupKick, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupKickAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_KICK_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_kick_ack
+ **功    能: 发送GROUP-KICK应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-KICK请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_kick_ack(head *comm.MesgHeader, req *mesg.MesgGroupKick) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupKickAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_KICK_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_kick_handler
+ **功    能: GROUP-KICK处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-KICK请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限(见group_moderate_check): 只有群主和管理员才能踢人, 且管理员不能踢群主和其他管理员
+ **     2. 将被踢用户移出群组
+ **     3. 下发踢人通知
+ **注意事项: 请求中的uid为被踢用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_kick_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupKick, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	code, err = ctx.group_moderate_check(req.GetGid(), uid, req.GetUid())
+	if nil != err {
+		return code, err
+	}
+
+	ok, err := chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Isn't member of group!")
+	}
+
+	/* > 移出群组 */
+	err = ctx.group_usr_del(req.GetGid(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete user from group failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发踢人通知 */
+	ctx.group_kick_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupKickHandler
+ **功    能: 群组踢人
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|被踢用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:24:34 #
+ ******************************************************************************/
+func UsrSvrGroupKickHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group kick request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析踢人请求 */
+	head, req, code, err := ctx.group_kick_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-kick request failed!")
+		ctx.group_kick_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_kick_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_kick_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 踢人处理 */
+	code, err = ctx.group_kick_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group kick handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_kick_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_kick_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 群组禁言 */
+
+/******************************************************************************
+ **函数名称: group_gag_add_parse
+ **功    能: 解析GROUP-GAG-ADD请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_add_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupGagAdd, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-gag-add is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupGagAdd{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-gag-add request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_gag_add_failed
+ **功    能: 发送GROUP-GAG-ADD应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-ADD请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_add_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupGagAdd, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupGagAddAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_GAG_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_add_ack
+ **功    能: 发送GROUP-GAG-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-ADD请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_add_ack(head *comm.MesgHeader, req *mesg.MesgGroupGagAdd) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupGagAddAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_GAG_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_add_handler
+ **功    能: GROUP-GAG-ADD处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-ADD请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限
+ **     2. 加入群组禁言名单(与HTTP接口共用同一名单)
+ **     3. 下发禁言通知
+ **注意事项: 请求中的uid为被禁言的用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_add_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupGagAdd, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	code, err = ctx.group_moderate_check(req.GetGid(), uid, req.GetUid())
+	if nil != err {
+		return code, err
+	}
+
+	ok, err := chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Isn't member of group!")
+	}
+
+	/* > 加入禁言名单 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_GAG_SET, req.GetGid())
+
+	_, err = rds.Do("ZADD", key, time.Now().Unix(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Add group gag failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_gag_add_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupGagAddHandler
+ **功    能: 群组禁言
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func UsrSvrGroupGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group gag add request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_gag_add_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-gag-add request failed!")
+		ctx.group_gag_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_gag_add_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_gag_add_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_gag_add_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group gag add handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_gag_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_gag_add_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 解除群组禁言 */
+
+/******************************************************************************
+ **函数名称: group_gag_del_parse
+ **功    能: 解析GROUP-GAG-DEL请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupGagDel, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-gag-del is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupGagDel{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-gag-del request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_gag_del_failed
+ **功    能: 发送GROUP-GAG-DEL应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-DEL请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_del_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupGagDel, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupGagDelAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_GAG_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_del_ack
+ **功    能: 发送GROUP-GAG-DEL应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-DEL请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_del_ack(head *comm.MesgHeader, req *mesg.MesgGroupGagDel) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupGagDelAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_GAG_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_gag_del_handler
+ **功    能: GROUP-GAG-DEL处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-GAG-DEL请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限
+ **     2. 移出群组禁言名单(与HTTP接口共用同一名单)
+ **     3. 下发解除禁言通知
+ **注意事项: 请求中的uid为被解除禁言的用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_gag_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupGagDel, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	code, err = ctx.group_moderate_check(req.GetGid(), uid, req.GetUid())
+	if nil != err {
+		return code, err
+	}
+
+	/* > 移出禁言名单 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_GAG_SET, req.GetGid())
+
+	_, err = rds.Do("ZREM", key, req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete group gag failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_gag_del_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupGagDelHandler
+ **功    能: 解除群组禁言
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func UsrSvrGroupGagDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group gag del request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_gag_del_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-gag-del request failed!")
+		ctx.group_gag_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_gag_del_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_gag_del_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_gag_del_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group gag del handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_gag_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_gag_del_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 加入群组黑名单 */
+
+/******************************************************************************
+ **函数名称: group_bl_add_parse
+ **功    能: 解析GROUP-BL-ADD请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_add_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupBlAdd, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-bl-add is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupBlAdd{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-bl-add request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_bl_add_failed
+ **功    能: 发送GROUP-BL-ADD应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-ADD请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_add_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupBlAdd, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupBlAddAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_BL_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_add_ack
+ **功    能: 发送GROUP-BL-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-ADD请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_add_ack(head *comm.MesgHeader, req *mesg.MesgGroupBlAdd) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupBlAddAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_BL_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_add_handler
+ **功    能: GROUP-BL-ADD处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-ADD请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限
+ **     2. 加入群组黑名单(与HTTP接口共用同一名单)
+ **     3. 下发加入黑名单通知
+ **注意事项: 请求中的uid为被加入黑名单的用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_add_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupBlAdd, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	code, err = ctx.group_moderate_check(req.GetGid(), uid, req.GetUid())
+	if nil != err {
+		return code, err
+	}
+
+	/* > 加入黑名单 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_BLACKLIST_SET, req.GetGid())
+
+	_, err = rds.Do("ZADD", key, time.Now().Unix(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Add group blacklist failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_bl_add_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupBlacklistAddHandler
+ **功    能: 加入群组黑名单
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func UsrSvrGroupBlacklistAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group blacklist add request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_bl_add_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-bl-add request failed!")
+		ctx.group_bl_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_bl_add_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_bl_add_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_bl_add_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group blacklist add handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_bl_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_bl_add_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 移除群组黑名单 */
+
+/******************************************************************************
+ **函数名称: group_bl_del_parse
+ **功    能: 解析GROUP-BL-DEL请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupBlDel, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-bl-del is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupBlDel{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-bl-del request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_bl_del_failed
+ **功    能: 发送GROUP-BL-DEL应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-DEL请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_del_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupBlDel, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupBlDelAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_BL_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_del_ack
+ **功    能: 发送GROUP-BL-DEL应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-DEL请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_del_ack(head *comm.MesgHeader, req *mesg.MesgGroupBlDel) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupBlDelAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_BL_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_bl_del_handler
+ **功    能: GROUP-BL-DEL处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-BL-DEL请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限
+ **     2. 移出群组黑名单(与HTTP接口共用同一名单)
+ **     3. 下发移除黑名单通知
+ **注意事项: 请求中的uid为被移出黑名单的用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_bl_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupBlDel, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	code, err = ctx.group_moderate_check(req.GetGid(), uid, req.GetUid())
+	if nil != err {
+		return code, err
+	}
+
+	/* > 移出黑名单 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_BLACKLIST_SET, req.GetGid())
+
+	_, err = rds.Do("ZREM", key, req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete group blacklist failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_bl_del_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupBlacklistDelHandler
+ **功    能: 移除群组黑名单
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:28:10 #
+ ******************************************************************************/
+func UsrSvrGroupBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group blacklist del request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_bl_del_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-bl-del request failed!")
+		ctx.group_bl_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_bl_del_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_bl_del_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_bl_del_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group blacklist del handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_bl_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_bl_del_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 添加群组管理员 */
+
+/******************************************************************************
+ **函数名称: group_mgr_add_parse
+ **功    能: 解析GROUP-MGR-ADD请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_add_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrAdd, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-mgr-add is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupMgrAdd{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-mgr-add request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_add_failed
+ **功    能: 发送GROUP-MGR-ADD应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-ADD请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_add_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrAdd, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupMgrAddAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_MGR_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_add_ack
+ **功    能: 发送GROUP-MGR-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-ADD请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_add_ack(head *comm.MesgHeader, req *mesg.MesgGroupMgrAdd) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupMgrAddAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_MGR_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_add_handler
+ **功    能: GROUP-MGR-ADD处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-ADD请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限: 只有群主才能设置管理员
+ **     2. 更新群组角色表, 并同步到数据库
+ **     3. 下发添加管理员通知
+ **注意事项: 请求中的uid为被设置的用户, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_add_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrAdd, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), uid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER != role {
+		ctx.log.Error("Only owner can add manager! gid:%d uid:%d role:%d",
+			req.GetGid(), uid, role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only owner can add manager!")
+	} else if uid == req.GetUid() {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Owner can't be manager!")
+	}
+
+	ok, err := chat.GroupIsMember(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Isn't member of group!")
+	}
+
+	role, err = chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_MANAGER == role {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Already manager of group!")
+	}
+
+	/* > 设置管理员角色 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, req.GetGid())
+
+	_, err = rds.Do("HSET", key, req.GetUid(), chat.GROUP_ROLE_MANAGER)
+	if nil != err {
+		ctx.log.Error("Set group role failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 记录到数据库(MONGODB) */
+	err = models.DbGroupMgrAdd(ctx.mongo, ctx.conf.Mongo.DbName,
+		req.GetGid(), req.GetUid(), uid, chat.GROUP_ROLE_MANAGER)
+	if nil != err {
+		ctx.log.Error("Save group manager failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_mgr_add_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupMgrAddHandler
+ **功    能: 添加群组管理员
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func UsrSvrGroupMgrAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group mgr add request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_mgr_add_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-mgr-add request failed!")
+		ctx.group_mgr_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_mgr_add_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_mgr_add_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_mgr_add_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group mgr add handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_mgr_add_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_mgr_add_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 移除群组管理员 */
+
+/******************************************************************************
+ **函数名称: group_mgr_del_parse
+ **功    能: 解析GROUP-MGR-DEL请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrDel, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-mgr-del is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupMgrDel{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-mgr-del request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() || 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! uid:%d gid:%d", req.GetUid(), req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_del_failed
+ **功    能: 发送GROUP-MGR-DEL应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-DEL请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_del_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrDel, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupMgrDelAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_MGR_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_del_ack
+ **功    能: 发送GROUP-MGR-DEL应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-DEL请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint32 code = 1;   // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_del_ack(head *comm.MesgHeader, req *mesg.MesgGroupMgrDel) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupMgrDelAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_MGR_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_mgr_del_handler
+ **功    能: GROUP-MGR-DEL处理
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-MGR-DEL请求
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **     1. 校验操作权限: 只有群主才能解除管理员
+ **     2. 更新群组角色表, 并同步到数据库
+ **     3. 下发解除管理员通知
+ **注意事项: 请求中的uid为被解除的管理员, 操作者通过会话SID获取
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_mgr_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgGroupMgrDel, uid uint64) (code uint32, err error) {
+	/* > 校验操作权限 */
+	role, err := chat.GroupGetRole(ctx.redis, req.GetGid(), uid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_OWNER != role {
+		ctx.log.Error("Only owner can delete manager! gid:%d uid:%d role:%d",
+			req.GetGid(), uid, role)
+		return comm.ERR_SVR_PERM_DENIED, errors.New("Only owner can delete manager!")
+	}
+
+	role, err = chat.GroupGetRole(ctx.redis, req.GetGid(), req.GetUid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if chat.GROUP_ROLE_MANAGER != role {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Isn't manager of group!")
+	}
+
+	/* > 移除管理员角色 */
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, req.GetGid())
+
+	_, err = rds.Do("HDEL", key, req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete group role failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 记录到数据库(MONGODB) */
+	err = models.DbGroupMgrDel(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetGid(), req.GetUid())
+	if nil != err {
+		ctx.log.Error("Delete group manager failed! gid:%d uid:%d errmsg:%s",
+			req.GetGid(), req.GetUid(), err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 下发通知 */
+	ctx.group_mgr_del_ntf(head, req.GetGid(), req.GetUid())
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupMgrDelHandler
+ **功    能: 移除群组管理员
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 uid = 1;    // M|用户ID|数字|
+ **        required uint64 gid = 2;    // M|群组ID|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func UsrSvrGroupMgrDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group mgr del request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_mgr_del_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-mgr-del request failed!")
+		ctx.group_mgr_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 获取操作者信息 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_mgr_del_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_mgr_del_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	/* > 请求处理 */
+	code, err = ctx.group_mgr_del_handler(head, req, attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Group group mgr del handler failed! code:%d errmsg:%s", code, err.Error())
+		ctx.group_mgr_del_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_mgr_del_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 群组成员列表 */
+
+/******************************************************************************
+ **函数名称: group_usr_list_parse
+ **功    能: 解析GROUP-USR-LIST请求
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_list_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGroupUsrList, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of group-usr-list is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgGroupUsrList{}
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal group-usr-list request failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetGid() {
+		ctx.log.Error("Paramter isn't right! gid:%d", req.GetGid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: group_usr_list_failed
+ **功    能: 发送GROUP-USR-LIST应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-USR-LIST请求
+ **     code: 错误码
+ **     err: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 gid = 1;    // M|群组ID|数字|
+ **         required string list = 2;   // M|成员列表|字串|JSON
+ **         optional uint64 next = 3;   // O|下页游标|数字|
+ **         optional uint32 total = 4;  // O|成员总数|数字|
+ **         optional uint32 code = 5;   // O|错误码|数字|
+ **         optional string errmsg = 6; // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_list_failed(
+	head *comm.MesgHeader, req *mesg.MesgGroupUsrList, code uint32, err error) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupUsrListAck{
+		Gid:    proto.Uint64(req.GetGid()),
+		List:   proto.String("[]"),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(err.Error()),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_USR_LIST_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: group_usr_list_ack
+ **功    能: 发送GROUP-USR-LIST应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GROUP-USR-LIST请求
+ **     list: 成员列表
+ **     next: 下页游标
+ **     total: 成员总数
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 gid = 1;    // M|群组ID|数字|
+ **         required string list = 2;   // M|成员列表|字串|JSON
+ **         optional uint64 next = 3;   // O|下页游标|数字|
+ **         optional uint32 total = 4;  // O|成员总数|数字|
+ **         optional uint32 code = 5;   // O|错误码|数字|
+ **         optional string errmsg = 6; // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) group_usr_list_ack(head *comm.MesgHeader,
+	req *mesg.MesgGroupUsrList, list []GroupUsrItem, next uint64, total int) int {
+	if nil == list {
+		list = make([]GroupUsrItem, 0)
+	}
+
+	data, err := json.Marshal(list)
+	if nil != err {
+		ctx.log.Error("Marshal json failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGroupUsrListAck{
+		Gid:    proto.Uint64(req.GetGid()),
+		List:   proto.String(string(data)),
+		Next:   proto.Uint64(next),
+		Total:  proto.Uint32(uint32(total)),
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	return ctx.send_data(comm.CMD_GROUP_USR_LIST_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGroupUsrListHandler
+ **功    能: 获取群组成员列表
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **        required uint64 gid = 1;    // M|群组ID|数字|
+ **        required uint32 num = 2;    // M|请求人数|数字|(0:表示获取默认数目, 最多CHAT_GROUP_USR_LIST_MAX_NUM个)
+ **        optional uint64 cursor = 3; // O|分页游标|数字|(成员在列表中的偏移)
+ **     }
+ **注意事项: 只有群成员才能获取成员列表
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func UsrSvrGroupUsrListHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv group usr list request! cmd:0x%04X nid:%d length:%d", cmd, nid, length)
+
+	/* > 解析请求 */
+	head, req, code, err := ctx.group_usr_list_parse(data)
+	if nil == req {
+		ctx.log.Error("Parse group-usr-list request failed!")
+		ctx.group_usr_list_failed(head, req, code, err)
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.group_usr_list_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if 0 == attr.GetUid() {
+		ctx.log.Error("Session is invalid! sid:%d", head.GetSid())
+		ctx.group_usr_list_failed(head, req,
+			comm.ERR_SVR_DATA_COLLISION, errors.New("Session is invalid!"))
+		return -1
+	}
+
+	ok, err = chat.GroupIsMember(ctx.redis, req.GetGid(), attr.GetUid())
+	if nil != err {
+		ctx.log.Error("Check group member failed! errmsg:%s", err.Error())
+		ctx.group_usr_list_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	} else if !ok {
+		ctx.log.Error("Isn't member of group! gid:%d uid:%d", req.GetGid(), attr.GetUid())
+		ctx.group_usr_list_failed(head, req,
+			comm.ERR_SVR_PERM_DENIED, errors.New("Isn't member of group!"))
+		return -1
+	}
+
+	/* > 获取成员列表 */
+	list, next, total, err := ctx.group_usr_list(req.GetGid(), req.GetCursor(), req.GetNum())
+	if nil != err {
+		ctx.group_usr_list_failed(head, req, comm.ERR_SYS_SYSTEM, err)
+		return -1
+	}
+
+	/* > 发送应答 */
+	ctx.group_usr_list_ack(head, req, list, next, total)
+
 	return 0
 }
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"fmt"
+	"strconv"
 
 	"beehive-im/src/golang/lib/comm"
 )
@@ -11,13 +12,76 @@ type UsrSvrGroupQueryCtrl struct {
 }
 
 func (this *UsrSvrGroupQueryCtrl) Query() {
-	//ctx := GetUsrSvrCtx()
+	ctx := GetUsrSvrCtx()
 
 	option := this.GetString("option")
 	switch option {
+	case "usr-list":
+		this.UsrList(ctx)
+		return
 	}
 
 	errmsg := fmt.Sprintf("Unsupport this option:%s", option)
 
 	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
 }
+
+////////////////////////////////////////////////////////////////////////////////
+/* 群组成员列表 */
+
+/* 应答结果 */
+type GroupUsrListRsp struct {
+	Gid    uint64         `json:"gid"`    // 群组ID
+	Total  int            `json:"total"`  // 成员总数
+	Next   uint64         `json:"next"`   // 下页游标
+	Len    int            `json:"len"`    // 列表长度
+	List   []GroupUsrItem `json:"list"`   // 成员列表
+	Code   int            `json:"code"`   // 错误码
+	ErrMsg string         `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: UsrList
+ **功    能: 分页获取群组成员列表
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述:
+ **注意事项:
+ **     请求参数: gid: 群组ID(M) num: 获取数目(O) cursor: 分页游标(O)
+ **作    者: # agent # 2026.10.18 06:27:28 #
+ ******************************************************************************/
+func (this *UsrSvrGroupQueryCtrl) UsrList(ctx *UsrSvrCntx) {
+	gid, _ := this.GetInt64("gid")
+	if 0 >= gid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [gid] is invalied!")
+		return
+	}
+
+	num, _ := strconv.ParseUint(this.GetString("num"), 10, 32)
+	cursor, _ := strconv.ParseUint(this.GetString("cursor"), 10, 64)
+
+	/* > 获取成员列表 */
+	list, next, total, err := ctx.group_usr_list(uint64(gid), cursor, uint32(num))
+	if nil != err {
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	} else if nil == list {
+		list = make([]GroupUsrItem, 0)
+	}
+
+	/* > 回复应答 */
+	rsp := &GroupUsrListRsp{
+		Gid:    uint64(gid),
+		Total:  total,
+		Next:   next,
+		Len:    len(list),
+		List:   list,
+		Code:   0,
+		ErrMsg: "Ok",
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/history"
+	"beehive-im/src/golang/lib/mesg"
+
+	"beehive-im/src/golang/exec/usrsvr/models"
+)
+
+// 历史消息查询
+type UsrSvrHistoryCtrl struct {
+	BaseController
+}
+
+func (this *UsrSvrHistoryCtrl) Query() {
+	ctx := GetUsrSvrCtx()
+
+	option := this.GetString("option")
+	switch option {
+	case "chat":
+		this.ChatHistory(ctx)
+		return
+	case "group":
+		this.GroupHistory(ctx)
+		return
+	}
+
+	errmsg := fmt.Sprintf("Unsupport this option:%s", option)
+
+	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+}
+
+/******************************************************************************
+ **函数名称: param
+ **功    能: 解析历史消息的公共查询条件
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: 查询条件
+ **实现描述: 见history.HistoryParamParse
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func (this *UsrSvrHistoryCtrl) param() *history.HistoryParam {
+	return history.HistoryParamParse(func(key string) string {
+		return this.GetString(key)
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 私聊历史消息 */
+
+/* 私聊消息 */
+type ChatHistoryItem struct {
+	Suid    uint64         `json:"suid"`    // 发送方UID
+	Duid    uint64         `json:"duid"`    // 接收方UID
+	Sid     uint64         `json:"sid"`     // 发送方会话SID
+	Msgid   uint64         `json:"msgid"`   // 消息ID
+	Ctm     int64          `json:"ctm"`     // 发送时间
+	Revoked uint32         `json:"revoked"` // 是否已撤回(0:否 1:是)
+	Mesg    *mesg.MesgChat `json:"mesg"`    // 消息内容
+}
+
+/* 应答结果 */
+type ChatHistoryRsp struct {
+	Uid    uint64            `json:"uid"`    // 用户ID
+	Peer   uint64            `json:"peer"`   // 对端UID
+	Next   string            `json:"next"`   // 下页游标(本页最后一条消息的${CTM}:${ID})
+	Len    int               `json:"len"`    // 列表长度
+	List   []ChatHistoryItem `json:"list"`   // 消息列表
+	Code   int               `json:"code"`   // 错误码
+	ErrMsg string            `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: ChatHistory
+ **功    能: 查询私聊历史消息
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述: 从MONGO中查询私聊消息, 并将PB消息体解析后返回.
+ **注意事项:
+ **     请求参数: uid: 用户ID(M) peer: 对端UID(O)
+ **     1. 私聊消息按(发送时间, 记录ID)分页: 将next作为下次请求的cursor;
+ **        私聊消息ID不是全局有序的, 因此不支持msgid游标;
+ **     2. 已撤回的消息不返回消息内容.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func (this *UsrSvrHistoryCtrl) ChatHistory(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseUint(this.GetString("uid"), 10, 64)
+	if 0 == uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalied!")
+		return
+	}
+
+	peer, _ := strconv.ParseUint(this.GetString("peer"), 10, 64)
+
+	/* > 生成查询条件 */
+	param := this.param()
+
+	cond, sort, err := history.ChatHistoryCond(uid, peer, param)
+	if nil != err {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, err.Error())
+		return
+	}
+
+	/* > 查询历史消息 */
+	rows, err := models.DbChatHistory(ctx.mongo,
+		ctx.conf.Mongo.DbName, cond, sort, param.Num)
+	if nil != err {
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	rsp := &ChatHistoryRsp{
+		Uid:  uid,
+		Peer: peer,
+		List: make([]ChatHistoryItem, 0, len(rows)),
+	}
+
+	for idx := range rows {
+		row := &rows[idx]
+
+		item := ChatHistoryItem{
+			Suid:    row.Suid,
+			Duid:    row.Duid,
+			Sid:     row.Sid,
+			Msgid:   row.Msgid,
+			Ctm:     row.Ctm,
+			Revoked: row.Revoked,
+		}
+
+		rsp.Next = history.ChatCursor(row)
+
+		if 0 == row.Revoked && len(row.Data) >= comm.MESG_HEAD_SIZE {
+			item.Mesg = &mesg.MesgChat{}
+			if nil != proto.Unmarshal(row.Data[comm.MESG_HEAD_SIZE:], item.Mesg) {
+				item.Mesg = nil
+			}
+		}
+
+		rsp.List = append(rsp.List, item)
+	}
+
+	/* > 回复应答 */
+	rsp.Len = len(rsp.List)
+	rsp.Code = 0
+	rsp.ErrMsg = "Ok"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 群聊历史消息 */
+
+/* 群聊消息 */
+type GroupHistoryItem struct {
+	Gid     uint64              `json:"gid"`     // 群组ID
+	Uid     uint64              `json:"uid"`     // 发送者UID
+	Msgid   uint64              `json:"msgid"`   // 群消息ID
+	Ctm     int64               `json:"ctm"`     // 发送时间
+	Revoked uint32              `json:"revoked"` // 是否已撤回(0:否 1:是)
+	Mesg    *mesg.MesgGroupChat `json:"mesg"`    // 消息内容
+}
+
+/* 应答结果 */
+type GroupHistoryRsp struct {
+	Gid    uint64             `json:"gid"`    // 群组ID
+	Next   uint64             `json:"next"`   // 下页游标(本页最后一条消息的群消息ID)
+	Len    int                `json:"len"`    // 列表长度
+	List   []GroupHistoryItem `json:"list"`   // 消息列表
+	Code   int                `json:"code"`   // 错误码
+	ErrMsg string             `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: GroupHistory
+ **功    能: 查询群聊历史消息
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述: 从MONGO中查询群聊消息, 并将PB消息体解析后返回.
+ **注意事项:
+ **     请求参数: gid: 群组ID(M)
+ **     1. 按群消息ID分页: 将next作为下次请求的msgid;
+ **     2. 已撤回的消息不返回消息内容.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func (this *UsrSvrHistoryCtrl) GroupHistory(ctx *UsrSvrCntx) {
+	gid, _ := strconv.ParseUint(this.GetString("gid"), 10, 64)
+	if 0 == gid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [gid] is invalied!")
+		return
+	}
+
+	/* > 查询历史消息 */
+	rows, err := models.DbGroupHistory(ctx.mongo,
+		ctx.conf.Mongo.DbName, gid, this.param())
+	if nil != err {
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
+	rsp := &GroupHistoryRsp{
+		Gid:  gid,
+		List: make([]GroupHistoryItem, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		item := GroupHistoryItem{
+			Gid:     row.Gid,
+			Uid:     row.Uid,
+			Msgid:   row.Msgid,
+			Ctm:     row.Ctm,
+			Revoked: row.Revoked,
+		}
+
+		rsp.Next = row.Msgid
+
+		if 0 == row.Revoked && len(row.Data) >= comm.MESG_HEAD_SIZE {
+			item.Mesg = &mesg.MesgGroupChat{}
+			if nil != proto.Unmarshal(row.Data[comm.MESG_HEAD_SIZE:], item.Mesg) {
+				item.Mesg = nil
+			}
+		}
+
+		rsp.List = append(rsp.List, item)
+	}
+
+	/* > 回复应答 */
+	rsp.Len = len(rsp.List)
+	rsp.Code = 0
+	rsp.ErrMsg = "Ok"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
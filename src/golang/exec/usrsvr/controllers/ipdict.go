@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+/* IP字典路径 */
+const USRSVR_IPDICT_PATH = "../conf/ipdict.txt"
+
+/* IP字典加载失败后的重试间隔(秒) */
+const USRSVR_IPDICT_RETRY_SEC = 30
+
+/* IP字典 */
+type UsrSvrIpDict struct {
+	sync.RWMutex              /* 读写锁 */
+	path         string       /* 字典路径 */
+	dict         *comm.IpDict /* 当前IP字典 */
+	load_tm      int64        /* 当前IP字典的加载时间 */
+	mtime        int64        /* 最近一次加载的字典文件修改时间(纳秒) */
+	size         int64        /* 最近一次加载的字典文件大小 */
+	reload_tm    int64        /* 最近一次重载时间 */
+	errmsg       string       /* 最近一次重载的错误描述(成功时为空) */
+}
+
+/* IP字典状态 */
+type UsrSvrIpDictStatus struct {
+	Path     string `json:"path"`      // 字典路径
+	Num      int    `json:"num"`       // 当前字典条目数(IPv4+IPv6)
+	LoadTm   int64  `json:"load-tm"`   // 当前字典的加载时间
+	ReloadTm int64  `json:"reload-tm"` // 最近一次重载时间
+	Result   int    `json:"result"`    // 最近一次重载结果(0:成功 1:失败)
+	Reason   string `json:"reason"`    // 最近一次重载失败的原因
+}
+
+/******************************************************************************
+ **函数名称: ipdict_init
+ **功    能: 加载IP字典
+ **输入参数:
+ **     path: 字典路径
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述:
+ **注意事项: 初始化时加载失败则程序无法启动
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) ipdict_init(path string) error {
+	ctx.ipdict.path = path
+
+	return ctx.ipdict_reload()
+}
+
+/******************************************************************************
+ **函数名称: ipdict_reload
+ **功    能: 重新加载IP字典
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述:
+ **     1. 加载并校验新字典(格式及IP是否有序, 见comm.LoadIpDict);
+ **     2. 校验通过后替换当前字典, 否则保留当前字典.
+ **注意事项: 新字典在锁外加载, 替换时才加写锁, 不阻塞查询.
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) ipdict_reload() error {
+	ctm := time.Now().Unix()
+
+	/* > 获取文件修改时间及大小 */
+	var mtime, size int64
+
+	info, err := os.Stat(ctx.ipdict.path)
+	if nil == err {
+		mtime = info.ModTime().UnixNano()
+		size = info.Size()
+	}
+
+	/* > 加载并校验新字典 */
+	dict, err := comm.LoadIpDict(ctx.ipdict.path)
+
+	ctx.ipdict.Lock()
+	defer ctx.ipdict.Unlock()
+
+	ctx.ipdict.mtime = mtime
+	ctx.ipdict.size = size
+	ctx.ipdict.reload_tm = ctm
+	if nil != err {
+		ctx.ipdict.errmsg = err.Error()
+		ctx.log.Error("Reload ip dict failed! path:%s errmsg:%s",
+			ctx.ipdict.path, err.Error())
+		return err
+	}
+
+	/* > 替换当前字典 */
+	ctx.ipdict.dict = dict
+	ctx.ipdict.load_tm = ctm
+	ctx.ipdict.errmsg = ""
+
+	ctx.log.Info("Reload ip dict success! path:%s num:%d",
+		ctx.ipdict.path, dict.GetNum())
+
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: ipdict_watch
+ **功    能: 检测IP字典文件是否被修改
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 以下情况重新加载IP字典:
+ **     1. 文件修改时间(纳秒)或文件大小发生变化;
+ **     2. 最近一次加载失败, 且距离上次重载已超过USRSVR_IPDICT_RETRY_SEC秒.
+ **注意事项: 部分文件系统的修改时间精度为秒, 因此同时比较文件大小;
+ **     加载失败时按间隔重试, 既能在文件修复后自动恢复, 又不会频繁加载错误文件.
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) ipdict_watch() {
+	info, err := os.Stat(ctx.ipdict.path)
+	if nil != err {
+		return
+	}
+
+	ctm := time.Now().Unix()
+
+	ctx.ipdict.RLock()
+	changed := (info.ModTime().UnixNano() != ctx.ipdict.mtime) || (info.Size() != ctx.ipdict.size)
+	retry := ("" != ctx.ipdict.errmsg) && (ctm-ctx.ipdict.reload_tm >= USRSVR_IPDICT_RETRY_SEC)
+	ctx.ipdict.RUnlock()
+
+	if !changed && !retry {
+		return
+	}
+
+	ctx.ipdict_reload()
+}
+
+/******************************************************************************
+ **函数名称: ipdict_query
+ **功    能: 查询IP字典
+ **输入参数:
+ **     ip: IP地址(IPv4或IPv6)
+ **输出参数: NONE
+ **返    回: IP选项(国家+运营商)
+ **实现描述:
+ **注意事项: 加读锁
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) ipdict_query(ip string) *comm.IpDictItem {
+	ctx.ipdict.RLock()
+	defer ctx.ipdict.RUnlock()
+
+	if nil == ctx.ipdict.dict {
+		return nil
+	}
+
+	return ctx.ipdict.dict.Query(ip)
+}
+
+/******************************************************************************
+ **函数名称: ipdict_status
+ **功    能: 获取IP字典状态
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: IP字典状态
+ **实现描述:
+ **注意事项: 加读锁
+ **作    者: # agent # 2026.10.18 06:51:01 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) ipdict_status() *UsrSvrIpDictStatus {
+	ctx.ipdict.RLock()
+	defer ctx.ipdict.RUnlock()
+
+	status := &UsrSvrIpDictStatus{
+		Path:     ctx.ipdict.path,
+		LoadTm:   ctx.ipdict.load_tm,
+		ReloadTm: ctx.ipdict.reload_tm,
+		Reason:   ctx.ipdict.errmsg,
+	}
+
+	if nil != ctx.ipdict.dict {
+		status.Num = ctx.ipdict.dict.GetNum()
+	}
+
+	if "" != ctx.ipdict.errmsg {
+		status.Result = 1
+	}
+
+	return status
+}
@@ -2,12 +2,11 @@ package controllers
 
 import (
 	"errors"
-	"fmt"
 	"math/rand"
+	"net"
 	"time"
 
 	"beehive-im/src/golang/lib/comm"
-	"beehive-im/src/golang/lib/crypt"
 )
 
 type UsrSvrIplistCtrl struct {
@@ -168,29 +167,21 @@ func (this *UsrSvrIplistCtrl) success(param *IpListParam, iplist []string) {
  **函数名称: iplist_token
  **功    能: 生成TOKEN字串
  **输入参数:
+ **     param: 注册参数
  **输出参数: NONE
- **返    回:
- **     token: 加密TOKEN
- **     expire: 过期时间
- **实现描述:
+ **返    回: 签名TOKEN
+ **实现描述: 使用当前签名密钥对uid/sid/签发时间/过期时间进行HMAC签名
  **注意事项:
- **     TOKEN的格式"uid:${uid}:ttl:${ttl}:sid:${sid}:end"
- **     uid: 用户ID
- **     ttl: 该token的最大生命时间
- **     sid: 会话UID
+ **     TOKEN的格式"v1.${kid}.${payload}.${sign}", 详见crypt.TokenCtx.Sign()
+ **     有效时长由配置TOKEN.TTL决定
  **作    者: # Qifeng.zou # 2016.11.25 23:54:27 #
  ******************************************************************************/
 func (this *UsrSvrIplistCtrl) iplist_token(param *IpListParam) string {
 	ctx := GetUsrSvrCtx()
-	ttl := time.Now().Unix() + comm.TIME_YEAR
-
-	/* > 原始TOKEN */
-	token := fmt.Sprintf("uid:%d:ttl:%d:sid:%d:end", param.uid, ttl, param.sid)
 
-	/* > 加密TOKEN */
-	cry := crypt.CreateEncodeCtx(ctx.conf.Cipher)
+	ctm := time.Now().Unix()
 
-	return crypt.Encode(cry, token)
+	return ctx.token.Sign(param.uid, param.sid, ctm, ctm+ctx.conf.Token.Ttl)
 }
 
 /******************************************************************************
@@ -201,8 +192,10 @@ func (this *UsrSvrIplistCtrl) iplist_token(param *IpListParam) string {
  **     typ: 网络类型(0:Unknown 1:TCP 2:WS)
  **     clientip: 客户端IP
  **输出参数: NONE
- **返    回: IP列表
- **实现描述: 首先根据网络类型, 再根据运营商类型筛选.
+ **返    回: IP列表(按优先级排列)
+ **实现描述:
+ **     1. 按客户端IP的地址族(IPv4/IPv6)返回同一地址族的侦听层地址;
+ **     2. IPv6客户端找不到IPv6侦听层时, 退化为返回IPv4地址(双栈客户端仍可连接).
  **注意事项: 加读锁
  **作    者: # Qifeng.zou # 2016.11.27 07:42:54 #
  ******************************************************************************/
@@ -215,30 +208,62 @@ func (this *UsrSvrIplistCtrl) iplist_get(ctx *UsrSvrCntx, typ int, clientip stri
 		return nil
 	}
 
-	item := ctx.ipdict.Query(clientip)
-	if nil == item {
-		listend.RLock()
-		defer listend.RUnlock()
-		return listend.get_default(ctx)
-	}
-
 	listend.RLock()
 	defer listend.RUnlock()
 
+	/* > 判断客户端地址族(IPv4映射地址按IPv4处理) */
+	ipv6 := false
+	if comm.IsIpv6(clientip) {
+		addr := net.ParseIP(clientip)
+		ipv6 = (nil != addr && nil == addr.To4())
+	}
+
+	item := ctx.ipdict_query(clientip)
+
+	items := listend.get_by_item(ctx, item, ipv6)
+	if 0 == len(items) && ipv6 {
+		ctx.log.Debug("Didn't find ipv6 listend! clientip:%s", clientip)
+		return listend.get_by_item(ctx, item, false)
+	}
+
+	return items
+}
+
+/******************************************************************************
+ **函数名称: get_by_item
+ **功    能: 根据IP字典项获取IP列表
+ **输入参数:
+ **     ctx: 上下文
+ **     item: IP字典项(国家+运营商). 为nil时取默认IP列表
+ **     ipv6: 是否获取IPv6地址
+ **输出参数: NONE
+ **返    回: IP列表(按优先级排列)
+ **实现描述: 首先根据国家/地区, 再根据运营商类型筛选; 无可用结点时取默认IP列表.
+ **注意事项: 外部已经加读锁
+ **作    者: # agent # 2026.10.18 06:49:39 #
+ ******************************************************************************/
+func (listend *UsrSvrLsndDictItem) get_by_item(
+	ctx *UsrSvrCntx, item *comm.IpDictItem, ipv6 bool) []string {
+	if nil == item {
+		return listend.get_default(ctx, ipv6)
+	}
+
 	/* > 获取国家/地区下辖的运营商列表 */
 	operators, ok := listend.list[item.GetNation()]
 	if nil == operators || !ok {
-		return listend.get_default(ctx)
+		return listend.get_default(ctx, ipv6)
 	}
 
 	/* > 获取运营商下辖的侦听层列表 */
 	list, ok := operators[item.GetOpid()]
 	if nil == list || !ok {
-		return listend.get_default(ctx)
+		return listend.get_default(ctx, ipv6)
 	}
 
-	items := make([]string, 0)
-	items = append(items, list[rand.Intn(len(list))])
+	items := ctx.listend_select(list, ctx.conf.Iplist.Num, ipv6)
+	if 0 == len(items) {
+		return listend.get_default(ctx, ipv6)
+	}
 
 	return items
 }
@@ -248,16 +273,15 @@ func (this *UsrSvrIplistCtrl) iplist_get(ctx *UsrSvrCntx, typ int, clientip stri
  **功    能: 获取默认IP列表
  **输入参数:
  **     ctx: 上下文
- **     typ: 网络类型(0:Unknown 1:TCP 2:WS)
+ **     ipv6: 是否获取IPv6地址
  **输出参数: NONE
- **返    回: IP列表
- **实现描述:
+ **返    回: IP列表(按优先级排列)
+ **实现描述: 从"默认"国家/地区下辖的所有运营商结点中选取
  **注意事项: 外部已经加读锁
  **作    者: # Qifeng.zou # 2016.11.27 19:33:49 #
  ******************************************************************************/
-func (listend *UsrSvrLsndDictItem) get_default(ctx *UsrSvrCntx) []string {
-	var ok bool
-	var typ, n uint32
+func (listend *UsrSvrLsndDictItem) get_default(ctx *UsrSvrCntx, ipv6 bool) []string {
+	var list []*UsrSvrLsndNode
 
 	/* > 获取"默认"国家/地区下辖的运营商ID列表 */
 	operators, ok := listend.list["CN"]
@@ -266,26 +290,68 @@ func (listend *UsrSvrLsndDictItem) get_default(ctx *UsrSvrCntx) []string {
 		return nil
 	}
 
-	idx := rand.Intn(len(operators)) % len(operators)
-
-	n = 0
-	for k, v := range operators {
-		if n == uint32(idx) {
-			typ = k
-			break
-		}
-		ctx.log.Debug("k:%d v:%s", k, v)
+	/* > 获取"默认"国家/地区下辖的侦听层列表 */
+	for _, nodes := range operators {
+		list = append(list, nodes...)
 	}
 
-	/* > 获取"默认"运营商下辖的侦听层列表 */
-	list, ok := operators[typ]
-	if nil == list || 0 == len(list) || !ok {
-		ctx.log.Error("Get default iplist by operator failed!")
+	items := ctx.listend_select(list, ctx.conf.Iplist.Num, ipv6)
+	if 0 == len(items) {
+		ctx.log.Error("Get default iplist by operator failed! ipv6:%t", ipv6)
 		return nil
 	}
 
+	return items
+}
+
+/******************************************************************************
+ **函数名称: listend_select
+ **功    能: 按负载选取侦听层结点
+ **输入参数:
+ **     list: 侦听层结点列表
+ **     num: 最多选取的结点数
+ **     ipv6: 是否选取IPv6地址
+ **输出参数: NONE
+ **返    回: IP列表(按优先级排列)
+ **实现描述:
+ **     1. 过滤掉处于繁忙状态(PROC_STATUS_BUSY)或不支持该地址族的结点;
+ **     2. 每轮随机抽取两个候选结点, 选择在线连接数较少者(Power of two choices),
+ **        直至选够num个结点或候选结点耗尽.
+ **注意事项:
+ **     1. 连接数由侦听层周期上报, 存在一定滞后. 相比直接选择连接数最少的结点,
+ **        随机二选一可避免大量请求同时涌向同一结点;
+ **     2. 客户端应按列表顺序依次尝试连接.
+ **作    者: # agent # 2026.10.18 06:47:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) listend_select(list []*UsrSvrLsndNode, num int, ipv6 bool) []string {
 	items := make([]string, 0)
-	items = append(items, list[rand.Intn(len(list))])
+
+	/* > 过滤繁忙结点 */
+	candidates := make([]*UsrSvrLsndNode, 0, len(list))
+	for _, node := range list {
+		if comm.PROC_STATUS_BUSY == node.status {
+			continue
+		} else if "" == node.get_addr(ipv6) {
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+
+	/* > 随机二选一 */
+	for len(items) < num && 0 != len(candidates) {
+		total := len(candidates)
+
+		idx := rand.Intn(total)
+		other := rand.Intn(total)
+		if candidates[other].conns < candidates[idx].conns {
+			idx = other
+		}
+
+		items = append(items, candidates[idx].get_addr(ipv6))
+
+		candidates[idx] = candidates[total-1]
+		candidates = candidates[:total-1]
+	}
 
 	return items
 }
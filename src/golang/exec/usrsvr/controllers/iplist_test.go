@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"sort"
+	"testing"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+func TestListendSelect(t *testing.T) {
+	nodes := []*UsrSvrLsndNode{
+		{nid: 1, addr: "1.1.1.1:8000", status: comm.PROC_STATUS_EXEC, conns: 10},
+		{nid: 2, addr: "2.2.2.2:8000", addr6: "[::2]:8000", status: comm.PROC_STATUS_EXEC, conns: 20},
+		{nid: 3, addr: "3.3.3.3:8000", status: comm.PROC_STATUS_BUSY, conns: 0},
+		{nid: 4, addr6: "[::4]:8000", status: comm.PROC_STATUS_EXEC, conns: 5},
+	}
+
+	cases := []struct {
+		name string
+		num  int
+		ipv6 bool
+		want []string // 选中的地址集合(不关心顺序)
+	}{
+		{"ipv4 skips busy and ipv6-only", 10, false, []string{"1.1.1.1:8000", "2.2.2.2:8000"}},
+		{"ipv6 skips ipv4-only", 10, true, []string{"[::2]:8000", "[::4]:8000"}},
+		{"limited by num", 1, false, nil},
+		{"zero num", 0, false, []string{}},
+	}
+
+	ctx := &UsrSvrCntx{}
+	for _, c := range cases {
+		list := make([]*UsrSvrLsndNode, len(nodes))
+		copy(list, nodes)
+
+		items := ctx.listend_select(list, c.num, c.ipv6)
+		if nil == c.want {
+			if c.num != len(items) {
+				t.Errorf("%s: got %d items, want %d", c.name, len(items), c.num)
+			}
+			continue
+		}
+
+		sort.Strings(items)
+		if len(items) != len(c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, items, c.want)
+			continue
+		}
+		for idx := range items {
+			if items[idx] != c.want[idx] {
+				t.Errorf("%s: got %v, want %v", c.name, items, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestListendSelectPrefersLessLoaded(t *testing.T) {
+	nodes := []*UsrSvrLsndNode{
+		{nid: 1, addr: "1.1.1.1:8000", status: comm.PROC_STATUS_EXEC, conns: 1000},
+		{nid: 2, addr: "2.2.2.2:8000", status: comm.PROC_STATUS_EXEC, conns: 10},
+	}
+
+	/* 随机二选一时, 负载较重的结点只有两次都被抽中才会被选中(概率1/4) */
+	ctx := &UsrSvrCntx{}
+	hits := 0
+	for idx := 0; idx < 1000; idx += 1 {
+		list := []*UsrSvrLsndNode{nodes[0], nodes[1]}
+		items := ctx.listend_select(list, 1, false)
+		if 1 == len(items) && "2.2.2.2:8000" == items[0] {
+			hits += 1
+		}
+	}
+
+	if hits < 600 {
+		t.Errorf("less loaded node selected %d/1000 times, want about 750", hits)
+	}
+}
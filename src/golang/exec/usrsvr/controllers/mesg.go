@@ -3,10 +3,12 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/garyburd/redigo/redis"
 	"github.com/golang/protobuf/proto"
 
 	"beehive-im/src/golang/lib/comm"
@@ -25,6 +27,7 @@ type OnlineToken struct {
 	uid uint64 /* 用户ID */
 	ttl int64  /* TTL */
 	sid uint64 /* 会话SID */
+	ctm int64  /* 签发时间 */
 }
 
 /******************************************************************************
@@ -34,15 +37,47 @@ type OnlineToken struct {
  **     token: TOKEN字串
  **输出参数: NONE
  **返    回: TOKEN字段
+ **实现描述: 签名TOKEN校验签名后提取有效数据; 旧版TOKEN只在配置允许时才进行解析.
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.20 09:28:06 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) online_token_decode(token string) *OnlineToken {
+	if !crypt.IsSignedToken(token) {
+		if 0 == ctx.conf.Token.Legacy {
+			ctx.log.Error("Legacy token is not allowed! digest:%s", crypt.TokenDigest(token))
+			return nil
+		}
+		return ctx.online_token_decode_legacy(token)
+	}
+
+	/* > 校验TOKEN签名 */
+	tk, err := ctx.token.Verify(token)
+	if nil != err {
+		ctx.log.Error("Verify token failed! digest:%s errmsg:%s",
+			crypt.TokenDigest(token), err.Error())
+		return nil
+	}
+
+	return &OnlineToken{uid: tk.Uid, ttl: tk.Ttl, sid: tk.Sid, ctm: tk.Ctm}
+}
+
+/******************************************************************************
+ **函数名称: online_token_decode_legacy
+ **功    能: 解码旧版TOKEN
+ **输入参数:
+ **     token: TOKEN字串
+ **输出参数: NONE
+ **返    回: TOKEN字段
  **实现描述: 解析token, 并提取有效数据.
  **注意事项:
  **     TOKEN的格式"uid:${uid}:ttl:${ttl}:sid:${sid}:end"
  **     uid: 用户ID
  **     ttl: 该token的最大生命时间
  **     sid: 会话SID
+ **     旧版TOKEN没有签发时间, 按"过期时间-1年"推算.
  **作    者: # Qifeng.zou # 2016.11.20 09:28:06 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) online_token_decode(token string) *OnlineToken {
+func (ctx *UsrSvrCntx) online_token_decode_legacy(token string) *OnlineToken {
 	tk := &OnlineToken{}
 
 	/* > TOKEN解码 */
@@ -50,11 +85,11 @@ func (ctx *UsrSvrCntx) online_token_decode(token string) *OnlineToken {
 	orig_token := crypt.Decode(cry, token)
 	words := strings.Split(orig_token, ":")
 	if 7 != len(words) {
-		ctx.log.Error("Token format not right! token:%s orig:%s", token, orig_token)
+		ctx.log.Error("Token format not right! token:%s", crypt.TokenDigest(token))
 		return nil
 	}
 
-	ctx.log.Debug("token:%s orig:%s", token, orig_token)
+	ctx.log.Debug("token:%s", crypt.TokenDigest(token))
 
 	/* > 验证TOKEN合法性 */
 	uid, _ := strconv.ParseInt(words[1], 10, 64)
@@ -69,6 +104,8 @@ func (ctx *UsrSvrCntx) online_token_decode(token string) *OnlineToken {
 	tk.sid = uint64(sid)
 	ctx.log.Debug("words[5]:%s sid:%d sid:%d", words[5], sid, tk.sid)
 
+	tk.ctm = tk.ttl - comm.TIME_YEAR
+
 	return tk
 }
 
@@ -79,12 +116,9 @@ func (ctx *UsrSvrCntx) online_token_decode(token string) *OnlineToken {
  **     req: ONLINE请求
  **输出参数: NONE
  **返    回: 异常信息
- **实现描述: 计算TOKEN合法性
+ **实现描述: 校验TOKEN签名、有效期、是否与请求匹配以及是否已被吊销
  **注意事项:
- **     1.TOKEN的格式"${uid}:${ttl}:${sid}"
- **         uid: 用户ID
- **         ttl: 该token的最大生命时间
- **         sid: 会话SID
+ **     1.TOKEN的格式见online_token_decode()
  **     2.头部数据(MesgHeader)中的SID此时表示的是客户端的连接CID.
  **作    者: # Qifeng.zou # 2016.11.02 10:20:57 #
  ******************************************************************************/
@@ -102,6 +136,17 @@ func (ctx *UsrSvrCntx) online_req_check(req *mesg.MesgOnline) error {
 		return errors.New("Token is invalid!!")
 	}
 
+	/* > 判断TOKEN是否已被吊销 */
+	revoked, err := im.IsTokenRevoked(ctx.redis, token.uid, token.sid, token.ctm)
+	if nil != err {
+		ctx.log.Error("Check token revoked failed! uid:%d sid:%d errmsg:%s",
+			token.uid, token.sid, err.Error())
+		return err
+	} else if revoked {
+		ctx.log.Error("Token is revoked! uid:%d sid:%d ctm:%d", token.uid, token.sid, token.ctm)
+		return errors.New("Token is revoked!")
+	}
+
 	return nil
 }
 
@@ -295,7 +340,8 @@ func (ctx *UsrSvrCntx) online_ack(head *comm.MesgHeader, req *mesg.MesgOnline, s
  **返    回: 消息序列号+异常信息
  **实现描述:
  **     1. 校验是否SID上线信息是否存在冲突. 如果存在冲突, 则将之前的连接踢下线.
- **     2. 更新数据库信息
+ **     2. 执行多设备登录策略, 将超出限制的老会话踢下线.
+ **     3. 更新数据库信息
  **注意事项:
  **     1. 在上线请求中, head中的sid此时为侦听层cid
  **     2. 在上线请求中, req中的sid此时为会话sid
@@ -336,15 +382,24 @@ func (ctx *UsrSvrCntx) online_handler(head *comm.MesgHeader, req *mesg.MesgOnlin
 		return 0, err
 	}
 
+	/* 执行多设备登录策略 */
+	ctx.online_policy(req)
+
 	/* 记录SID集合 */
 	pl.Send("ZADD", comm.IM_KEY_SID_ZSET, ttl, req.GetSid())
 
 	/* 记录UID集合 */
 	pl.Send("ZADD", comm.IM_KEY_UID_ZSET, ttl, req.GetUid())
 
-	/* 记录SID->UID/NID */
+	/* 记录SID->UID/NID/APP */
 	key = fmt.Sprintf(comm.IM_KEY_SID_ATTR, req.GetSid())
-	pl.Send("HMSET", key, "CID", head.GetCid(), "UID", req.GetUid(), "NID", head.GetNid())
+	pl.Send("HMSET", key, "CID", head.GetCid(), "UID", req.GetUid(), "NID", head.GetNid(),
+		"APP", req.GetApp(), "VERSION", req.GetVersion(),
+		"TERMINAL", req.GetTerminal(), "ONLINE_TM", time.Now().Unix())
+
+	/* 记录APP->SID集合 */
+	key = fmt.Sprintf(comm.IM_KEY_APP_TO_SID_ZSET, req.GetApp())
+	pl.Send("ZADD", key, ttl, req.GetSid())
 
 	/* 记录UID->SID集合 */
 	key = fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, req.GetUid())
@@ -353,6 +408,185 @@ func (ctx *UsrSvrCntx) online_handler(head *comm.MesgHeader, req *mesg.MesgOnlin
 	return seq, err
 }
 
+/* 在线会话 */
+type OnlineSess struct {
+	sid      uint64 // 会话SID
+	cid      uint64 // 连接CID
+	nid      uint32 // 侦听层ID
+	terminal uint32 // 终端类型
+	online   int64  // 上线时间
+}
+
+type OnlineSessList []*OnlineSess
+
+func (list OnlineSessList) Len() int           { return len(list) }
+func (list OnlineSessList) Less(i, j int) bool { return list[i].online < list[j].online }
+func (list OnlineSessList) Swap(i, j int)      { list[i], list[j] = list[j], list[i] }
+
+/******************************************************************************
+ **函数名称: online_sess_list
+ **功    能: 获取用户在某APP上的在线会话列表
+ **输入参数:
+ **     uid: 用户ID
+ **     app: APP名(为空时表示所有APP)
+ **     except: 需排除的会话SID(即: 正在上线的会话)
+ **输出参数: NONE
+ **返    回:
+ **     list: 在线会话列表(按上线时间升序排列)
+ **     err: 错误描述
+ **实现描述: 遍历UID对应的会话SID集合, 通过PIPELINE批量获取会话属性及有效期.
+ **注意事项: 已过期或已不属于该用户的会话将被忽略
+ **作    者: # agent # 2026.10.18 06:52:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) online_sess_list(
+	uid uint64, app string, except uint64) (list OnlineSessList, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+
+	sid_list, err := redis.Int64s(rds.Do("SMEMBERS", key))
+	if nil != err {
+		return nil, err
+	}
+
+	/* > 批量获取会话属性 */
+	num := len(sid_list)
+	for idx := 0; idx < num; idx += 1 {
+		key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid_list[idx])
+		rds.Send("HMGET", key, "CID", "UID", "NID", "APP", "TERMINAL", "ONLINE_TM")
+		rds.Send("ZSCORE", comm.IM_KEY_SID_ZSET, sid_list[idx])
+	}
+
+	rds.Flush()
+
+	for idx := 0; idx < num; idx += 1 {
+		vals, err := redis.Strings(rds.Receive())
+		if nil != err {
+			return nil, err
+		}
+
+		ttl, err := redis.Int64(rds.Receive())
+		if redis.ErrNil == err {
+			continue
+		} else if nil != err {
+			return nil, err
+		}
+
+		sid := uint64(sid_list[idx])
+		_uid, _ := strconv.ParseUint(vals[1], 10, 64)
+		nid, _ := strconv.ParseUint(vals[2], 10, 32)
+		if sid == except || _uid != uid || 0 == nid || ttl < ctm || ("" != app && vals[3] != app) {
+			continue
+		}
+
+		cid, _ := strconv.ParseUint(vals[0], 10, 64)
+		terminal, _ := strconv.ParseUint(vals[4], 10, 32)
+		online, _ := strconv.ParseInt(vals[5], 10, 64)
+
+		sess := &OnlineSess{
+			sid:      sid,
+			cid:      cid,
+			nid:      uint32(nid),
+			terminal: uint32(terminal),
+			online:   online,
+		}
+
+		list = append(list, sess)
+	}
+
+	sort.Sort(list)
+
+	return list, nil
+}
+
+/******************************************************************************
+ **函数名称: online_policy
+ **功    能: 执行多设备登录策略
+ **输入参数:
+ **     req: 上线请求
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 同一终端类型的在线会话数超过TERM-MAX时, 踢掉该终端类型最早上线的会话;
+ **     2. 所有终端的在线会话数超过MAX时, 踢掉最早上线的会话.
+ **注意事项:
+ **     1. 只统计同一APP下的会话, 策略配置见usrsvr.xml的LOGIN节点;
+ **     2. 终端类型未知(0)时不执行终端类型限制;
+ **     3. 统计时不包含正在上线的会话, 因此需为其预留一个名额.
+ **作    者: # agent # 2026.10.18 06:52:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) online_policy(req *mesg.MesgOnline) {
+	policy := ctx.conf.GetLoginPolicy(req.GetApp())
+	if nil == policy || (0 == policy.Max && 0 == policy.TermMax) {
+		return
+	}
+
+	list, err := ctx.online_sess_list(req.GetUid(), req.GetApp(), req.GetSid())
+	if nil != err {
+		ctx.log.Error("Get online session list failed! uid:%d errmsg:%s",
+			req.GetUid(), err.Error())
+		return
+	}
+
+	kicked := make(map[uint64]bool)
+
+	/* > 同一终端类型的会话数限制 */
+	if 0 != policy.TermMax && 0 != req.GetTerminal() {
+		var same OnlineSessList
+
+		for _, sess := range list {
+			if sess.terminal == req.GetTerminal() {
+				same = append(same, sess)
+			}
+		}
+
+		for idx := 0; idx <= len(same)-policy.TermMax; idx += 1 {
+			kicked[same[idx].sid] = true
+			ctx.online_policy_kick(req, same[idx])
+		}
+	}
+
+	/* > 所有终端的会话数限制 */
+	if 0 != policy.Max {
+		var remain OnlineSessList
+
+		for _, sess := range list {
+			if !kicked[sess.sid] {
+				remain = append(remain, sess)
+			}
+		}
+
+		for idx := 0; idx <= len(remain)-policy.Max; idx += 1 {
+			ctx.online_policy_kick(req, remain[idx])
+		}
+	}
+}
+
+/******************************************************************************
+ **函数名称: online_policy_kick
+ **功    能: 将违反登录策略的老会话踢下线
+ **输入参数:
+ **     req: 上线请求
+ **     sess: 将被踢下线的会话
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 清理会话数据, 并通知侦听层断开连接.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:52:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) online_policy_kick(req *mesg.MesgOnline, sess *OnlineSess) {
+	ctx.log.Info("Kick session by login policy! uid:%d app:%s sid:%d terminal:%d new-sid:%d terminal:%d",
+		req.GetUid(), req.GetApp(), sess.sid, sess.terminal, req.GetSid(), req.GetTerminal())
+
+	im.CleanSessionData(ctx.redis, sess.sid, sess.cid, sess.nid)
+
+	ctx.send_kick(sess.sid, sess.cid, sess.nid,
+		comm.ERR_SVR_MULTI_LOGIN, "Logged in on another device!")
+}
+
 /******************************************************************************
  **函数名称: UsrSvrOnlineHandler
  **功    能: 上线请求
@@ -407,6 +641,18 @@ func UsrSvrOnlineHandler(cmd uint32, nid uint32, data []byte, length uint32, par
 	/* > 发送上线应答 */
 	ctx.online_ack(head, req, seq)
 
+	/* > 标记上线(防抖后通知好友及订阅者) */
+	ctx.presence_mark(req.GetUid())
+
+	/* > 下发离线期间收到的好友申请 */
+	ctx.friendReqResend(req.GetUid(), req.GetSid(), head.GetCid(), head.GetNid())
+
+	/* > 下发离线期间收到的入群申请(群主及管理员) */
+	ctx.group_join_resend(req.GetUid(), req.GetSid(), head.GetCid(), head.GetNid())
+
+	/* > 下发离线期间的推送消息 */
+	ctx.push_resend(req.GetUid(), req.GetApp(), req.GetVersion(), req.GetSid(), head.GetCid(), head.GetNid())
+
 	return 0
 }
 
@@ -817,6 +1063,16 @@ func UsrSvrSubHandler(cmd uint32, nid uint32, data []byte, length uint32, param
 		return -1
 	}
 
+	/* > 订阅上下线通知 */
+	if is_presence_cmd(sub.GetCmd()) && 0 != sub.GetUid() {
+		code, err = ctx.presence_sub(head.GetSid(), sub.GetUid(), true)
+		if nil != err {
+			ctx.sub_failed(head, sub, code, err.Error())
+			ctx.log.Error("Sub presence failed! errmsg:%s", err.Error())
+			return -1
+		}
+	}
+
 	/* > 发送SUB应答 */
 	ctx.sub_ack(head, sub)
 
@@ -825,8 +1081,147 @@ func UsrSvrSubHandler(cmd uint32, nid uint32, data []byte, length uint32, param
 	return 0
 }
 
+////////////////////////////////////////////////////////////////////////////////
 /* 取消订阅请求 */
+
+/******************************************************************************
+ **函数名称: unsub_parse
+ **功    能: 解析UNSUB请求
+ **输入参数:
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 协议头
+ **     unsub: UNSUB请求
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) unsub_parse(data []byte) (
+	head *comm.MesgHeader, unsub *mesg.MesgUnsub, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Unsub header is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	unsub = &mesg.MesgUnsub{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], unsub)
+	if nil != err {
+		ctx.log.Error("Unmarshal body of unsub failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	}
+
+	return head, unsub, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: unsub_ack
+ **功    能: 发送UNSUB-ACK应答
+ **输入参数:
+ **     head: 协议头
+ **     unsub: UNSUB请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 cmd = 1;        // M|取消订阅的数据|数字|
+ **     required uint32 code = 2;       // M|错误码|数字|
+ **     required string errmsg = 3;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) unsub_ack(head *comm.MesgHeader,
+	unsub *mesg.MesgUnsub, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgUnsubAck{
+		Cmd:    proto.Uint32(unsub.GetCmd()),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_UNSUB_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_UNSUB_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrUnsubHandler
+ **功    能: UNSUB请求的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **注意事项: 目前仅处理ONLINE-NTF/OFFLINE-NTF的取消订阅
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
 func UsrSvrUnsubHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析UNSUB请求 */
+	head, unsub, code, err := ctx.unsub_parse(data)
+	if nil != err {
+		ctx.unsub_ack(head, unsub, code, err.Error())
+		ctx.log.Error("Unsub parse failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 取消订阅上下线通知 */
+	if is_presence_cmd(unsub.GetCmd()) && 0 != unsub.GetUid() {
+		code, err := ctx.presence_sub(head.GetSid(), unsub.GetUid(), false)
+		if nil != err {
+			ctx.unsub_ack(head, unsub, code, err.Error())
+			ctx.log.Error("Unsub presence failed! errmsg:%s", err.Error())
+			return -1
+		}
+	}
+
+	/* > 发送UNSUB应答 */
+	ctx.unsub_ack(head, unsub, 0, "Ok")
+
 	return 0
 }
 
@@ -902,3 +1297,75 @@ func (ctx *UsrSvrCntx) send_data(cmd uint32, sid uint64, cid uint64, nid uint32,
 	/* > 发送协议包 */
 	return ctx.frwder.AsyncSend(cmd, p.Buff, uint32(len(p.Buff)))
 }
+
+/******************************************************************************
+ **函数名称: send_to_uid
+ **功    能: 下发消息给指定用户的所有终端
+ **输入参数:
+ **     cmd: 命令类型
+ **     uid: 用户UID
+ **     seq: 序列号
+ **     data: 下发数据
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: 下发的终端个数
+ **实现描述: 遍历UID对应的会话SID集合, 并逐一下发消息(见send_to_uid_except)
+ **注意事项: 用户不在线时, 不下发消息
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) send_to_uid(cmd uint32, uid uint64, seq uint64, data []byte, length uint32) int {
+	return ctx.send_to_uid_except(cmd, uid, 0, seq, data, length)
+}
+
+/******************************************************************************
+ **函数名称: send_to_uid_except
+ **功    能: 下发消息给指定用户除指定会话外的所有终端
+ **输入参数:
+ **     cmd: 命令类型
+ **     uid: 用户UID
+ **     except: 不需要下发的会话SID(0:表示下发给所有终端)
+ **     seq: 序列号
+ **     data: 下发数据
+ **     length: 数据长度
+ **输出参数: NONE
+ **返    回: 下发的终端个数
+ **实现描述: 遍历UID对应的会话SID集合, 并逐一下发消息
+ **注意事项: 常用于将某终端的操作同步给该用户的其他终端
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) send_to_uid_except(cmd uint32,
+	uid uint64, except uint64, seq uint64, data []byte, length uint32) int {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+
+	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
+	if nil != err {
+		ctx.log.Error("Get sid set by uid [%d] failed! errmsg:%s", uid, err.Error())
+		return 0
+	}
+
+	total := 0
+	num := len(sid_list)
+	for idx := 0; idx < num; idx += 1 {
+		sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
+		if 0 != except && uint64(sid) == except {
+			continue
+		}
+
+		attr, _ := im.GetSidAttr(ctx.redis, uint64(sid))
+		if nil == attr {
+			continue
+		} else if 0 == attr.GetNid() || attr.GetUid() != uid {
+			ctx.log.Error("Session attr is invalid! uid:%d sid:%d cid:%d nid:%d",
+				uid, sid, attr.GetCid(), attr.GetNid())
+			continue
+		}
+
+		ctx.send_data(cmd, uint64(sid), attr.GetCid(), attr.GetNid(), seq, data, length)
+		total += 1
+	}
+
+	return total
+}
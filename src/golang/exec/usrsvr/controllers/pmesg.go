@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -148,58 +149,66 @@ func (ctx *UsrSvrCntx) friendAddAck(head *comm.MesgHeader, req *mesg.MesgFriendA
  **输出参数: NONE
  **返    回: 错误码+错误描述
  **实现描述:
- **     1. 将消息放入UID离线队列
- **     2. 判断接收方是否在线.
+ **     1. 校验双方是否已是好友, 以及申请人是否在对方的黑名单中
+ **     2. 将好友申请记录到缓存和数据库中(接收方不在线时, 待其上线后再下发)
+ **     3. 判断接收方是否在线.
  **        > 如果在线, 则直接下发消息
  **        > 如果不在线, 则无需下发消息
- **注意事项:
+ **注意事项: 好友关系需等待接收方回复(FRIEND-REPLY)同意后才建立
  **作    者: # Qifeng.zou # 2017.06.07 22:07:00 #
  ******************************************************************************/
 func (ctx *UsrSvrCntx) friendAddHandler(head *comm.MesgHeader,
 	req *mesg.MesgFriendAdd, data []byte) (code uint32, err error) {
-	var key string
-
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
-	/* > 将消息放入UID离线队列 */
+	if req.GetSuid() == req.GetDuid() {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Can't add yourself as friend!")
+	}
 
-	/* > 发送给"接收方"所有终端.
-	        1.如果在线, 则直接下发消息
-		    2.如果不在线, 则无需下发消息 */
-	key = fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, req.GetDuid())
+	/* > 校验请求合法性 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, req.GetSuid())
 
-	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
-	if nil != err {
-		ctx.log.Error("Get sid set by uid [%d] failed!", req.GetDuid())
-		return comm.ERR_SYS_DB, err
+	_, err = redis.Int64(rds.Do("ZSCORE", key, req.GetDuid()))
+	if nil == err {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Already friend!")
+	} else if redis.ErrNil != err {
+		ctx.log.Error("Check friend failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
 	}
 
-	num := len(sid_list)
-	for idx := 0; idx < num; idx += 1 {
-		sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_BLACKLIST_TAB, req.GetDuid())
 
-		attr, _ := im.GetSidAttr(ctx.redis, uint64(sid))
-		if nil == attr {
-			continue
-		} else if 0 == attr.GetNid() {
-			ctx.log.Error("Nid is invalid! uid:%d sid:%d cid:%d nid:%d!",
-				req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
-			continue
-		} else if uint64(attr.GetUid()) != req.GetDuid() {
-			ctx.log.Error("uid:%d sid:%d cid:%d nid:%d!",
-				req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
-			continue
-		}
+	ok, err := redis.Bool(rds.Do("HEXISTS", key, req.GetSuid()))
+	if nil != err {
+		ctx.log.Error("Check blacklist failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if ok {
+		return comm.ERR_SVR_IN_BLACKLIST, errors.New("In blacklist of the user!")
+	}
 
-		ctx.log.Debug("uid:%d sid:%d cid:%d nid:%d!",
-			req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
+	/* > 记录好友申请 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_REQ_TAB, req.GetDuid())
 
-		ctx.send_data(comm.CMD_FRIEND_ADD,
-			uint64(sid), attr.GetCid(), uint32(attr.GetNid()),
-			head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+	_, err = rds.Do("HSET", key, req.GetSuid(), req.GetMark())
+	if nil != err {
+		ctx.log.Error("Save friend request failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	err = models.DbFriendReqAdd(ctx.mongo, ctx.conf.Mongo.DbName,
+		req.GetDuid(), req.GetSuid(), req.GetMark())
+	if nil != err {
+		ctx.log.Error("Save friend request failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
 	}
 
+	/* > 发送给"接收方"所有终端.
+	        1.如果在线, 则直接下发消息
+		    2.如果不在线, 则待其上线后再下发 */
+	ctx.send_to_uid(comm.CMD_FRIEND_ADD, req.GetDuid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
 	return 0, nil
 }
 
@@ -215,9 +224,9 @@ func (ctx *UsrSvrCntx) friendAddHandler(head *comm.MesgHeader,
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述:
- **     1. 首先将FRIEND-ADD消息放入接收方离线队列.
+ **     1. 首先将FRIEND-ADD消息记录到接收方的好友申请列表.
  **     2. 如果接收方当前在线, 则直接下发FRIEND-ADD消息;
- **        如果接收方当前"不"在线, 则"不"下发FRIEND-ADD消息.
+ **        如果接收方当前"不"在线, 则待其上线后再下发FRIEND-ADD消息.
  **     3. 给发送方下发FRIEND-ADD应答消息.
  **注意事项:
  **作    者: # Qifeng.zou # 2017.06.07 21:49:36 #
@@ -244,6 +253,20 @@ func UsrSvrFriendAddHandler(cmd uint32, orig uint32,
 
 	ctx.log.Debug("Uid [%d] send friend-add to uid [%d]!", req.GetSuid(), req.GetDuid())
 
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.friendAddFailed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if attr.GetUid() != req.GetSuid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetSuid())
+		ctx.friendAddFailed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
+		return -1
+	}
+
 	/* > 进行业务处理 */
 	code, err = ctx.friendAddHandler(head, req, data)
 	if nil != err {
@@ -389,58 +412,58 @@ func (ctx *UsrSvrCntx) friendDelAck(head *comm.MesgHeader, req *mesg.MesgFriendD
  **输出参数: NONE
  **返    回: 错误码+错误描述
  **实现描述:
- **     1. 将消息放入UID离线队列
- **     2. 判断接收方是否在线.
- **        > 如果在线, 则直接下发消息
- **        > 如果不在线, 则无需下发消息
+ **     1. 从自己的好友列表中移除对方
+ **     2. 双向删除时, 同时从对方的好友列表中移除自己, 并通知对方
+ **     3. 同步给自己的其他终端
  **注意事项:
  **作    者: # Qifeng.zou # 2017.06.07 22:07:00 #
  ******************************************************************************/
 func (ctx *UsrSvrCntx) friendDelHandler(head *comm.MesgHeader,
 	req *mesg.MesgFriendDel, data []byte) (code uint32, err error) {
-	var key string
-
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
-	/* > 将消息放入UID离线队列 */
+	/* > 从自己的好友列表中移除 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, req.GetSuid())
 
-	/* > 发送给"接收方"所有终端.
-	        1.如果在线, 则直接下发消息
-		    2.如果不在线, 则无需下发消息 */
-	key = fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, req.GetDuid())
+	_, err = rds.Do("ZREM", key, req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Delete friend failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
 
-	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
+	err = models.DbFriendDel(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
 	if nil != err {
-		ctx.log.Error("Get sid set by uid [%d] failed!", req.GetDuid())
+		ctx.log.Error("Delete friend failed! errmsg:%s", err.Error())
 		return comm.ERR_SYS_DB, err
 	}
 
-	num := len(sid_list)
-	for idx := 0; idx < num; idx += 1 {
-		sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
+	/* > 从对方的好友列表中移除 */
+	if 0 != req.GetMutual() {
+		key = fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, req.GetDuid())
 
-		attr, _ := im.GetSidAttr(ctx.redis, uint64(sid))
-		if nil == attr {
-			continue
-		} else if 0 == attr.GetNid() {
-			ctx.log.Error("Nid is invalid! uid:%d sid:%d cid:%d nid:%d!",
-				req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
-			continue
-		} else if uint64(attr.GetUid()) != req.GetDuid() {
-			ctx.log.Error("uid:%d sid:%d cid:%d nid:%d!",
-				req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
-			continue
+		_, err = rds.Do("ZREM", key, req.GetSuid())
+		if nil != err {
+			ctx.log.Error("Delete friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_SYSTEM, err
 		}
 
-		ctx.log.Debug("uid:%d sid:%d cid:%d nid:%d!",
-			req.GetDuid(), sid, attr.GetCid(), attr.GetNid())
+		err = models.DbFriendDel(ctx.mongo,
+			ctx.conf.Mongo.DbName, req.GetDuid(), req.GetSuid())
+		if nil != err {
+			ctx.log.Error("Delete friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_DB, err
+		}
 
-		ctx.send_data(comm.CMD_FRIEND_DEL,
-			uint64(sid), attr.GetCid(), uint32(attr.GetNid()),
+		ctx.send_to_uid(comm.CMD_FRIEND_DEL, req.GetDuid(),
 			head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
 	}
 
+	/* > 同步给自己的其他终端 */
+	ctx.send_to_uid_except(comm.CMD_FRIEND_DEL, req.GetSuid(), head.GetSid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
 	return 0, nil
 }
 
@@ -456,9 +479,8 @@ func (ctx *UsrSvrCntx) friendDelHandler(head *comm.MesgHeader,
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述:
- **     1. 首先将FRIEND-DEL消息放入接收方离线队列.
- **     2. 如果接收方当前在线, 则直接下发FRIEND-ADD消息;
- **        如果接收方当前"不"在线, 则"不"下发FRIEND-ADD消息.
+ **     1. 从发送方的好友列表中移除接收方(双向删除时同时从接收方的好友列表中移除).
+ **     2. 双向删除时, 如果接收方当前在线, 则直接下发FRIEND-DEL消息.
  **     3. 给发送方下发FRIEND-DEL应答消息.
  **注意事项:
  **作    者: # Qifeng.zou # 2017.06.07 21:49:36 #
@@ -485,6 +507,20 @@ func UsrSvrFriendDelHandler(cmd uint32, orig uint32,
 
 	ctx.log.Debug("Uid [%d] send friend-del to uid [%d]!", req.GetSuid(), req.GetDuid())
 
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.friendDelFailed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if attr.GetUid() != req.GetSuid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetSuid())
+		ctx.friendDelFailed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
+		return -1
+	}
+
 	/* > 进行业务处理 */
 	code, err = ctx.friendDelHandler(head, req, data)
 	if nil != err {
@@ -499,244 +535,887 @@ func UsrSvrFriendDelHandler(cmd uint32, orig uint32,
 }
 
 ////////////////////////////////////////////////////////////////////////////////
-/* 加入黑名单 */
+/* 好友申请回复 */
 
 /******************************************************************************
- **函数名称: blacklistAddParse
- **功    能: 解析BLACKLIST-ADD请求
+ **函数名称: friendReplyParse
+ **功    能: 解析FRIEND-REPLY消息
  **输入参数:
- **     data: 原始数据
+ **     data: 接收的数据
  **输出参数: NONE
  **返    回:
- **     head: 协议头
- **     req: 请求内容
+ **     head: 通用协议头
+ **     req: 协议体内容
  **     code: 错误码
  **     err: 错误描述
- **实现描述:
+ **实现描述: 1.对通用头进行字节序转换 2.解析PB协议体
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 10:06:14 #
+ **作    者: # agent # 2026.10.18 06:33:39 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklistAddParse(data []byte) (
-	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd, code uint32, err error) {
+func (ctx *UsrSvrCntx) friendReplyParse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgFriendReply, code uint32, err error) {
 	/* > 字节序转换 */
 	head = comm.MesgHeadNtoh(data)
 	if !head.IsValid(1) {
-		errmsg := "Header of blacklist-add is invalid!"
 		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
 			head.GetCmd(), head.GetNid())
-		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of friend-reply is invalid")
 	}
 
 	/* > 解析PB协议 */
+	req = &mesg.MesgFriendReply{}
+
 	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
 	if nil != err {
-		ctx.log.Error("Unmarshal body of blacklist-add failed! errmsg:%s", err.Error())
+		ctx.log.Error("Unmarshal body failed! errmsg:%s", err.Error())
 		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetSuid() || 0 == req.GetDuid() {
+		ctx.log.Error("Paramter isn't right! orig:%d dest:%d", req.GetSuid(), req.GetDuid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
 	}
 
-	return head, req, 0, nil
-}
-
-/******************************************************************************
- **函数名称: blacklistAddHandler
- **功    能: 进行BLACKLIST-ADD处理
- **输入参数:
- **     head: 协议头
- **     req: 请求内容
- **输出参数: NONE
- **返    回:
- **     code: 错误码
- **     err: 错误描述
- **实现描述: 将信息同步到数据库和缓存中...
- **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 10:30:04 #
- ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklistAddHandler(
-	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd) (code uint32, err error) {
-	/* > 加入用户黑名单(缓存) */
-	code, err = models.RdsBlacklistAdd(ctx.redis, req.GetSuid(), req.GetDuid())
-	if nil != err {
-		ctx.log.Error("Add into blacklist failed! errmsg:%s", err.Error())
-		return code, err
-	}
-
-	/* > 加入用户黑名单(数据库MONGODB) */
-	err = models.DbBlacklistAdd(ctx.mongo,
-		ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
-	if nil != err {
-		return comm.ERR_SYS_SYSTEM, err
-	}
-
-	return 0, nil
+	return head, req, comm.OK, nil
 }
 
 /******************************************************************************
- **函数名称: blacklistAddFailed
- **功    能: 发送BLACKLIST-ADD应答
+ **函数名称: friendReplyFailed
+ **功    能: 发送FRIEND-REPLY应答(异常)
  **输入参数:
  **     head: 协议头
- **     req: BLACKLIST-ADD请求
+ **     req: FRIEND-REPLY请求
  **     code: 错误码
  **     errmsg: 错误描述
  **输出参数: NONE
  **返    回: VOID
  **实现描述:
  **应答协议:
- ** {
- **     required uint32 code = 1;       // M|错误码|数字|
- **     required string errmsg = 2;     // M|错误描述|字串|
- ** }
+ **     {
+ **         required uint32 code = 1; // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
  **注意事项:
- **作    者: # Qifeng.zou # 2016.11.01 18:37:59 #
+ **作    者: # agent # 2026.10.18 06:33:39 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklistAddFailed(head *comm.MesgHeader,
-	req *mesg.MesgBlacklistAdd, code uint32, errmsg string) int {
+func (ctx *UsrSvrCntx) friendReplyFailed(head *comm.MesgHeader,
+	req *mesg.MesgFriendReply, code uint32, errmsg string) int {
 	if nil == head {
 		return -1
 	}
 
 	/* > 设置协议体 */
-	ack := &mesg.MesgOnlineAck{
+	ack := &mesg.MesgFriendReplyAck{
 		Code:   proto.Uint32(code),
 		Errmsg: proto.String(errmsg),
 	}
 
-	/* 生成PB数据 */
+	/* > 生成PB数据 */
 	body, err := proto.Marshal(ack)
 	if nil != err {
 		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
 		return -1
 	}
 
-	length := len(body)
-
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
-
-	head.Cmd = comm.CMD_BLACKLIST_ADD_ACK
-	head.Length = uint32(length)
-
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
-
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_ADD_ACK, p.Buff, uint32(len(p.Buff)))
-
-	return 0
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_FRIEND_REPLY_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
 /******************************************************************************
- **函数名称: blacklistAddAck
- **功    能: 发送BLACKLIST-ADD应答
+ **函数名称: friendReplyAck
+ **功    能: 发送FRIEND-REPLY应答
  **输入参数:
  **     head: 协议头
- **     req: BLACKLIST-ADD请求
- **     code: 错误码
- **     errmsg: 错误描述
+ **     req: FRIEND-REPLY请求
  **输出参数: NONE
- **返    回: VOID
+ **返    回: 0:成功 !0:失败
  **实现描述:
  **应答协议:
- ** {
- **     required uint32 code = 1;       // M|错误码|数字|
- **     required string errmsg = 2;     // M|错误描述|字串|
- ** }
+ **     {
+ **         required uint32 code = 1; // M|错误码|数字|
+ **         required string errmsg = 2; // M|错误描述|字串|
+ **     }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 10:40:03 #
+ **作    者: # agent # 2026.10.18 06:33:39 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklistAddAck(
-	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd) int {
+func (ctx *UsrSvrCntx) friendReplyAck(head *comm.MesgHeader, req *mesg.MesgFriendReply) int {
 	/* > 设置协议体 */
-	ack := &mesg.MesgBlacklistAddAck{
+	ack := &mesg.MesgFriendReplyAck{
 		Code:   proto.Uint32(0),
 		Errmsg: proto.String("Ok"),
 	}
 
-	/* 生成PB数据 */
+	/* > 生成PB数据 */
 	body, err := proto.Marshal(ack)
 	if nil != err {
 		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
 		return -1
 	}
 
-	length := len(body)
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_FRIEND_REPLY_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
 
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+/******************************************************************************
+ **函数名称: friendReplyHandler
+ **功    能: FRIEND-REPLY处理
+ **输入参数:
+ **     head: 协议头
+ **     req: FRIEND-REPLY请求
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回: 错误码+错误描述
+ **实现描述:
+ **     1. 校验对应的好友申请是否存在, 并将其移除
+ **     2. 同意时, 将双方加入彼此的好友列表
+ **     3. 将回复下发给申请人, 并同步给回复者的其他终端
+ **注意事项: 请求中的suid为回复者, duid为申请人
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendReplyHandler(head *comm.MesgHeader,
+	req *mesg.MesgFriendReply, data []byte) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
 
-	head.Cmd = comm.CMD_BLACKLIST_ADD_ACK
-	head.Length = uint32(length)
+	/* > 校验好友申请是否存在 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_REQ_TAB, req.GetSuid())
 
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+	ok, err := redis.Bool(rds.Do("HEXISTS", key, req.GetDuid()))
+	if nil != err {
+		ctx.log.Error("Check friend request failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	} else if !ok {
+		return comm.ERR_SVR_INVALID_PARAM, errors.New("Friend request isn't exist!")
+	}
 
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_ADD_ACK, p.Buff, uint32(len(p.Buff)))
+	/* > 移除好友申请 */
+	_, err = rds.Do("HDEL", key, req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Delete friend request failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
 
-	return 0
+	err = models.DbFriendReqDel(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Delete friend request failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
+	}
+
+	/* > 同意时建立好友关系 */
+	if 0 != req.GetPass() {
+		ctm := time.Now().Unix()
+
+		key = fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, req.GetSuid())
+		_, err = rds.Do("ZADD", key, ctm, req.GetDuid())
+		if nil != err {
+			ctx.log.Error("Add friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_SYSTEM, err
+		}
+
+		key = fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, req.GetDuid())
+		_, err = rds.Do("ZADD", key, ctm, req.GetSuid())
+		if nil != err {
+			ctx.log.Error("Add friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_SYSTEM, err
+		}
+
+		err = models.DbFriendAdd(ctx.mongo,
+			ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
+		if nil != err {
+			ctx.log.Error("Save friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_DB, err
+		}
+
+		err = models.DbFriendAdd(ctx.mongo,
+			ctx.conf.Mongo.DbName, req.GetDuid(), req.GetSuid())
+		if nil != err {
+			ctx.log.Error("Save friend failed! errmsg:%s", err.Error())
+			return comm.ERR_SYS_DB, err
+		}
+	}
+
+	/* > 下发给申请人 */
+	ctx.send_to_uid(comm.CMD_FRIEND_REPLY, req.GetDuid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
+	/* > 同步给回复者的其他终端 */
+	ctx.send_to_uid_except(comm.CMD_FRIEND_REPLY, req.GetSuid(), head.GetSid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
+	return 0, nil
 }
 
 /******************************************************************************
- **函数名称: UsrSvrBlacklistAddHandler
- **功    能: 加入黑名单
+ **函数名称: UsrSvrFriendReplyHandler
+ **功    能: FRIEND-REPLY消息的处理
  **输入参数:
  **     cmd: 消息类型
- **     nid: 结点ID
+ **     orig: 帧听层ID
  **     data: 收到数据
  **     length: 数据长度
- **     param: 附加参数
+ **     param: 附加参
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述:
+ **请求协议:
+ **     {
+ **         required uint64 suid = 1; // M|回复者ID|数字|
+ **         required uint64 duid = 2; // M|申请人ID|数字|
+ **         required uint32 pass = 3; // M|是否同意|数字|(0:拒绝 1:同意)
+ **     }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 09:49:10 #
+ **作    者: # agent # 2026.10.18 06:33:39 #
  ******************************************************************************/
-func UsrSvrBlacklistAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+func UsrSvrFriendReplyHandler(cmd uint32, orig uint32,
+	data []byte, length uint32, param interface{}) int {
 	ctx, ok := param.(*UsrSvrCntx)
 	if !ok {
 		return -1
 	}
 
-	/* > 解析BLACKLIST-ADD请求 */
-	head, req, code, err := ctx.blacklistAddParse(data)
-	if nil != err {
-		ctx.log.Error("Parse blacklist-add failed! code:%d errmsg:%s", code, err.Error())
-		ctx.blacklistAddFailed(head, req, code, err.Error())
+	ctx.log.Debug("Recv friend reply request!")
+
+	/* > 解析FRIEND-REPLY协议 */
+	head, req, code, err := ctx.friendReplyParse(data)
+	if nil == head {
+		ctx.log.Error("Parse friend reply failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil == req {
+		ctx.log.Error("Parse friend reply failed! errmsg:%s", err.Error())
+		ctx.friendReplyFailed(head, req, code, err.Error())
 		return -1
 	}
 
+	ctx.log.Debug("Uid [%d] reply friend-add of uid [%d]! pass:%d",
+		req.GetSuid(), req.GetDuid(), req.GetPass())
+
 	/* > 验证请求合法性 */
 	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
 	if nil != err {
 		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
-		ctx.blacklistAddFailed(head, req, code, err.Error())
+		ctx.friendReplyFailed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
 		return -1
-	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+	} else if attr.GetUid() != req.GetSuid() {
 		errmsg := "Uid is collision!"
-		ctx.log.Error("errmsg:%s", errmsg)
-		ctx.blacklistAddFailed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetSuid())
+		ctx.friendReplyFailed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
 		return -1
 	}
 
-	/* > 进行BLACKLIST-ADD处理 */
-	code, err = ctx.blacklistAddHandler(head, req)
+	/* > 进行业务处理 */
+	code, err = ctx.friendReplyHandler(head, req, data)
 	if nil != err {
-		ctx.log.Error("Handle blacklist-add failed! code:%d errmsg:%s", code, err.Error())
-		ctx.blacklistAddFailed(head, req, code, err.Error())
+		ctx.log.Error("Handle friend reply failed! errmsg:%s", err.Error())
+		ctx.friendReplyFailed(head, req, code, err.Error())
 		return -1
 	}
 
-	/* > 发送BLACKLIST-ADD应答 */
-	ctx.blacklistAddAck(head, req)
+	ctx.friendReplyAck(head, req)
 
 	return 0
 }
 
-////////////////////////////////////////////////////////////////////////////////
-/* 移除黑名单 */
-
+/******************************************************************************
+ **函数名称: friendReqResend
+ **功    能: 重新下发待处理的好友申请
+ **输入参数:
+ **     uid: 用户UID
+ **     sid: 会话SID
+ **     cid: 连接CID
+ **     nid: 结点ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 用户上线后, 将其离线期间收到的好友申请下发给该会话
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendReqResend(uid uint64, sid uint64, cid uint64, nid uint32) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_REQ_TAB, uid)
+
+	reqs, err := redis.StringMap(rds.Do("HGETALL", key))
+	if nil != err {
+		ctx.log.Error("Get friend request failed! uid:%d errmsg:%s", uid, err.Error())
+		return
+	}
+
+	for suid, mark := range reqs {
+		id, _ := strconv.ParseInt(suid, 10, 64)
+		if 0 == id {
+			continue
+		}
+
+		req := &mesg.MesgFriendAdd{
+			Suid: proto.Uint64(uint64(id)),
+			Duid: proto.Uint64(uid),
+			Mark: proto.String(mark),
+		}
+
+		body, err := proto.Marshal(req)
+		if nil != err {
+			ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+			continue
+		}
+
+		ctx.send_data(comm.CMD_FRIEND_ADD, sid, cid, nid, 0, body, uint32(len(body)))
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 好友列表 */
+
+/* 好友信息 */
+type FriendItem struct {
+	Uid    uint64 `json:"uid"`    // 好友UID
+	Ctm    int64  `json:"ctm"`    // 成为好友的时间
+	Mark   string `json:"mark"`   // 备注名
+	Online bool   `json:"online"` // 是否在线
+}
+
+/******************************************************************************
+ **函数名称: friendList
+ **功    能: 分页获取好友列表
+ **输入参数:
+ **     uid: 用户UID
+ **     cursor: 分页游标(好友在列表中的偏移)
+ **     num: 获取数目(0:表示获取所有好友)
+ **输出参数: NONE
+ **返    回:
+ **     list: 好友列表
+ **     next: 下页游标(0:表示已无更多好友)
+ **     total: 好友总数
+ **     err: 错误描述
+ **实现描述:
+ **     1. 按成为好友的时间从好友列表中取出一页好友
+ **     2. 通过备注列表获取备注名
+ **     3. 通过用户UID集合判断好友是否在线(管道批量查询)
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendList(uid uint64, cursor uint64, num uint32) (
+	list []FriendItem, next uint64, total int, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 获取好友总数 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, uid)
+
+	total, err = redis.Int(rds.Do("ZCARD", key))
+	if nil != err {
+		ctx.log.Error("Get friend num failed! uid:%d errmsg:%s", uid, err.Error())
+		return nil, 0, 0, err
+	} else if uint64(total) <= cursor {
+		return nil, 0, total, nil
+	}
+
+	/* > 获取一页好友 */
+	stop := int64(-1)
+	if 0 != num {
+		stop = int64(cursor) + int64(num) - 1
+	}
+
+	vals, err := redis.Strings(rds.Do("ZRANGE", key, cursor, stop, "WITHSCORES"))
+	if nil != err {
+		ctx.log.Error("Get friend list failed! uid:%d errmsg:%s", uid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 获取备注列表 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_MARK_TAB, uid)
+
+	marks, err := redis.StringMap(rds.Do("HGETALL", key))
+	if nil != err {
+		ctx.log.Error("Get mark list failed! uid:%d errmsg:%s", uid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 批量获取在线状态(管道) */
+	num_vals := len(vals)
+	for idx := 0; idx+1 < num_vals; idx += 2 {
+		rds.Send("ZSCORE", comm.IM_KEY_UID_ZSET, vals[idx])
+	}
+
+	ttls, err := redis.Values(rds.Do(""))
+	if nil != err {
+		ctx.log.Error("Get online status failed! uid:%d errmsg:%s", uid, err.Error())
+		return nil, 0, total, err
+	}
+
+	/* > 生成好友列表 */
+	ctm := time.Now().Unix()
+
+	for idx := 0; idx+1 < num_vals; idx += 2 {
+		fuid, _ := strconv.ParseInt(vals[idx], 10, 64)
+		tm, _ := strconv.ParseInt(vals[idx+1], 10, 64)
+
+		ttl, _ := redis.Int64(ttls[idx/2], nil) /* 不在线时为nil */
+
+		item := FriendItem{
+			Uid:    uint64(fuid),
+			Ctm:    tm,
+			Mark:   marks[vals[idx]],
+			Online: ttl >= ctm,
+		}
+
+		list = append(list, item)
+	}
+
+	next = cursor + uint64(len(list))
+	if next >= uint64(total) {
+		next = 0
+	}
+
+	return list, next, total, nil
+}
+
+/******************************************************************************
+ **函数名称: friendListParse
+ **功    能: 解析FRIEND-LIST消息
+ **输入参数:
+ **     data: 接收的数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 通用协议头
+ **     req: 协议体内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 1.对通用头进行字节序转换 2.解析PB协议体
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendListParse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgFriendList, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New("Header of friend-list is invalid")
+	}
+
+	/* > 解析PB协议 */
+	req = &mesg.MesgFriendList{}
+
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal body failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetUid() {
+		ctx.log.Error("Paramter isn't right! uid:%d", req.GetUid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
+	}
+
+	return head, req, comm.OK, nil
+}
+
+/******************************************************************************
+ **函数名称: friendListFailed
+ **功    能: 发送FRIEND-LIST应答(异常)
+ **输入参数:
+ **     head: 协议头
+ **     req: FRIEND-LIST请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendListFailed(head *comm.MesgHeader,
+	req *mesg.MesgFriendList, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgFriendListAck{
+		Uid:    proto.Uint64(req.GetUid()),
+		List:   proto.String("[]"),
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_FRIEND_LIST_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: friendListAck
+ **功    能: 发送FRIEND-LIST应答
+ **输入参数:
+ **     head: 协议头
+ **     req: FRIEND-LIST请求
+ **     list: 好友列表
+ **     next: 下页游标
+ **     total: 好友总数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **应答协议:
+ **     {
+ **         required uint64 uid = 1;    // M|用户ID|数字|
+ **         required string list = 2;   // M|好友列表|字串|JSON
+ **         optional uint64 next = 3;   // O|下页游标|数字|
+ **         optional uint32 total = 4;  // O|好友总数|数字|
+ **         optional uint32 code = 5;   // O|错误码|数字|
+ **         optional string errmsg = 6; // O|错误描述|字串|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) friendListAck(head *comm.MesgHeader,
+	req *mesg.MesgFriendList, list []FriendItem, next uint64, total int) int {
+	if nil == list {
+		list = make([]FriendItem, 0)
+	}
+
+	data, err := json.Marshal(list)
+	if nil != err {
+		ctx.log.Error("Marshal json failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgFriendListAck{
+		Uid:    proto.Uint64(req.GetUid()),
+		List:   proto.String(string(data)),
+		Next:   proto.Uint64(next),
+		Total:  proto.Uint32(uint32(total)),
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* > 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	/* > 发送应答数据 */
+	return ctx.send_data(comm.CMD_FRIEND_LIST_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrFriendListHandler
+ **功    能: FRIEND-LIST消息的处理
+ **输入参数:
+ **     cmd: 消息类型
+ **     orig: 帧听层ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **请求协议:
+ **     {
+ **         required uint64 uid = 1;    // M|用户ID|数字|
+ **         required uint32 num = 2;    // M|请求个数|数字|(0:表示获取所有好友)
+ **         optional uint64 cursor = 3; // O|分页游标|数字|
+ **     }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func UsrSvrFriendListHandler(cmd uint32, orig uint32,
+	data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	ctx.log.Debug("Recv friend list request!")
+
+	/* > 解析FRIEND-LIST协议 */
+	head, req, code, err := ctx.friendListParse(data)
+	if nil == head {
+		ctx.log.Error("Parse friend list failed! errmsg:%s", err.Error())
+		return -1
+	} else if nil == req {
+		ctx.log.Error("Parse friend list failed! errmsg:%s", err.Error())
+		ctx.friendListFailed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.friendListFailed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	} else if attr.GetUid() != req.GetUid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetUid())
+		ctx.friendListFailed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
+		return -1
+	}
+
+	/* > 获取好友列表 */
+	list, next, total, err := ctx.friendList(req.GetUid(), req.GetCursor(), req.GetNum())
+	if nil != err {
+		ctx.friendListFailed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
+		return -1
+	}
+
+	ctx.friendListAck(head, req, list, next, total)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 加入黑名单 */
+
+/******************************************************************************
+ **函数名称: blacklistAddParse
+ **功    能: 解析BLACKLIST-ADD请求
+ **输入参数:
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 协议头
+ **     req: 请求内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 10:06:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklistAddParse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of blacklist-add is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal body of blacklist-add failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: blacklistAddHandler
+ **功    能: 进行BLACKLIST-ADD处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 请求内容
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 将信息同步到数据库和缓存中...
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 10:30:04 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklistAddHandler(
+	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd) (code uint32, err error) {
+	/* > 加入用户黑名单(缓存) */
+	code, err = models.RdsBlacklistAdd(ctx.redis, req.GetSuid(), req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Add into blacklist failed! errmsg:%s", err.Error())
+		return code, err
+	}
+
+	/* > 加入用户黑名单(数据库MONGODB) */
+	err = models.DbBlacklistAdd(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: blacklistAddFailed
+ **功    能: 发送BLACKLIST-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: BLACKLIST-ADD请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.01 18:37:59 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklistAddFailed(head *comm.MesgHeader,
+	req *mesg.MesgBlacklistAdd, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgOnlineAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_BLACKLIST_ADD_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_ADD_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: blacklistAddAck
+ **功    能: 发送BLACKLIST-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: BLACKLIST-ADD请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 10:40:03 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklistAddAck(
+	head *comm.MesgHeader, req *mesg.MesgBlacklistAdd) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgBlacklistAddAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_BLACKLIST_ADD_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_ADD_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrBlacklistAddHandler
+ **功    能: 加入黑名单
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 09:49:10 #
+ ******************************************************************************/
+func UsrSvrBlacklistAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析BLACKLIST-ADD请求 */
+	head, req, code, err := ctx.blacklistAddParse(data)
+	if nil != err {
+		ctx.log.Error("Parse blacklist-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.blacklistAddFailed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.blacklistAddFailed(head, req, code, err.Error())
+		return -1
+	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s", errmsg)
+		ctx.blacklistAddFailed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		return -1
+	}
+
+	/* > 进行BLACKLIST-ADD处理 */
+	code, err = ctx.blacklistAddHandler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle blacklist-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.blacklistAddFailed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 发送BLACKLIST-ADD应答 */
+	ctx.blacklistAddAck(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 移除黑名单 */
+
 /******************************************************************************
  **函数名称: blacklist_del_parse
  **功    能: 解析BLACKLIST-DEL请求
@@ -752,12 +1431,482 @@ func UsrSvrBlacklistAddHandler(cmd uint32, nid uint32, data []byte, length uint3
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.19 11:03:54 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklist_del_parse(data []byte) (
-	head *comm.MesgHeader, req *mesg.MesgBlacklistDel, code uint32, err error) {
+func (ctx *UsrSvrCntx) blacklist_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgBlacklistDel, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of blacklist-del is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal body of blacklist-add failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: blacklist_del_handler
+ **功    能: 进行BLACKLIST-DEL处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 请求内容
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:04:31 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklist_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgBlacklistDel) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 移除用户黑名单 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_BLACKLIST_TAB, req.GetSuid())
+
+	_, err = rds.Do("HDEL", key, req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Remove blacklist failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: blacklist_del_failed
+ **功    能: 发送BLACKLIST-DEL应答
+ **输入参数:
+ **     head: 协议头
+ **     req: BLACKLIST-DEL请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.01 11:05:32 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklist_del_failed(head *comm.MesgHeader,
+	req *mesg.MesgBlacklistDel, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgBlacklistDelAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_BLACKLIST_DEL_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_DEL_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: blacklist_del_ack
+ **功    能: 发送BLACKLIST-DEL应答
+ **输入参数:
+ **     head: 协议头
+ **     req: BLACKLIST-DEL请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:07:08 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) blacklist_del_ack(
+	head *comm.MesgHeader, req *mesg.MesgBlacklistDel) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgBlacklistDelAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_BLACKLIST_DEL_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_DEL_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrBlacklistDelHandler
+ **功    能: 移除黑名单
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:07:54 #
+ ******************************************************************************/
+func UsrSvrBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析BLACKLIST-DEL请求 */
+	head, req, code, err := ctx.blacklist_del_parse(data)
+	if nil != err {
+		ctx.log.Error("Parse blacklist-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.blacklist_del_failed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.blacklist_del_failed(head, req, code, err.Error())
+		return -1
+	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s", errmsg)
+		ctx.blacklist_del_failed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		return -1
+	}
+
+	/* > 进行BLACKLIST-DEL处理 */
+	code, err = ctx.blacklist_del_handler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle blacklist-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.blacklist_del_failed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 发送BLACKLIST-DEL应答 */
+	ctx.blacklist_del_ack(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 设置禁言 */
+
+/******************************************************************************
+ **函数名称: gagAddParse
+ **功    能: 解析GAG-ADD请求
+ **输入参数:
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 协议头
+ **     req: 请求内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:03:54 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) gagAddParse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGagAdd, code uint32, err error) {
+	/* > 字节序转换 */
+	head = comm.MesgHeadNtoh(data)
+	if !head.IsValid(1) {
+		errmsg := "Header of gag-add is invalid!"
+		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
+			head.GetCmd(), head.GetNid())
+		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
+	}
+
+	/* > 解析PB协议 */
+	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
+	if nil != err {
+		ctx.log.Error("Unmarshal body of gag-add failed! errmsg:%s", err.Error())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	}
+
+	return head, req, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: gagAddHandler
+ **功    能: 进行GAG-ADD处理
+ **输入参数:
+ **     head: 协议头
+ **     req: 请求内容
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:04:31 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) gagAddHandler(
+	head *comm.MesgHeader, req *mesg.MesgGagAdd) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	/* > 加入禁言列表 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_GAG_ZSET, req.GetSuid())
+
+	_, err = rds.Do("ZADD", key, ctm, req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Add into gag-list failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return 0, nil
+}
+
+/******************************************************************************
+ **函数名称: gagAddFailed
+ **功    能: 发送GAG-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GAG-ADD请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.01 11:05:32 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) gagAddFailed(head *comm.MesgHeader,
+	req *mesg.MesgGagAdd, code uint32, errmsg string) int {
+	if nil == head {
+		return -1
+	}
+
+	/* > 设置协议体 */
+	ack := &mesg.MesgGagAddAck{
+		Code:   proto.Uint32(code),
+		Errmsg: proto.String(errmsg),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_GAG_ADD_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: gagAddAck
+ **功    能: 发送GAG-ADD应答
+ **输入参数:
+ **     head: 协议头
+ **     req: GAG-ADD请求
+ **     code: 错误码
+ **     errmsg: 错误描述
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **应答协议:
+ ** {
+ **     required uint32 code = 1;       // M|错误码|数字|
+ **     required string errmsg = 2;     // M|错误描述|字串|
+ ** }
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:07:08 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) gagAddAck(
+	head *comm.MesgHeader, req *mesg.MesgGagAdd) int {
+	/* > 设置协议体 */
+	ack := &mesg.MesgGagDelAck{
+		Code:   proto.Uint32(0),
+		Errmsg: proto.String("Ok"),
+	}
+
+	/* 生成PB数据 */
+	body, err := proto.Marshal(ack)
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return -1
+	}
+
+	length := len(body)
+
+	/* > 拼接协议包 */
+	p := &comm.MesgPacket{}
+	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
+
+	head.Cmd = comm.CMD_GAG_ADD_ACK
+	head.Length = uint32(length)
+
+	comm.MesgHeadHton(head, p)
+	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
+
+	/* > 发送协议包 */
+	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
+
+	return 0
+}
+
+/******************************************************************************
+ **函数名称: UsrSvrGagAddHandler
+ **功    能: 设置禁言
+ **输入参数:
+ **     cmd: 消息类型
+ **     nid: 结点ID
+ **     data: 收到数据
+ **     length: 数据长度
+ **     param: 附加参数
+ **输出参数: NONE
+ **返    回: 0:成功 !0:失败
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:07:54 #
+ ******************************************************************************/
+func UsrSvrGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+	ctx, ok := param.(*UsrSvrCntx)
+	if !ok {
+		return -1
+	}
+
+	/* > 解析GAG-ADD请求 */
+	head, req, code, err := ctx.gagAddParse(data)
+	if nil != err {
+		ctx.log.Error("Parse gag-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.gagAddFailed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 验证请求合法性 */
+	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
+	if nil != err {
+		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
+		ctx.gagAddFailed(head, req, code, err.Error())
+		return -1
+	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+		errmsg := "Uid is collision!"
+		ctx.log.Error("errmsg:%s", errmsg)
+		ctx.gagAddFailed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		return -1
+	}
+
+	/* > 进行GAG-ADD处理 */
+	code, err = ctx.gagAddHandler(head, req)
+	if nil != err {
+		ctx.log.Error("Handle gag-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.gagAddFailed(head, req, code, err.Error())
+		return -1
+	}
+
+	/* > 发送GAG-ADD应答 */
+	ctx.gagAddAck(head, req)
+
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 解除禁言 */
+
+/******************************************************************************
+ **函数名称: gag_del_parse
+ **功    能: 解析GAG-ADD请求
+ **输入参数:
+ **     data: 原始数据
+ **输出参数: NONE
+ **返    回:
+ **     head: 协议头
+ **     req: 请求内容
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # Qifeng.zou # 2017.01.19 11:03:54 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) gag_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgGagDel, code uint32, err error) {
 	/* > 字节序转换 */
 	head = comm.MesgHeadNtoh(data)
 	if !head.IsValid(1) {
-		errmsg := "Header of blacklist-del is invalid!"
+		errmsg := "Header of gag-del is invalid!"
 		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
 			head.GetCmd(), head.GetNid())
 		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
@@ -766,7 +1915,7 @@ func (ctx *UsrSvrCntx) blacklist_del_parse(data []byte) (
 	/* > 解析PB协议 */
 	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
 	if nil != err {
-		ctx.log.Error("Unmarshal body of blacklist-add failed! errmsg:%s", err.Error())
+		ctx.log.Error("Unmarshal body of gag-del failed! errmsg:%s", err.Error())
 		return head, nil, comm.ERR_SVR_BODY_INVALID, err
 	}
 
@@ -774,8 +1923,8 @@ func (ctx *UsrSvrCntx) blacklist_del_parse(data []byte) (
 }
 
 /******************************************************************************
- **函数名称: blacklist_del_handler
- **功    能: 进行BLACKLIST-DEL处理
+ **函数名称: gag_del_handler
+ **功    能: 进行GAG-ADD处理
  **输入参数:
  **     head: 协议头
  **     req: 请求内容
@@ -787,17 +1936,17 @@ func (ctx *UsrSvrCntx) blacklist_del_parse(data []byte) (
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.19 11:04:31 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklist_del_handler(
-	head *comm.MesgHeader, req *mesg.MesgBlacklistDel) (code uint32, err error) {
+func (ctx *UsrSvrCntx) gag_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgGagDel) (code uint32, err error) {
 	rds := ctx.redis.Get()
 	defer rds.Close()
 
 	/* > 移除用户黑名单 */
-	key := fmt.Sprintf(comm.CHAT_KEY_USR_BLACKLIST_TAB, req.GetSuid())
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_GAG_ZSET, req.GetSuid())
 
-	_, err = rds.Do("HDEL", key, req.GetDuid())
+	_, err = rds.Do("ZREM", key, req.GetDuid())
 	if nil != err {
-		ctx.log.Error("Remove blacklist failed! errmsg:%s", err.Error())
+		ctx.log.Error("Remove gag failed! errmsg:%s", err.Error())
 		return comm.ERR_SYS_SYSTEM, err
 	}
 
@@ -805,11 +1954,11 @@ func (ctx *UsrSvrCntx) blacklist_del_handler(
 }
 
 /******************************************************************************
- **函数名称: blacklist_del_failed
- **功    能: 发送BLACKLIST-DEL应答
+ **函数名称: gag_del_failed
+ **功    能: 发送GAG-ADD应答
  **输入参数:
  **     head: 协议头
- **     req: BLACKLIST-DEL请求
+ **     req: GAG-ADD请求
  **     code: 错误码
  **     errmsg: 错误描述
  **输出参数: NONE
@@ -823,14 +1972,14 @@ func (ctx *UsrSvrCntx) blacklist_del_handler(
  **注意事项:
  **作    者: # Qifeng.zou # 2016.11.01 11:05:32 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklist_del_failed(head *comm.MesgHeader,
-	req *mesg.MesgBlacklistDel, code uint32, errmsg string) int {
+func (ctx *UsrSvrCntx) gag_del_failed(head *comm.MesgHeader,
+	req *mesg.MesgGagDel, code uint32, errmsg string) int {
 	if nil == head {
 		return -1
 	}
 
 	/* > 设置协议体 */
-	ack := &mesg.MesgBlacklistDelAck{
+	ack := &mesg.MesgGagDelAck{
 		Code:   proto.Uint32(code),
 		Errmsg: proto.String(errmsg),
 	}
@@ -848,24 +1997,24 @@ func (ctx *UsrSvrCntx) blacklist_del_failed(head *comm.MesgHeader,
 	p := &comm.MesgPacket{}
 	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
 
-	head.Cmd = comm.CMD_BLACKLIST_DEL_ACK
+	head.Cmd = comm.CMD_GAG_ADD_ACK
 	head.Length = uint32(length)
 
 	comm.MesgHeadHton(head, p)
 	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
 
 	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_DEL_ACK, p.Buff, uint32(len(p.Buff)))
+	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
 
 	return 0
 }
 
 /******************************************************************************
- **函数名称: blacklist_del_ack
- **功    能: 发送BLACKLIST-DEL应答
+ **函数名称: gag_del_ack
+ **功    能: 发送GAG-ADD应答
  **输入参数:
  **     head: 协议头
- **     req: BLACKLIST-DEL请求
+ **     req: GAG-ADD请求
  **     code: 错误码
  **     errmsg: 错误描述
  **输出参数: NONE
@@ -879,10 +2028,10 @@ func (ctx *UsrSvrCntx) blacklist_del_failed(head *comm.MesgHeader,
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.19 11:07:08 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) blacklist_del_ack(
-	head *comm.MesgHeader, req *mesg.MesgBlacklistDel) int {
+func (ctx *UsrSvrCntx) gag_del_ack(
+	head *comm.MesgHeader, req *mesg.MesgGagDel) int {
 	/* > 设置协议体 */
-	ack := &mesg.MesgBlacklistDelAck{
+	ack := &mesg.MesgGagDelAck{
 		Code:   proto.Uint32(0),
 		Errmsg: proto.String("Ok"),
 	}
@@ -900,21 +2049,21 @@ func (ctx *UsrSvrCntx) blacklist_del_ack(
 	p := &comm.MesgPacket{}
 	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
 
-	head.Cmd = comm.CMD_BLACKLIST_DEL_ACK
+	head.Cmd = comm.CMD_GAG_ADD_ACK
 	head.Length = uint32(length)
 
 	comm.MesgHeadHton(head, p)
 	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
 
 	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_BLACKLIST_DEL_ACK, p.Buff, uint32(len(p.Buff)))
+	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
 
 	return 0
 }
 
 /******************************************************************************
- **函数名称: UsrSvrBlacklistDelHandler
- **功    能: 移除黑名单
+ **函数名称: UsrSvrGagDelHandler
+ **功    能: 解除禁言
  **输入参数:
  **     cmd: 消息类型
  **     nid: 结点ID
@@ -927,17 +2076,17 @@ func (ctx *UsrSvrCntx) blacklist_del_ack(
  **注意事项:
  **作    者: # Qifeng.zou # 2017.01.19 11:07:54 #
  ******************************************************************************/
-func UsrSvrBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+func UsrSvrGagDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
 	ctx, ok := param.(*UsrSvrCntx)
 	if !ok {
 		return -1
 	}
 
-	/* > 解析BLACKLIST-DEL请求 */
-	head, req, code, err := ctx.blacklist_del_parse(data)
+	/* > 解析GAG-ADD请求 */
+	head, req, code, err := ctx.gag_del_parse(data)
 	if nil != err {
-		ctx.log.Error("Parse blacklist-del failed! code:%d errmsg:%s", code, err.Error())
-		ctx.blacklist_del_failed(head, req, code, err.Error())
+		ctx.log.Error("Parse gag-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.gag_del_failed(head, req, code, err.Error())
 		return -1
 	}
 
@@ -945,35 +2094,35 @@ func UsrSvrBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint3
 	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
 	if nil != err {
 		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
-		ctx.blacklist_del_failed(head, req, code, err.Error())
+		ctx.gag_del_failed(head, req, code, err.Error())
 		return -1
 	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
 		errmsg := "Uid is collision!"
 		ctx.log.Error("errmsg:%s", errmsg)
-		ctx.blacklist_del_failed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		ctx.gag_del_failed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
 		return -1
 	}
 
-	/* > 进行BLACKLIST-DEL处理 */
-	code, err = ctx.blacklist_del_handler(head, req)
+	/* > 进行GAG-ADD处理 */
+	code, err = ctx.gag_del_handler(head, req)
 	if nil != err {
-		ctx.log.Error("Handle blacklist-del failed! code:%d errmsg:%s", code, err.Error())
-		ctx.blacklist_del_failed(head, req, code, err.Error())
+		ctx.log.Error("Handle gag-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.gag_del_failed(head, req, code, err.Error())
 		return -1
 	}
 
-	/* > 发送BLACKLIST-DEL应答 */
-	ctx.blacklist_del_ack(head, req)
+	/* > 发送GAG-ADD应答 */
+	ctx.gag_del_ack(head, req)
 
 	return 0
 }
 
 ////////////////////////////////////////////////////////////////////////////////
-/* 设置禁言 */
+/* 设置备注 */
 
 /******************************************************************************
- **函数名称: gagAddParse
- **功    能: 解析GAG-ADD请求
+ **函数名称: mark_add_parse
+ **功    能: 解析MARK-ADD请求
  **输入参数:
  **     data: 原始数据
  **输出参数: NONE
@@ -984,68 +2133,89 @@ func UsrSvrBlacklistDelHandler(cmd uint32, nid uint32, data []byte, length uint3
  **     err: 错误描述
  **实现描述:
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:03:54 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gagAddParse(data []byte) (
-	head *comm.MesgHeader, req *mesg.MesgGagAdd, code uint32, err error) {
+func (ctx *UsrSvrCntx) mark_add_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgMarkAdd, code uint32, err error) {
 	/* > 字节序转换 */
 	head = comm.MesgHeadNtoh(data)
 	if !head.IsValid(1) {
-		errmsg := "Header of gag-add is invalid!"
+		errmsg := "Header of mark-add is invalid!"
 		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
 			head.GetCmd(), head.GetNid())
 		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
 	}
 
 	/* > 解析PB协议 */
+	req = &mesg.MesgMarkAdd{}
 	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
 	if nil != err {
-		ctx.log.Error("Unmarshal body of gag-add failed! errmsg:%s", err.Error())
+		ctx.log.Error("Unmarshal body of mark-add failed! errmsg:%s", err.Error())
 		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetSuid() || 0 == req.GetDuid() {
+		ctx.log.Error("Paramter isn't right! suid:%d duid:%d", req.GetSuid(), req.GetDuid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
 	}
 
 	return head, req, 0, nil
 }
 
 /******************************************************************************
- **函数名称: gagAddHandler
- **功    能: 进行GAG-ADD处理
+ **函数名称: mark_add_handler
+ **功    能: 进行MARK-ADD处理
  **输入参数:
  **     head: 协议头
  **     req: 请求内容
+ **     data: 原始数据
  **输出参数: NONE
  **返    回:
  **     code: 错误码
  **     err: 错误描述
  **实现描述:
- **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:04:31 #
+ **     1. 将备注同步到缓存和数据库中
+ **     2. 将请求同步给该用户的其他终端
+ **注意事项: 备注名优先取name字段; 旧版客户端只填写mark字段(数字), 此时将其转为字串.
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gagAddHandler(
-	head *comm.MesgHeader, req *mesg.MesgGagAdd) (code uint32, err error) {
-	rds := ctx.redis.Get()
-	defer rds.Close()
+func (ctx *UsrSvrCntx) mark_add_handler(
+	head *comm.MesgHeader, req *mesg.MesgMarkAdd, data []byte) (code uint32, err error) {
+	name := req.GetName()
+	if "" == name && 0 != req.GetMark() {
+		name = strconv.FormatUint(req.GetMark(), 10) /* 兼容旧版客户端 */
+	}
 
-	ctm := time.Now().Unix()
+	if "" == name {
+		return comm.ERR_SVR_BODY_INVALID, errors.New("Mark is empty!")
+	}
 
-	/* > 加入禁言列表 */
-	key := fmt.Sprintf(comm.CHAT_KEY_USR_GAG_ZSET, req.GetSuid())
+	/* > 设置备注(缓存) */
+	code, err = models.RdsMarkAdd(ctx.redis, req.GetSuid(), req.GetDuid(), name)
+	if nil != err {
+		ctx.log.Error("Add mark failed! errmsg:%s", err.Error())
+		return code, err
+	}
 
-	_, err = rds.Do("ZADD", key, ctm, req.GetDuid())
+	/* > 设置备注(数据库MONGODB) */
+	err = models.DbMarkAdd(ctx.mongo, ctx.conf.Mongo.DbName,
+		req.GetSuid(), req.GetDuid(), name)
 	if nil != err {
-		ctx.log.Error("Add into gag-list failed! errmsg:%s", err.Error())
-		return comm.ERR_SYS_SYSTEM, err
+		ctx.log.Error("Save mark failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
 	}
 
+	/* > 同步给该用户的其他终端 */
+	ctx.send_to_uid_except(comm.CMD_MARK_ADD, req.GetSuid(), head.GetSid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
 	return 0, nil
 }
 
 /******************************************************************************
- **函数名称: gagAddFailed
- **功    能: 发送GAG-ADD应答
+ **函数名称: mark_add_failed
+ **功    能: 发送MARK-ADD应答(异常)
  **输入参数:
  **     head: 协议头
- **     req: GAG-ADD请求
+ **     req: MARK-ADD请求
  **     code: 错误码
  **     errmsg: 错误描述
  **输出参数: NONE
@@ -1057,16 +2227,16 @@ func (ctx *UsrSvrCntx) gagAddHandler(
  **     required string errmsg = 2;     // M|错误描述|字串|
  ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2016.11.01 11:05:32 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gagAddFailed(head *comm.MesgHeader,
-	req *mesg.MesgGagAdd, code uint32, errmsg string) int {
+func (ctx *UsrSvrCntx) mark_add_failed(head *comm.MesgHeader,
+	req *mesg.MesgMarkAdd, code uint32, errmsg string) int {
 	if nil == head {
 		return -1
 	}
 
 	/* > 设置协议体 */
-	ack := &mesg.MesgGagAddAck{
+	ack := &mesg.MesgMarkAddAck{
 		Code:   proto.Uint32(code),
 		Errmsg: proto.String(errmsg),
 	}
@@ -1078,32 +2248,16 @@ func (ctx *UsrSvrCntx) gagAddFailed(head *comm.MesgHeader,
 		return -1
 	}
 
-	length := len(body)
-
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
-
-	head.Cmd = comm.CMD_GAG_ADD_ACK
-	head.Length = uint32(length)
-
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
-
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
-
-	return 0
+	return ctx.send_data(comm.CMD_MARK_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
 /******************************************************************************
- **函数名称: gagAddAck
- **功    能: 发送GAG-ADD应答
+ **函数名称: mark_add_ack
+ **功    能: 发送MARK-ADD应答
  **输入参数:
  **     head: 协议头
- **     req: GAG-ADD请求
- **     code: 错误码
- **     errmsg: 错误描述
+ **     req: MARK-ADD请求
  **输出参数: NONE
  **返    回: VOID
  **实现描述:
@@ -1113,12 +2267,11 @@ func (ctx *UsrSvrCntx) gagAddFailed(head *comm.MesgHeader,
  **     required string errmsg = 2;     // M|错误描述|字串|
  ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:07:08 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gagAddAck(
-	head *comm.MesgHeader, req *mesg.MesgGagAdd) int {
+func (ctx *UsrSvrCntx) mark_add_ack(head *comm.MesgHeader, req *mesg.MesgMarkAdd) int {
 	/* > 设置协议体 */
-	ack := &mesg.MesgGagDelAck{
+	ack := &mesg.MesgMarkAddAck{
 		Code:   proto.Uint32(0),
 		Errmsg: proto.String("Ok"),
 	}
@@ -1130,27 +2283,13 @@ func (ctx *UsrSvrCntx) gagAddAck(
 		return -1
 	}
 
-	length := len(body)
-
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
-
-	head.Cmd = comm.CMD_GAG_ADD_ACK
-	head.Length = uint32(length)
-
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
-
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
-
-	return 0
+	return ctx.send_data(comm.CMD_MARK_ADD_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
 /******************************************************************************
- **函数名称: UsrSvrGagAddHandler
- **功    能: 设置禁言
+ **函数名称: UsrSvrMarkAddHandler
+ **功    能: 设置备注
  **输入参数:
  **     cmd: 消息类型
  **     nid: 结点ID
@@ -1160,20 +2299,27 @@ func (ctx *UsrSvrCntx) gagAddAck(
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述:
+ **请求协议:
+ ** {
+ **     required uint64 suid = 1;       // M|源用户ID|数字|
+ **     required uint64 duid = 2;       // M|目标用户ID|数字|
+ **     optional uint64 mark = 3;       // O|备注名|数字|(已废弃)
+ **     optional string name = 4;       // O|备注名|字串|
+ ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:07:54 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func UsrSvrGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+func UsrSvrMarkAddHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
 	ctx, ok := param.(*UsrSvrCntx)
 	if !ok {
 		return -1
 	}
 
-	/* > 解析GAG-ADD请求 */
-	head, req, code, err := ctx.gagAddParse(data)
+	/* > 解析MARK-ADD请求 */
+	head, req, code, err := ctx.mark_add_parse(data)
 	if nil != err {
-		ctx.log.Error("Parse gag-add failed! code:%d errmsg:%s", code, err.Error())
-		ctx.gagAddFailed(head, req, code, err.Error())
+		ctx.log.Error("Parse mark-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.mark_add_failed(head, req, code, err.Error())
 		return -1
 	}
 
@@ -1181,35 +2327,36 @@ func UsrSvrGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, par
 	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
 	if nil != err {
 		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
-		ctx.gagAddFailed(head, req, code, err.Error())
+		ctx.mark_add_failed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
 		return -1
-	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+	} else if attr.GetUid() != req.GetSuid() {
 		errmsg := "Uid is collision!"
-		ctx.log.Error("errmsg:%s", errmsg)
-		ctx.gagAddFailed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetSuid())
+		ctx.mark_add_failed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
 		return -1
 	}
 
-	/* > 进行GAG-ADD处理 */
-	code, err = ctx.gagAddHandler(head, req)
+	/* > 进行MARK-ADD处理 */
+	code, err = ctx.mark_add_handler(head, req, data)
 	if nil != err {
-		ctx.log.Error("Handle gag-add failed! code:%d errmsg:%s", code, err.Error())
-		ctx.gagAddFailed(head, req, code, err.Error())
+		ctx.log.Error("Handle mark-add failed! code:%d errmsg:%s", code, err.Error())
+		ctx.mark_add_failed(head, req, code, err.Error())
 		return -1
 	}
 
-	/* > 发送GAG-ADD应答 */
-	ctx.gagAddAck(head, req)
+	/* > 发送MARK-ADD应答 */
+	ctx.mark_add_ack(head, req)
 
 	return 0
 }
 
 ////////////////////////////////////////////////////////////////////////////////
-/* 解除禁言 */
+/* 移除备注 */
 
 /******************************************************************************
- **函数名称: gag_del_parse
- **功    能: 解析GAG-ADD请求
+ **函数名称: mark_del_parse
+ **功    能: 解析MARK-DEL请求
  **输入参数:
  **     data: 原始数据
  **输出参数: NONE
@@ -1220,66 +2367,80 @@ func UsrSvrGagAddHandler(cmd uint32, nid uint32, data []byte, length uint32, par
  **     err: 错误描述
  **实现描述:
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:03:54 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gag_del_parse(data []byte) (
-	head *comm.MesgHeader, req *mesg.MesgGagDel, code uint32, err error) {
+func (ctx *UsrSvrCntx) mark_del_parse(data []byte) (
+	head *comm.MesgHeader, req *mesg.MesgMarkDel, code uint32, err error) {
 	/* > 字节序转换 */
 	head = comm.MesgHeadNtoh(data)
 	if !head.IsValid(1) {
-		errmsg := "Header of gag-del is invalid!"
+		errmsg := "Header of mark-del is invalid!"
 		ctx.log.Error("Header is invalid! cmd:0x%04X nid:%d",
 			head.GetCmd(), head.GetNid())
 		return nil, nil, comm.ERR_SVR_HEAD_INVALID, errors.New(errmsg)
 	}
 
 	/* > 解析PB协议 */
+	req = &mesg.MesgMarkDel{}
 	err = proto.Unmarshal(data[comm.MESG_HEAD_SIZE:], req)
 	if nil != err {
-		ctx.log.Error("Unmarshal body of gag-del failed! errmsg:%s", err.Error())
+		ctx.log.Error("Unmarshal body of mark-del failed! errmsg:%s", err.Error())
 		return head, nil, comm.ERR_SVR_BODY_INVALID, err
+	} else if 0 == req.GetSuid() || 0 == req.GetDuid() {
+		ctx.log.Error("Paramter isn't right! suid:%d duid:%d", req.GetSuid(), req.GetDuid())
+		return head, nil, comm.ERR_SVR_BODY_INVALID, errors.New("Paramter isn't right!")
 	}
 
 	return head, req, 0, nil
 }
 
 /******************************************************************************
- **函数名称: gag_del_handler
- **功    能: 进行GAG-ADD处理
+ **函数名称: mark_del_handler
+ **功    能: 进行MARK-DEL处理
  **输入参数:
  **     head: 协议头
  **     req: 请求内容
+ **     data: 原始数据
  **输出参数: NONE
  **返    回:
  **     code: 错误码
  **     err: 错误描述
  **实现描述:
+ **     1. 将备注从缓存和数据库中移除
+ **     2. 将请求同步给该用户的其他终端
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:04:31 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gag_del_handler(
-	head *comm.MesgHeader, req *mesg.MesgGagDel) (code uint32, err error) {
-	rds := ctx.redis.Get()
-	defer rds.Close()
-
-	/* > 移除用户黑名单 */
-	key := fmt.Sprintf(comm.CHAT_KEY_USR_GAG_ZSET, req.GetSuid())
+func (ctx *UsrSvrCntx) mark_del_handler(
+	head *comm.MesgHeader, req *mesg.MesgMarkDel, data []byte) (code uint32, err error) {
+	/* > 移除备注(缓存) */
+	code, err = models.RdsMarkDel(ctx.redis, req.GetSuid(), req.GetDuid())
+	if nil != err {
+		ctx.log.Error("Delete mark failed! errmsg:%s", err.Error())
+		return code, err
+	}
 
-	_, err = rds.Do("ZREM", key, req.GetDuid())
+	/* > 移除备注(数据库MONGODB) */
+	err = models.DbMarkDel(ctx.mongo,
+		ctx.conf.Mongo.DbName, req.GetSuid(), req.GetDuid())
 	if nil != err {
-		ctx.log.Error("Remove gag failed! errmsg:%s", err.Error())
-		return comm.ERR_SYS_SYSTEM, err
+		ctx.log.Error("Delete mark failed! errmsg:%s", err.Error())
+		return comm.ERR_SYS_DB, err
 	}
 
+	/* > 同步给该用户的其他终端 */
+	ctx.send_to_uid_except(comm.CMD_MARK_DEL, req.GetSuid(), head.GetSid(),
+		head.GetSeq(), data[comm.MESG_HEAD_SIZE:], head.GetLength())
+
 	return 0, nil
 }
 
 /******************************************************************************
- **函数名称: gag_del_failed
- **功    能: 发送GAG-ADD应答
+ **函数名称: mark_del_failed
+ **功    能: 发送MARK-DEL应答(异常)
  **输入参数:
  **     head: 协议头
- **     req: GAG-ADD请求
+ **     req: MARK-DEL请求
  **     code: 错误码
  **     errmsg: 错误描述
  **输出参数: NONE
@@ -1291,16 +2452,16 @@ func (ctx *UsrSvrCntx) gag_del_handler(
  **     required string errmsg = 2;     // M|错误描述|字串|
  ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2016.11.01 11:05:32 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gag_del_failed(head *comm.MesgHeader,
-	req *mesg.MesgGagDel, code uint32, errmsg string) int {
+func (ctx *UsrSvrCntx) mark_del_failed(head *comm.MesgHeader,
+	req *mesg.MesgMarkDel, code uint32, errmsg string) int {
 	if nil == head {
 		return -1
 	}
 
 	/* > 设置协议体 */
-	ack := &mesg.MesgGagDelAck{
+	ack := &mesg.MesgMarkDelAck{
 		Code:   proto.Uint32(code),
 		Errmsg: proto.String(errmsg),
 	}
@@ -1312,32 +2473,16 @@ func (ctx *UsrSvrCntx) gag_del_failed(head *comm.MesgHeader,
 		return -1
 	}
 
-	length := len(body)
-
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
-
-	head.Cmd = comm.CMD_GAG_ADD_ACK
-	head.Length = uint32(length)
-
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
-
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
-
-	return 0
+	return ctx.send_data(comm.CMD_MARK_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
 /******************************************************************************
- **函数名称: gag_del_ack
- **功    能: 发送GAG-ADD应答
+ **函数名称: mark_del_ack
+ **功    能: 发送MARK-DEL应答
  **输入参数:
  **     head: 协议头
- **     req: GAG-ADD请求
- **     code: 错误码
- **     errmsg: 错误描述
+ **     req: MARK-DEL请求
  **输出参数: NONE
  **返    回: VOID
  **实现描述:
@@ -1347,12 +2492,11 @@ func (ctx *UsrSvrCntx) gag_del_failed(head *comm.MesgHeader,
  **     required string errmsg = 2;     // M|错误描述|字串|
  ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:07:08 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func (ctx *UsrSvrCntx) gag_del_ack(
-	head *comm.MesgHeader, req *mesg.MesgGagDel) int {
+func (ctx *UsrSvrCntx) mark_del_ack(head *comm.MesgHeader, req *mesg.MesgMarkDel) int {
 	/* > 设置协议体 */
-	ack := &mesg.MesgGagDelAck{
+	ack := &mesg.MesgMarkDelAck{
 		Code:   proto.Uint32(0),
 		Errmsg: proto.String("Ok"),
 	}
@@ -1364,27 +2508,13 @@ func (ctx *UsrSvrCntx) gag_del_ack(
 		return -1
 	}
 
-	length := len(body)
-
-	/* > 拼接协议包 */
-	p := &comm.MesgPacket{}
-	p.Buff = make([]byte, comm.MESG_HEAD_SIZE+length)
-
-	head.Cmd = comm.CMD_GAG_ADD_ACK
-	head.Length = uint32(length)
-
-	comm.MesgHeadHton(head, p)
-	copy(p.Buff[comm.MESG_HEAD_SIZE:], body)
-
-	/* > 发送协议包 */
-	ctx.frwder.AsyncSend(comm.CMD_GAG_ADD_ACK, p.Buff, uint32(len(p.Buff)))
-
-	return 0
+	return ctx.send_data(comm.CMD_MARK_DEL_ACK, head.GetSid(),
+		head.GetCid(), head.GetNid(), head.GetSeq(), body, uint32(len(body)))
 }
 
 /******************************************************************************
- **函数名称: UsrSvrGagDelHandler
- **功    能: 解除禁言
+ **函数名称: UsrSvrMarkDelHandler
+ **功    能: 移除备注
  **输入参数:
  **     cmd: 消息类型
  **     nid: 结点ID
@@ -1394,20 +2524,25 @@ func (ctx *UsrSvrCntx) gag_del_ack(
  **输出参数: NONE
  **返    回: 0:成功 !0:失败
  **实现描述:
+ **请求协议:
+ ** {
+ **     required uint64 suid = 1;       // M|源用户ID|数字|
+ **     required uint64 duid = 2;       // M|目标用户ID|数字|
+ ** }
  **注意事项:
- **作    者: # Qifeng.zou # 2017.01.19 11:07:54 #
+ **作    者: # agent # 2026.10.18 06:30:14 #
  ******************************************************************************/
-func UsrSvrGagDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
+func UsrSvrMarkDelHandler(cmd uint32, nid uint32, data []byte, length uint32, param interface{}) int {
 	ctx, ok := param.(*UsrSvrCntx)
 	if !ok {
 		return -1
 	}
 
-	/* > 解析GAG-ADD请求 */
-	head, req, code, err := ctx.gag_del_parse(data)
+	/* > 解析MARK-DEL请求 */
+	head, req, code, err := ctx.mark_del_parse(data)
 	if nil != err {
-		ctx.log.Error("Parse gag-del failed! code:%d errmsg:%s", code, err.Error())
-		ctx.gag_del_failed(head, req, code, err.Error())
+		ctx.log.Error("Parse mark-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.mark_del_failed(head, req, code, err.Error())
 		return -1
 	}
 
@@ -1415,25 +2550,26 @@ func UsrSvrGagDelHandler(cmd uint32, nid uint32, data []byte, length uint32, par
 	attr, err := im.GetSidAttr(ctx.redis, head.GetSid())
 	if nil != err {
 		ctx.log.Error("Get attr by sid failed! errmsg:%s", err.Error())
-		ctx.gag_del_failed(head, req, code, err.Error())
+		ctx.mark_del_failed(head, req, comm.ERR_SYS_SYSTEM, err.Error())
 		return -1
-	} else if 0 != attr.GetUid() && attr.GetUid() != req.GetSuid() {
+	} else if attr.GetUid() != req.GetSuid() {
 		errmsg := "Uid is collision!"
-		ctx.log.Error("errmsg:%s", errmsg)
-		ctx.gag_del_failed(head, req, comm.ERR_SYS_SYSTEM, errmsg)
+		ctx.log.Error("errmsg:%s sid:%d uid:%d/%d",
+			errmsg, head.GetSid(), attr.GetUid(), req.GetSuid())
+		ctx.mark_del_failed(head, req, comm.ERR_SVR_DATA_COLLISION, errmsg)
 		return -1
 	}
 
-	/* > 进行GAG-ADD处理 */
-	code, err = ctx.gag_del_handler(head, req)
+	/* > 进行MARK-DEL处理 */
+	code, err = ctx.mark_del_handler(head, req, data)
 	if nil != err {
-		ctx.log.Error("Handle gag-del failed! code:%d errmsg:%s", code, err.Error())
-		ctx.gag_del_failed(head, req, code, err.Error())
+		ctx.log.Error("Handle mark-del failed! code:%d errmsg:%s", code, err.Error())
+		ctx.mark_del_failed(head, req, code, err.Error())
 		return -1
 	}
 
-	/* > 发送GAG-ADD应答 */
-	ctx.gag_del_ack(head, req)
+	/* > 发送MARK-DEL应答 */
+	ctx.mark_del_ack(head, req)
 
 	return 0
 }
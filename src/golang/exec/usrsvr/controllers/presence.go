@@ -0,0 +1,462 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/golang/protobuf/proto"
+
+	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
+	"beehive-im/src/golang/lib/mesg"
+)
+
+const (
+	USRSVR_PRESENCE_BATCH_NUM = 1000 // 每次最多处理的待通知用户数
+	USRSVR_PRESENCE_QUERY_MAX = 500  // 单次批量查询在线状态的最大用户数
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// 上下线通知
+
+/******************************************************************************
+ **函数名称: presence_mark
+ **功    能: 标记用户在线状态可能发生变化
+ **输入参数:
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 将用户放入待通知集合, 防抖时长过后由定时任务判断是否需要通知.
+ **注意事项: 下线及会话超时时由im.CleanSessionData*标记, 此处只需在上线时调用.
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_mark(uid uint64) {
+	err := im.PresenceMark(ctx.redis, uid)
+	if nil != err {
+		ctx.log.Error("Mark presence failed! uid:%d errmsg:%s", uid, err.Error())
+	}
+}
+
+/******************************************************************************
+ **函数名称: presence_task
+ **功    能: 处理待通知上下线状态的用户
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 取出防抖时长已过的用户, 并通过ZREM抢占处理权(多个USRSVR并存时只有一个处理);
+ **     2. 判断用户当前是否在线, 并通过SADD/SREM判断与上次通知的状态是否一致;
+ **     3. 状态发生变化时, 通知其在线好友及订阅者.
+ **注意事项: 由task_presence协程周期调用, 避免通知量大时阻塞其他定时任务.
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_task() {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	uid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE",
+		comm.IM_KEY_PRESENCE_PENDING_ZSET, "-inf", ctm, "LIMIT", 0, USRSVR_PRESENCE_BATCH_NUM))
+	if nil != err {
+		ctx.log.Error("Get pending presence list failed! errmsg:%s", err.Error())
+		return
+	}
+
+	for _, str := range uid_list {
+		/* > 抢占处理权 */
+		num, err := redis.Int(rds.Do("ZREM", comm.IM_KEY_PRESENCE_PENDING_ZSET, str))
+		if nil != err || 0 == num {
+			continue
+		}
+
+		uid, _ := strconv.ParseUint(str, 10, 64)
+		if 0 == uid {
+			continue
+		}
+
+		/* > 判断在线状态是否变化 */
+		list, err := ctx.online_sess_list(uid, "", 0)
+		if nil != err {
+			ctx.log.Error("Get online session list failed! uid:%d errmsg:%s", uid, err.Error())
+			continue
+		}
+
+		if 0 != len(list) {
+			num, err = redis.Int(rds.Do("SADD", comm.IM_KEY_PRESENCE_ONLINE_SET, uid))
+			if nil == err && 1 == num {
+				ctx.presence_notify(comm.CMD_ONLINE_NTF, uid, ctm)
+			}
+			continue
+		}
+
+		num, err = redis.Int(rds.Do("SREM", comm.IM_KEY_PRESENCE_ONLINE_SET, uid))
+		if nil == err && 1 == num {
+			ctx.presence_notify(comm.CMD_OFFLINE_NTF, uid, ctm)
+		}
+	}
+}
+
+/******************************************************************************
+ **函数名称: task_presence
+ **功    能: 上下线通知协程
+ **输入参数: NONE
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 每秒处理一次待通知上下线状态的用户
+ **注意事项: 独立于task()运行, 通知耗时不影响侦听层字典等的更新.
+ **作    者: # agent # 2026.10.18 07:46:48 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) task_presence() {
+	for {
+		ctx.presence_task()
+
+		time.Sleep(time.Second)
+	}
+}
+
+/******************************************************************************
+ **函数名称: presence_notify
+ **功    能: 下发上下线通知
+ **输入参数:
+ **     cmd: 命令类型(CMD_ONLINE_NTF/CMD_OFFLINE_NTF)
+ **     uid: 状态发生变化的用户ID
+ **     ctm: 状态变化时间
+ **输出参数: NONE
+ **返    回: 下发的会话数
+ **实现描述: 下发给该用户的在线好友及订阅者, 同一会话只下发一次.
+ **通知协议:
+ ** {
+ **     required uint64 uid = 1;        // M|上线(下线)用户ID|数字|
+ **     required uint64 time = 2;       // M|上线(下线)时间|数字|
+ ** }
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_notify(cmd uint32, uid uint64, ctm int64) int {
+	var body []byte
+	var err error
+
+	/* > 生成PB数据 */
+	if comm.CMD_ONLINE_NTF == cmd {
+		body, err = proto.Marshal(&mesg.MesgOnlineNtf{
+			Uid:  proto.Uint64(uid),
+			Time: proto.Uint64(uint64(ctm)),
+		})
+	} else {
+		body, err = proto.Marshal(&mesg.MesgOfflineNtf{
+			Uid:  proto.Uint64(uid),
+			Time: proto.Uint64(uint64(ctm)),
+		})
+	}
+	if nil != err {
+		ctx.log.Error("Marshal protobuf failed! errmsg:%s", err.Error())
+		return 0
+	}
+
+	/* > 获取接收者会话列表 */
+	receivers := ctx.presence_receivers(uid)
+
+	total := 0
+	for sid, owner := range receivers {
+		attr, err := im.GetSidAttr(ctx.redis, sid)
+		if nil != err || 0 == attr.GetNid() {
+			continue
+		} else if 0 != owner && attr.GetUid() != owner {
+			continue
+		}
+
+		ctx.send_data(cmd, sid, attr.GetCid(), attr.GetNid(), 0, body, uint32(len(body)))
+		total += 1
+	}
+
+	ctx.log.Debug("Send presence notification! cmd:0x%04X uid:%d total:%d", cmd, uid, total)
+
+	return total
+}
+
+/******************************************************************************
+ **函数名称: presence_receivers
+ **功    能: 获取上下线通知的接收者会话列表
+ **输入参数:
+ **     uid: 状态发生变化的用户ID
+ **输出参数: NONE
+ **返    回: 会话列表(会话SID -> 会话所属UID, 订阅者为0表示不校验)
+ **实现描述:
+ **     1. 好友: 遍历好友列表, 获取各好友的会话SID集合;
+ **     2. 订阅者: 获取订阅了该用户上下线通知且未过期的会话, 并清理过期订阅.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_receivers(uid uint64) map[uint64]uint64 {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	receivers := make(map[uint64]uint64)
+
+	/* > 好友的会话 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, uid)
+
+	uid_list, err := redis.Strings(rds.Do("ZRANGE", key, 0, -1))
+	if nil != err {
+		ctx.log.Error("Get friend list failed! uid:%d errmsg:%s", uid, err.Error())
+	}
+
+	friends := make([]uint64, 0, len(uid_list))
+	for _, str := range uid_list {
+		fuid, _ := strconv.ParseUint(str, 10, 64)
+		if 0 == fuid {
+			continue
+		}
+		friends = append(friends, fuid)
+
+		key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, fuid)
+		rds.Send("SMEMBERS", key)
+	}
+
+	rds.Flush()
+
+	for _, fuid := range friends {
+		sid_list, err := redis.Strings(rds.Receive())
+		if nil != err {
+			continue
+		}
+
+		for _, sid := range sid_list {
+			_sid, _ := strconv.ParseUint(sid, 10, 64)
+			receivers[_sid] = fuid
+		}
+	}
+
+	/* > 订阅者的会话 */
+	ctm := time.Now().Unix()
+
+	key = fmt.Sprintf(comm.IM_KEY_PRESENCE_SUB_ZSET, uid)
+
+	rds.Do("ZREMRANGEBYSCORE", key, "-inf", ctm)
+
+	sid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, ctm, "+inf"))
+	if nil != err {
+		ctx.log.Error("Get presence subscriber failed! uid:%d errmsg:%s", uid, err.Error())
+		return receivers
+	}
+
+	for _, str := range sid_list {
+		sid, _ := strconv.ParseUint(str, 10, 64)
+		if _, ok := receivers[sid]; !ok {
+			receivers[sid] = 0
+		}
+	}
+
+	return receivers
+}
+
+/******************************************************************************
+ **函数名称: presence_sub
+ **功    能: 订阅(取消订阅)某用户的上下线通知
+ **输入参数:
+ **     sid: 订阅者会话SID
+ **     uid: 订阅对象UID
+ **     sub: true:订阅 false:取消订阅
+ **输出参数: NONE
+ **返    回:
+ **     code: 错误码
+ **     err: 错误描述
+ **实现描述: 订阅前校验订阅者是否在订阅对象的好友列表中(订阅自己除外).
+ **注意事项: 订阅有效期为一天, 过期后需重新订阅.
+ **作    者: # agent # 2026.10.18 06:56:14 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_sub(sid uint64, uid uint64, sub bool) (code uint32, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.IM_KEY_PRESENCE_SUB_ZSET, uid)
+	if !sub {
+		_, err := rds.Do("ZREM", key, sid)
+		if nil != err {
+			return comm.ERR_SYS_SYSTEM, err
+		}
+		return 0, nil
+	}
+
+	/* > 校验好友关系 */
+	attr, err := im.GetSidAttr(ctx.redis, sid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	} else if 0 == attr.GetUid() {
+		return comm.ERR_SVR_CHECK_FAIL, errors.New("Session is not online!")
+	} else if attr.GetUid() != uid {
+		key := fmt.Sprintf(comm.CHAT_KEY_USR_FRIEND_ZSET, uid)
+
+		_, err := redis.Int64(rds.Do("ZSCORE", key, attr.GetUid()))
+		if redis.ErrNil == err {
+			return comm.ERR_SVR_PERM_DENIED, errors.New("Only friend can subscribe presence!")
+		} else if nil != err {
+			return comm.ERR_SYS_SYSTEM, err
+		}
+	}
+
+	/* > 添加订阅 */
+	ttl := time.Now().Unix() + comm.TIME_DAY
+
+	_, err = rds.Do("ZADD", key, ttl, sid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return 0, nil
+}
+
+/* 判断是否为上下线通知命令 */
+func is_presence_cmd(cmd uint32) bool {
+	return comm.CMD_ONLINE_NTF == cmd || comm.CMD_OFFLINE_NTF == cmd
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 在线状态批量查询
+
+/* 在线会话详情 */
+type PresenceSessItem struct {
+	Sid      uint64 `json:"sid"`       // 会话SID
+	Nid      uint32 `json:"nid"`       // 侦听层ID
+	App      string `json:"app"`       // APP名
+	Version  string `json:"version"`   // APP版本
+	Terminal uint32 `json:"terminal"`  // 终端类型
+	OnlineTm int64  `json:"online-tm"` // 上线时间
+}
+
+/* 用户在线状态 */
+type PresenceItem struct {
+	Uid    uint64             `json:"uid"`    // 用户ID
+	Online bool               `json:"online"` // 是否在线
+	Len    int                `json:"len"`    // 在线会话数
+	List   []PresenceSessItem `json:"list"`   // 在线会话列表(按上线时间升序)
+}
+
+type PresenceSessList []PresenceSessItem
+
+func (list PresenceSessList) Len() int           { return len(list) }
+func (list PresenceSessList) Less(i, j int) bool { return list[i].OnlineTm < list[j].OnlineTm }
+func (list PresenceSessList) Swap(i, j int)      { list[i], list[j] = list[j], list[i] }
+
+/******************************************************************************
+ **函数名称: presence_query
+ **功    能: 批量查询用户在线状态
+ **输入参数:
+ **     uid_list: 用户ID列表
+ **输出参数: NONE
+ **返    回:
+ **     list: 在线状态列表(与uid_list一一对应)
+ **     err: 错误描述
+ **实现描述:
+ **     1. 通过PIPELINE批量获取各用户的有效期(IM_KEY_UID_ZSET)及会话SID集合;
+ **     2. 通过PIPELINE批量获取在线用户各会话的属性及有效期.
+ **注意事项:
+ **     1. 整个查询只需两次网络往返, 与用户数无关;
+ **     2. 已过期或已不属于该用户的会话将被忽略.
+ **作    者: # agent # 2026.10.18 06:57:25 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) presence_query(uid_list []uint64) (list []PresenceItem, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	/* > 批量获取用户有效期及会话列表 */
+	for _, uid := range uid_list {
+		key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+		rds.Send("ZSCORE", comm.IM_KEY_UID_ZSET, uid)
+		rds.Send("SMEMBERS", key)
+	}
+
+	rds.Flush()
+
+	list = make([]PresenceItem, len(uid_list))
+	owner := make([]int, 0) // 会话所属用户在list中的下标
+	sid_list := make([]uint64, 0)
+
+	for idx, uid := range uid_list {
+		list[idx].Uid = uid
+		list[idx].List = make([]PresenceSessItem, 0)
+
+		ttl, err := redis.Int64(rds.Receive())
+		if nil != err && redis.ErrNil != err {
+			return nil, err
+		}
+
+		sids, err := redis.Strings(rds.Receive())
+		if nil != err {
+			return nil, err
+		} else if ttl < ctm {
+			continue /* 用户已离线 */
+		}
+
+		for _, str := range sids {
+			sid, _ := strconv.ParseUint(str, 10, 64)
+			if 0 == sid {
+				continue
+			}
+			owner = append(owner, idx)
+			sid_list = append(sid_list, sid)
+		}
+	}
+
+	if 0 == len(sid_list) {
+		return list, nil
+	}
+
+	/* > 批量获取会话属性 */
+	for _, sid := range sid_list {
+		key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid)
+		rds.Send("HMGET", key, "UID", "NID", "APP", "VERSION", "TERMINAL", "ONLINE_TM")
+		rds.Send("ZSCORE", comm.IM_KEY_SID_ZSET, sid)
+	}
+
+	rds.Flush()
+
+	for idx, sid := range sid_list {
+		vals, err := redis.Strings(rds.Receive())
+		if nil != err {
+			return nil, err
+		}
+
+		ttl, err := redis.Int64(rds.Receive())
+		if redis.ErrNil == err {
+			continue
+		} else if nil != err {
+			return nil, err
+		}
+
+		item := &list[owner[idx]]
+
+		uid, _ := strconv.ParseUint(vals[0], 10, 64)
+		nid, _ := strconv.ParseUint(vals[1], 10, 32)
+		if uid != item.Uid || 0 == nid || ttl < ctm {
+			continue
+		}
+
+		terminal, _ := strconv.ParseUint(vals[4], 10, 32)
+		online, _ := strconv.ParseInt(vals[5], 10, 64)
+
+		item.List = append(item.List, PresenceSessItem{
+			Sid:      sid,
+			Nid:      uint32(nid),
+			App:      vals[2],
+			Version:  vals[3],
+			Terminal: uint32(terminal),
+			OnlineTm: online,
+		})
+	}
+
+	for idx := range list {
+		sort.Sort(PresenceSessList(list[idx].List))
+		list[idx].Len = len(list[idx].List)
+		list[idx].Online = (0 != list[idx].Len)
+	}
+
+	return list, nil
+}
@@ -3,12 +3,14 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
-	_ "github.com/garyburd/redigo/redis"
-	_ "github.com/golang/protobuf/proto"
+	"github.com/garyburd/redigo/redis"
 
 	"beehive-im/src/golang/lib/comm"
-	_ "beehive-im/src/golang/lib/mesg"
+	"beehive-im/src/golang/lib/im"
 )
 
 /* 推送接口 */
@@ -43,13 +45,648 @@ func (this *UsrSvrPushCtrl) Push() {
 	this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 推送公共方法
+
+/* 推送消息ID列表 */
+type PushMsgidList []uint64
+
+func (list PushMsgidList) Len() int           { return len(list) }
+func (list PushMsgidList) Less(i, j int) bool { return list[i] < list[j] }
+func (list PushMsgidList) Swap(i, j int)      { list[i], list[j] = list[j], list[i] }
+
+/******************************************************************************
+ **函数名称: push_alloc
+ **功    能: 申请推送消息ID并存储推送内容
+ **输入参数:
+ **     data: 透传数据
+ **     version: APP版本(空:不限版本)
+ **     expire: 过期时间(秒)
+ **输出参数: NONE
+ **返    回:
+ **     msgid: 推送消息ID
+ **     err: 错误信息
+ **实现描述: 当expire为0时, 不存储推送内容, 离线用户将不会收到此消息.
+ **注意事项: 推送内容在过期后由REDIS自动删除
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_alloc(data []byte, version string, expire uint32) (msgid uint64, err error) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 申请推送消息ID */
+	msgid, err = redis.Uint64(rds.Do("INCRBY", comm.CHAT_KEY_PUSH_MSGID_INCR, 1))
+	if nil != err {
+		ctx.log.Error("Alloc push msgid failed! errmsg:%s", err.Error())
+		return 0, err
+	} else if 0 == expire {
+		return msgid, nil
+	}
+
+	/* > 存储推送内容 */
+	key := fmt.Sprintf(comm.CHAT_KEY_PUSH_MESG, msgid)
+
+	rds.Send("MULTI")
+	rds.Send("HMSET", key, "DATA", data, "VERSION", version)
+	rds.Send("EXPIRE", key, expire)
+	_, err = rds.Do("EXEC")
+	if nil != err {
+		ctx.log.Error("Store push message failed! msgid:%d errmsg:%s", msgid, err.Error())
+		return 0, err
+	}
+
+	return msgid, nil
+}
+
+/******************************************************************************
+ **函数名称: push_to_sid
+ **功    能: 下发推送消息给指定会话
+ **输入参数:
+ **     cmd: 命令类型(CMD_P2P:定向推送 CMD_BC:全员推送)
+ **     attr: 会话属性
+ **     msgid: 推送消息ID
+ **     data: 透传数据
+ **输出参数: NONE
+ **返    回: 是否下发成功(false:会话不在线)
+ **实现描述: 通过会话属性获取CID和NID, 再下发给侦听层.
+ **注意事项: 协议头中的序列号为推送消息ID, 终端可据此去重.
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_to_sid(cmd uint32, attr *im.SidAttr, msgid uint64, data []byte) bool {
+	if 0 == attr.GetUid() || 0 == attr.GetNid() {
+		return false /* 会话不在线 */
+	}
+
+	ctx.send_data(cmd, attr.GetSid(), attr.GetCid(),
+		attr.GetNid(), msgid, data, uint32(len(data)))
+
+	return true
+}
+
+/******************************************************************************
+ **函数名称: push_to_uid
+ **功    能: 下发推送消息给指定用户
+ **输入参数:
+ **     uid: 用户UID
+ **     msgid: 推送消息ID
+ **     data: 透传数据
+ **     expire: 过期时间(秒)
+ **输出参数: NONE
+ **返    回:
+ **     online: 下发的会话数
+ **     offline: 离线存储数(0:未存储 1:已存储)
+ **实现描述:
+ **     1. 遍历用户的会话SID集合, 下发给所有在线终端;
+ **     2. 用户无在线终端且expire不为0时, 将消息ID放入用户离线推送队列.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_to_uid(uid uint64,
+	msgid uint64, data []byte, expire uint32) (online int, offline int) {
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 下发给在线终端 */
+	key := fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid)
+
+	sid_list, err := redis.Strings(rds.Do("SMEMBERS", key))
+	if nil != err {
+		ctx.log.Error("Get sid list failed! uid:%d errmsg:%s", uid, err.Error())
+		return 0, 0
+	}
+
+	sids := make([]uint64, 0, len(sid_list))
+	for _, val := range sid_list {
+		sid, _ := strconv.ParseInt(val, 10, 64)
+		if 0 != sid {
+			sids = append(sids, uint64(sid))
+		}
+	}
+
+	attrs, err := im.GetSidAttrList(ctx.redis, sids)
+	if nil != err {
+		ctx.log.Error("Get sid attr failed! uid:%d errmsg:%s", uid, err.Error())
+		return 0, 0
+	}
+
+	num := len(attrs)
+	for idx := 0; idx < num; idx += 1 {
+		if attrs[idx].GetUid() != uid {
+			continue
+		} else if !ctx.push_to_sid(comm.CMD_P2P, attrs[idx], msgid, data) {
+			continue
+		}
+		online += 1
+	}
+
+	if 0 != online || 0 == expire {
+		return online, 0
+	}
+
+	/* > 放入离线推送队列 */
+	ctm := time.Now().Unix()
+
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_ZSET, uid)
+
+	rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm)
+	rds.Send("ZADD", key, ctm+int64(expire), msgid)
+	rds.Do("")
+
+	return 0, 1
+}
+
+/******************************************************************************
+ **函数名称: push_to_uid_list
+ **功    能: 下发推送消息给用户集合
+ **输入参数:
+ **     uid_list: 用户UID集合
+ **     msgid: 推送消息ID
+ **     data: 透传数据
+ **     expire: 过期时间(秒)
+ **输出参数: NONE
+ **返    回:
+ **     total: 有效用户数
+ **     online: 下发的会话数
+ **     offline: 离线存储数(用户数)
+ **实现描述: 按CHAT_BAT_NUM分批处理, 每批通过管道完成以下操作:
+ **     1. 批量获取用户的会话SID集合;
+ **     2. 批量获取会话属性, 并下发给在线会话;
+ **     3. 批量将无在线终端的用户放入离线推送队列.
+ **注意事项: 与push_to_uid的处理结果一致, 但REDIS交互次数与成员数无关.
+ **作    者: # agent # 2026.10.18 07:41:47 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_to_uid_list(uid_list []string,
+	msgid uint64, data []byte, expire uint32) (total int, online int, offline int) {
+	var sids []uint64
+	var owners []uint64
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	num := len(uid_list)
+	for off := 0; off < num; off += comm.CHAT_BAT_NUM {
+		end := off + comm.CHAT_BAT_NUM
+		if end > num {
+			end = num
+		}
+
+		/* > 批量获取会话SID集合 */
+		uids := make([]uint64, 0, end-off)
+		for idx := off; idx < end; idx += 1 {
+			uid, _ := strconv.ParseInt(uid_list[idx], 10, 64)
+			if 0 == uid {
+				continue
+			}
+			uids = append(uids, uint64(uid))
+			rds.Send("SMEMBERS", fmt.Sprintf(comm.IM_KEY_UID_TO_SID_SET, uid))
+		}
+
+		if 0 == len(uids) {
+			continue
+		}
+
+		sets, err := redis.Values(rds.Do(""))
+		if nil != err {
+			ctx.log.Error("Get sid list failed! errmsg:%s", err.Error())
+			return total, online, offline
+		}
+
+		total += len(uids)
+
+		/* > 批量获取会话属性 */
+		sids = sids[:0]
+		owners = owners[:0]
+		for idx := 0; idx < len(uids); idx += 1 {
+			sid_list, _ := redis.Strings(sets[idx], nil)
+			for _, val := range sid_list {
+				sid, _ := strconv.ParseInt(val, 10, 64)
+				if 0 == sid {
+					continue
+				}
+				sids = append(sids, uint64(sid))
+				owners = append(owners, uids[idx])
+				rds.Send("HMGET", fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid), "CID", "UID", "NID")
+			}
+		}
+
+		reached := make(map[uint64]bool)
+		if 0 != len(sids) {
+			attrs, err := redis.Values(rds.Do(""))
+			if nil != err {
+				ctx.log.Error("Get sid attr failed! errmsg:%s", err.Error())
+				return total, online, offline
+			}
+
+			/* > 下发给在线会话 */
+			for idx := 0; idx < len(sids); idx += 1 {
+				vals, _ := redis.Strings(attrs[idx], nil)
+				if 3 != len(vals) {
+					continue
+				}
+
+				cid, _ := strconv.ParseInt(vals[0], 10, 64)
+				uid, _ := strconv.ParseInt(vals[1], 10, 64)
+				nid, _ := strconv.ParseInt(vals[2], 10, 64)
+				if uint64(uid) != owners[idx] || 0 == nid {
+					continue /* 会话不在线 */
+				}
+
+				ctx.send_data(comm.CMD_P2P, sids[idx], uint64(cid),
+					uint32(nid), msgid, data, uint32(len(data)))
+
+				online += 1
+				reached[owners[idx]] = true
+			}
+		}
+
+		if 0 == expire {
+			continue
+		}
+
+		/* > 放入离线推送队列 */
+		for _, uid := range uids {
+			if reached[uid] {
+				continue
+			}
+
+			key := fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_ZSET, uid)
+
+			rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm)
+			rds.Send("ZADD", key, ctm+int64(expire), msgid)
+			offline += 1
+		}
+		rds.Do("")
+	}
+
+	return total, online, offline
+}
+
+/******************************************************************************
+ **函数名称: push_to_sid_list
+ **功    能: 下发公共推送消息给会话集合
+ **输入参数:
+ **     cmd: 命令类型(CMD_P2P:应用推送 CMD_BC:全员推送)
+ **     sid_list: 会话SID集合
+ **     app: APP名(空:全员推送)
+ **     version: APP版本(空:不限版本)
+ **     msgid: 推送消息ID
+ **     data: 透传数据
+ **     expire: 过期时间(秒)
+ **输出参数: NONE
+ **返    回: 下发的会话数
+ **实现描述: 按CHAT_BAT_NUM分批通过管道获取会话属性, 校验APP及版本后下发;
+ **     下发成功后更新用户的公共推送接收进度, 防止上线时重复下发.
+ **注意事项: 只有expire不为0时, 才需更新接收进度.
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_to_sid_list(cmd uint32, sid_list []string,
+	app string, version string, msgid uint64, data []byte, expire uint32) (online int) {
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	field := app
+	if "" == field {
+		field = "*"
+	}
+
+	num := len(sid_list)
+	for off := 0; off < num; off += comm.CHAT_BAT_NUM {
+		end := off + comm.CHAT_BAT_NUM
+		if end > num {
+			end = num
+		}
+
+		/* > 批量获取会话属性 */
+		sids := make([]uint64, 0, end-off)
+		for idx := off; idx < end; idx += 1 {
+			sid, _ := strconv.ParseInt(sid_list[idx], 10, 64)
+			if 0 != sid {
+				sids = append(sids, uint64(sid))
+			}
+		}
+
+		attrs, err := im.GetSidAttrList(ctx.redis, sids)
+		if nil != err {
+			ctx.log.Error("Get sid attr failed! errmsg:%s", err.Error())
+			return online
+		}
+
+		for idx := 0; idx < len(attrs); idx += 1 {
+			attr := attrs[idx]
+
+			/* > 校验APP及版本 */
+			if "" != app && app != attr.GetApp() {
+				continue
+			} else if "" != version && version != attr.GetVer() {
+				continue
+			} else if !ctx.push_to_sid(cmd, attr, msgid, data) {
+				continue
+			}
+			online += 1
+
+			/* > 更新接收进度 */
+			if 0 != expire {
+				key := fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_CURSOR_TAB, attr.GetUid())
+				pl.Send("HSET", key, field, msgid)
+			}
+		}
+	}
+
+	return online
+}
+
+/******************************************************************************
+ **函数名称: push_resend
+ **功    能: 下发离线期间的推送消息
+ **输入参数:
+ **     uid: 用户UID
+ **     app: APP名
+ **     version: APP版本
+ **     sid: 会话SID
+ **     cid: 连接CID
+ **     nid: 侦听层ID
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述:
+ **     1. 下发用户离线推送队列中未过期的消息, 并清空队列;
+ **     2. 下发全员推送和应用推送中未过期且大于接收进度的消息, 并更新接收进度.
+ **     全员推送以CMD_BC下发, 其他推送以CMD_P2P下发.
+ **注意事项: 在上线成功后调用
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) push_resend(uid uint64,
+	app string, version string, sid uint64, cid uint64, nid uint32) {
+	var list PushMsgidList
+
+	bc := make(map[uint64]bool) // 全员推送消息ID
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	ctm := time.Now().Unix()
+
+	/* > 获取用户离线推送 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_ZSET, uid)
+
+	msgid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, ctm, "+inf"))
+	if nil != err {
+		ctx.log.Error("Get push list failed! uid:%d errmsg:%s", uid, err.Error())
+		return
+	}
+
+	num := len(msgid_list)
+	for idx := 0; idx < num; idx += 1 {
+		msgid, _ := strconv.ParseInt(msgid_list[idx], 10, 64)
+		if 0 != msgid {
+			list = append(list, uint64(msgid))
+		}
+	}
+
+	pl.Send("DEL", key)
+
+	/* > 获取公共离线推送 */
+	key = fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_CURSOR_TAB, uid)
+
+	vals, err := redis.Strings(rds.Do("HMGET", key, "*", app))
+	if nil != err {
+		ctx.log.Error("Get push cursor failed! uid:%d errmsg:%s", uid, err.Error())
+		return
+	}
+
+	queue := [][2]string{
+		{comm.CHAT_KEY_BC_PUSH_ZSET, "*"},                    // 全员推送
+		{fmt.Sprintf(comm.CHAT_KEY_APP_PUSH_ZSET, app), app}, // 应用推送
+	}
+
+	for qidx := 0; qidx < len(queue); qidx += 1 {
+		zkey, field := queue[qidx][0], queue[qidx][1]
+
+		last, _ := strconv.ParseInt(vals[qidx], 10, 64)
+
+		msgid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", zkey, ctm, "+inf"))
+		if nil != err {
+			ctx.log.Error("Get push list failed! key:%s errmsg:%s", zkey, err.Error())
+			continue
+		}
+
+		max := last
+		num := len(msgid_list)
+		for idx := 0; idx < num; idx += 1 {
+			msgid, _ := strconv.ParseInt(msgid_list[idx], 10, 64)
+			if msgid <= last {
+				continue
+			}
+			list = append(list, uint64(msgid))
+			if comm.CHAT_KEY_BC_PUSH_ZSET == zkey {
+				bc[uint64(msgid)] = true
+			}
+			if msgid > max {
+				max = msgid
+			}
+		}
+
+		/* > 更新接收进度 */
+		if max > last {
+			pl.Send("HSET", key, field, max)
+		}
+	}
+
+	/* > 按消息ID顺序下发 */
+	sort.Sort(list)
+
+	num = len(list)
+	for idx := 0; idx < num; idx += 1 {
+		key := fmt.Sprintf(comm.CHAT_KEY_PUSH_MESG, list[idx])
+
+		vals, err := redis.Strings(rds.Do("HMGET", key, "DATA", "VERSION"))
+		if nil != err || "" == vals[0] {
+			continue /* 消息已过期 */
+		} else if "" != vals[1] && version != vals[1] {
+			continue /* 版本不匹配 */
+		}
+
+		cmd := uint32(comm.CMD_P2P)
+		if bc[list[idx]] {
+			cmd = comm.CMD_BC
+		}
+
+		ctx.send_data(cmd, sid, cid, nid,
+			list[idx], []byte(vals[0]), uint32(len(vals[0])))
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // SID推送
 
+/* SID推送请求 */
+type SidPushReq struct {
+	ctrl *UsrSvrPushCtrl
+}
+
+/* SID推送参数 */
+type SidPushParam struct {
+	sid    uint64 // 会话SID
+	expire uint32 // 超时时间
+	data   []byte // 透传数据
+}
+
+/* SID推送应答 */
+type SidPushRsp struct {
+	Sid     uint64 `json:"sid"`     // 会话SID
+	Msgid   uint64 `json:"msgid"`   // 推送消息ID
+	Online  int    `json:"online"`  // 下发的会话数
+	Offline int    `json:"offline"` // 离线存储数(用户数: 0或1)
+	Code    int    `json:"code"`    // 错误码
+	ErrMsg  string `json:"errmsg"`  // 错误描述
+}
+
 func (this *UsrSvrPushCtrl) SidPush(ctx *UsrSvrCntx) {
+	req := &SidPushReq{ctrl: this}
+
+	/* > 提取推送参数 */
+	param, err := req.parse_param(ctx)
+	if nil != err {
+		ctx.log.Error("Parse sid push param failed! sid:%d", param.sid)
+		this.Error(comm.ERR_SVR_PARSE_PARAM, err.Error())
+		return
+	}
+
+	/* > SID推送处理 */
+	rsp, code, err := req.push_handler(ctx, param)
+	if nil != err {
+		this.Error(code, err.Error())
+		return
+	}
+
+	req.push_success(rsp)
+
 	return
 }
 
+/******************************************************************************
+ **函数名称: parse_param
+ **功    能: 解析参数
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回:
+ **     param: 推送参数
+ **     err: 错误描述
+ **实现描述: 从url参数中抽取, 包体为透传数据.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *SidPushReq) parse_param(
+	ctx *UsrSvrCntx) (param *SidPushParam, err error) {
+	this := req.ctrl
+	param = &SidPushParam{}
+
+	/* > 提取推送参数 */
+	sid, _ := this.GetInt64("sid")
+	param.sid = uint64(sid)
+
+	expire, _ := this.GetInt32("expire")
+	param.expire = uint32(expire)
+
+	param.data = this.Ctx.Input.RequestBody
+
+	/* > 校验参数合法性 */
+	if 0 == param.sid || 0 == len(param.data) {
+		ctx.log.Error("Paramter is invalid. sid:%d", param.sid)
+		return param, errors.New("Paramter is invalid!")
+	}
+
+	return param, nil
+}
+
+/******************************************************************************
+ **函数名称: push_handler
+ **功    能: SID推送处理
+ **输入参数:
+ **     ctx: 上下文
+ **     param: 请求参数
+ **输出参数: NONE
+ **返    回:
+ **     rsp: 推送结果
+ **     code: 错误码
+ **     err: 错误信息
+ **实现描述: 会话不在线时, 将消息放入会话所属用户的离线推送队列.
+ **注意事项: 会话数据已被清理时, 无法确定所属用户, 消息将被丢弃.
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *SidPushReq) push_handler(ctx *UsrSvrCntx,
+	param *SidPushParam) (rsp *SidPushRsp, code int, err error) {
+	rsp = &SidPushRsp{Sid: param.sid}
+
+	/* > 申请推送消息ID */
+	rsp.Msgid, err = ctx.push_alloc(param.data, "", param.expire)
+	if nil != err {
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发推送消息 */
+	attr, err := im.GetSidAttr(ctx.redis, param.sid)
+	if nil != err {
+		ctx.log.Error("Get sid attr failed! sid:%d errmsg:%s", param.sid, err.Error())
+		return rsp, 0, nil
+	} else if ctx.push_to_sid(comm.CMD_P2P, attr, rsp.Msgid, param.data) {
+		rsp.Online = 1
+		return rsp, 0, nil
+	} else if 0 == attr.GetUid() || 0 == param.expire {
+		return rsp, 0, nil
+	}
+
+	/* > 放入离线推送队列 */
+	ctm := time.Now().Unix()
+
+	pl := ctx.redis.Get()
+	defer func() {
+		pl.Do("")
+		pl.Close()
+	}()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_PUSH_ZSET, attr.GetUid())
+
+	pl.Send("ZREMRANGEBYSCORE", key, "-inf", ctm)
+	pl.Send("ZADD", key, ctm+int64(param.expire), rsp.Msgid)
+
+	rsp.Offline = 1
+
+	return rsp, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: push_success
+ **功    能: SID推送成功
+ **输入参数:
+ **     rsp: 推送结果
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 按照协议返回http应答
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *SidPushReq) push_success(rsp *SidPushRsp) {
+	this := req.ctrl
+
+	rsp.Code = 0
+	rsp.ErrMsg = "OK"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // UID推送
 
@@ -62,13 +699,17 @@ type UidPushReq struct {
 type UidPushParam struct {
 	uid    uint64 // 用户UID
 	expire uint32 // 超时时间
+	data   []byte // 透传数据
 }
 
 /* UID推送应答 */
 type UidPushRsp struct {
-	Uid    uint64 `json:"uid"`    // 用户UID
-	Code   int    `json:"code"`   // 错误码
-	ErrMsg string `json:"errmsg"` // 错误描述
+	Uid     uint64 `json:"uid"`     // 用户UID
+	Msgid   uint64 `json:"msgid"`   // 推送消息ID
+	Online  int    `json:"online"`  // 下发的会话数
+	Offline int    `json:"offline"` // 离线存储数(用户数: 0或1)
+	Code    int    `json:"code"`    // 错误码
+	ErrMsg  string `json:"errmsg"`  // 错误描述
 }
 
 func (this *UsrSvrPushCtrl) UidPush(ctx *UsrSvrCntx) {
@@ -83,13 +724,13 @@ func (this *UsrSvrPushCtrl) UidPush(ctx *UsrSvrCntx) {
 	}
 
 	/* > UID推送处理 */
-	code, err := req.push_handler(ctx, param)
+	rsp, code, err := req.push_handler(ctx, param)
 	if nil != err {
 		this.Error(code, err.Error())
 		return
 	}
 
-	req.push_success(param)
+	req.push_success(rsp)
 
 	return
 
@@ -120,8 +761,10 @@ func (req *UidPushReq) parse_param(
 	expire, _ := this.GetInt32("expire")
 	param.expire = uint32(expire)
 
+	param.data = this.Ctx.Input.RequestBody
+
 	/* > 校验参数合法性 */
-	if 0 == param.uid {
+	if 0 == param.uid || 0 == len(param.data) {
 		ctx.log.Error("Paramter is invalid. uid:%d", param.uid)
 		return param, errors.New("Paramter is invalid!")
 	}
@@ -133,35 +776,49 @@ func (req *UidPushReq) parse_param(
  **函数名称: push_handler
  **功    能: UID推送处理
  **输入参数:
+ **     ctx: 上下文
  **     param: 请求参数
  **输出参数: NONE
- **返    回: VOID
- **实现描述: 按照协议返回http应答
+ **返    回:
+ **     rsp: 推送结果
+ **     code: 错误码
+ **     err: 错误信息
+ **实现描述: 下发给用户所有在线终端, 用户不在线时放入离线推送队列.
  **注意事项:
  **作    者: # Qifeng.zou # 2017.03.19 22:19:04 #
  ******************************************************************************/
-func (req *UidPushReq) push_handler(ctx *UsrSvrCntx, param *UidPushParam) (code int, err error) {
-	return 0, nil
+func (req *UidPushReq) push_handler(ctx *UsrSvrCntx,
+	param *UidPushParam) (rsp *UidPushRsp, code int, err error) {
+	rsp = &UidPushRsp{Uid: param.uid}
+
+	/* > 申请推送消息ID */
+	rsp.Msgid, err = ctx.push_alloc(param.data, "", param.expire)
+	if nil != err {
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 下发推送消息 */
+	rsp.Online, rsp.Offline = ctx.push_to_uid(param.uid, rsp.Msgid, param.data, param.expire)
+
+	return rsp, 0, nil
 }
 
 /******************************************************************************
  **函数名称: push_success
  **功    能: UID推送成功
  **输入参数:
- **     param: 请求参数
+ **     rsp: 推送结果
  **输出参数: NONE
  **返    回: VOID
  **实现描述: 按照协议返回http应答
  **注意事项:
  **作    者: # Qifeng.zou # 2017.03.19 22:19:04 #
  ******************************************************************************/
-func (req *UidPushReq) push_success(param *UidPushParam) {
+func (req *UidPushReq) push_success(rsp *UidPushRsp) {
 	this := req.ctrl
-	rsp := &UidPushRsp{
-		Uid:    param.uid,
-		Code:   0,
-		ErrMsg: "OK",
-	}
+
+	rsp.Code = 0
+	rsp.ErrMsg = "OK"
 
 	this.Data["json"] = rsp
 	this.ServeJSON()
@@ -170,33 +827,472 @@ func (req *UidPushReq) push_success(param *UidPushParam) {
 ////////////////////////////////////////////////////////////////////////////////
 // APP推送
 
+/* APP推送请求 */
+type AppPushReq struct {
+	ctrl *UsrSvrPushCtrl
+}
+
+/* APP推送参数 */
+type AppPushParam struct {
+	app     string // APP名
+	version string // APP版本
+	expire  uint32 // 超时时间
+	data    []byte // 透传数据
+}
+
+/* APP推送应答 */
+type AppPushRsp struct {
+	AppId   string `json:"appid"`   // APP名
+	Msgid   uint64 `json:"msgid"`   // 推送消息ID
+	Online  int    `json:"online"`  // 下发的会话数
+	Offline int    `json:"offline"` // 离线存储数(公共队列: 0或1, 非用户数)
+	Code    int    `json:"code"`    // 错误码
+	ErrMsg  string `json:"errmsg"`  // 错误描述
+}
+
 func (this *UsrSvrPushCtrl) AppPush(ctx *UsrSvrCntx) {
+	req := &AppPushReq{ctrl: this}
+
+	/* > 提取推送参数 */
+	param, err := req.parse_param(ctx)
+	if nil != err {
+		ctx.log.Error("Parse app push param failed! appid:%s", param.app)
+		this.Error(comm.ERR_SVR_PARSE_PARAM, err.Error())
+		return
+	}
+
+	/* > APP推送处理 */
+	rsp, code, err := req.push_handler(ctx, param)
+	if nil != err {
+		this.Error(code, err.Error())
+		return
+	}
+
+	req.push_success(rsp)
+
 	return
 }
 
+/******************************************************************************
+ **函数名称: parse_param
+ **功    能: 解析参数
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回:
+ **     param: 推送参数
+ **     err: 错误描述
+ **实现描述: 从url参数中抽取, 包体为透传数据.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *AppPushReq) parse_param(
+	ctx *UsrSvrCntx) (param *AppPushParam, err error) {
+	this := req.ctrl
+	param = &AppPushParam{}
+
+	/* > 提取推送参数 */
+	param.app = this.GetString("appid")
+	param.version = this.GetString("version")
+
+	expire, _ := this.GetInt32("expire")
+	param.expire = uint32(expire)
+
+	param.data = this.Ctx.Input.RequestBody
+
+	/* > 校验参数合法性 */
+	if "" == param.app || 0 == len(param.data) {
+		ctx.log.Error("Paramter is invalid. appid:%s", param.app)
+		return param, errors.New("Paramter is invalid!")
+	}
+
+	return param, nil
+}
+
+/******************************************************************************
+ **函数名称: push_handler
+ **功    能: APP推送处理
+ **输入参数:
+ **     ctx: 上下文
+ **     param: 请求参数
+ **输出参数: NONE
+ **返    回:
+ **     rsp: 推送结果
+ **     code: 错误码
+ **     err: 错误信息
+ **实现描述:
+ **     1. 下发给APP对应的所有在线会话(指定版本时只下发给该版本);
+ **     2. expire不为0时, 放入APP离线推送队列, 用户上线时再下发.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *AppPushReq) push_handler(ctx *UsrSvrCntx,
+	param *AppPushParam) (rsp *AppPushRsp, code int, err error) {
+	rsp = &AppPushRsp{AppId: param.app}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	/* > 申请推送消息ID */
+	rsp.Msgid, err = ctx.push_alloc(param.data, param.version, param.expire)
+	if nil != err {
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 放入离线推送队列 */
+	if 0 != param.expire {
+		key := fmt.Sprintf(comm.CHAT_KEY_APP_PUSH_ZSET, param.app)
+
+		rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm)
+		rds.Send("ZADD", key, ctm+int64(param.expire), rsp.Msgid)
+		rds.Do("")
+
+		rsp.Offline = 1
+	}
+
+	/* > 下发给在线会话 */
+	key := fmt.Sprintf(comm.IM_KEY_APP_TO_SID_ZSET, param.app)
+
+	sid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, ctm, "+inf"))
+	if nil != err {
+		ctx.log.Error("Get sid list failed! appid:%s errmsg:%s", param.app, err.Error())
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	rsp.Online = ctx.push_to_sid_list(comm.CMD_P2P, sid_list,
+		param.app, param.version, rsp.Msgid, param.data, param.expire)
+
+	return rsp, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: push_success
+ **功    能: APP推送成功
+ **输入参数:
+ **     rsp: 推送结果
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 按照协议返回http应答
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *AppPushReq) push_success(rsp *AppPushRsp) {
+	this := req.ctrl
+
+	rsp.Code = 0
+	rsp.ErrMsg = "OK"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // GROUP推送
 
+/* GROUP推送请求 */
+type GroupPushReq struct {
+	ctrl *UsrSvrPushCtrl
+}
+
 /* GROUP推送参数 */
 type GroupPushParam struct {
 	gid    uint64 // 群组ID
 	expire uint32 // 超时时间
+	data   []byte // 透传数据
 }
 
 /* GROUP推送应答 */
 type GroupPushRsp struct {
-	Gid    uint64 `json:"gid"`    // 群组ID
-	Code   int    `json:"code"`   // 错误码
-	ErrMsg string `json:"errmsg"` // 错误描述
+	Gid     uint64 `json:"gid"`     // 群组ID
+	Msgid   uint64 `json:"msgid"`   // 推送消息ID
+	Total   int    `json:"total"`   // 群成员数
+	Online  int    `json:"online"`  // 下发的会话数
+	Offline int    `json:"offline"` // 离线存储数(用户数)
+	Code    int    `json:"code"`    // 错误码
+	ErrMsg  string `json:"errmsg"`  // 错误描述
 }
 
 func (this *UsrSvrPushCtrl) GroupPush(ctx *UsrSvrCntx) {
+	req := &GroupPushReq{ctrl: this}
+
+	/* > 提取推送参数 */
+	param, err := req.parse_param(ctx)
+	if nil != err {
+		ctx.log.Error("Parse group push param failed! gid:%d", param.gid)
+		this.Error(comm.ERR_SVR_PARSE_PARAM, err.Error())
+		return
+	}
+
+	/* > GROUP推送处理 */
+	rsp, code, err := req.push_handler(ctx, param)
+	if nil != err {
+		this.Error(code, err.Error())
+		return
+	}
+
+	req.push_success(rsp)
+
 	return
 }
 
+/******************************************************************************
+ **函数名称: parse_param
+ **功    能: 解析参数
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回:
+ **     param: 推送参数
+ **     err: 错误描述
+ **实现描述: 从url参数中抽取, 包体为透传数据.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *GroupPushReq) parse_param(
+	ctx *UsrSvrCntx) (param *GroupPushParam, err error) {
+	this := req.ctrl
+	param = &GroupPushParam{}
+
+	/* > 提取推送参数 */
+	gid, _ := this.GetInt64("gid")
+	param.gid = uint64(gid)
+
+	expire, _ := this.GetInt32("expire")
+	param.expire = uint32(expire)
+
+	param.data = this.Ctx.Input.RequestBody
+
+	/* > 校验参数合法性 */
+	if 0 == param.gid || 0 == len(param.data) {
+		ctx.log.Error("Paramter is invalid. gid:%d", param.gid)
+		return param, errors.New("Paramter is invalid!")
+	}
+
+	return param, nil
+}
+
+/******************************************************************************
+ **函数名称: push_handler
+ **功    能: GROUP推送处理
+ **输入参数:
+ **     ctx: 上下文
+ **     param: 请求参数
+ **输出参数: NONE
+ **返    回:
+ **     rsp: 推送结果
+ **     code: 错误码
+ **     err: 错误信息
+ **实现描述: 获取群成员列表, 通过管道批量下发给成员的在线会话.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *GroupPushReq) push_handler(ctx *UsrSvrCntx,
+	param *GroupPushParam) (rsp *GroupPushRsp, code int, err error) {
+	rsp = &GroupPushRsp{Gid: param.gid}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	/* > 获取群成员列表 */
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, param.gid)
+
+	uid_list, err := redis.Strings(rds.Do("ZRANGE", key, 0, -1))
+	if nil != err {
+		ctx.log.Error("Get group member failed! gid:%d errmsg:%s", param.gid, err.Error())
+		return nil, comm.ERR_SYS_SYSTEM, err
+	} else if 0 == len(uid_list) {
+		ctx.log.Error("Group is empty or not exist! gid:%d", param.gid)
+		return nil, comm.ERR_SVR_INVALID_PARAM, errors.New("Group is empty or not exist!")
+	}
+
+	/* > 申请推送消息ID */
+	rsp.Msgid, err = ctx.push_alloc(param.data, "", param.expire)
+	if nil != err {
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 批量下发给群成员 */
+	rsp.Total, rsp.Online, rsp.Offline = ctx.push_to_uid_list(
+		uid_list, rsp.Msgid, param.data, param.expire)
+
+	return rsp, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: push_success
+ **功    能: GROUP推送成功
+ **输入参数:
+ **     rsp: 推送结果
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 按照协议返回http应答
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *GroupPushReq) push_success(rsp *GroupPushRsp) {
+	this := req.ctrl
+
+	rsp.Code = 0
+	rsp.ErrMsg = "OK"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 全员推送
 
+/* 全员推送请求 */
+type BcPushReq struct {
+	ctrl *UsrSvrPushCtrl
+}
+
+/* 全员推送参数 */
+type BcPushParam struct {
+	expire uint32 // 超时时间
+	data   []byte // 透传数据
+}
+
+/* 全员推送应答 */
+type BcPushRsp struct {
+	Msgid   uint64 `json:"msgid"`   // 推送消息ID
+	Online  int    `json:"online"`  // 下发的会话数
+	Offline int    `json:"offline"` // 离线存储数(公共队列: 0或1, 非用户数)
+	Code    int    `json:"code"`    // 错误码
+	ErrMsg  string `json:"errmsg"`  // 错误描述
+}
+
 func (this *UsrSvrPushCtrl) Broadcast(ctx *UsrSvrCntx) {
+	req := &BcPushReq{ctrl: this}
+
+	/* > 提取推送参数 */
+	param, err := req.parse_param(ctx)
+	if nil != err {
+		ctx.log.Error("Parse broadcast param failed! errmsg:%s", err.Error())
+		this.Error(comm.ERR_SVR_PARSE_PARAM, err.Error())
+		return
+	}
+
+	/* > 全员推送处理 */
+	rsp, code, err := req.push_handler(ctx, param)
+	if nil != err {
+		this.Error(code, err.Error())
+		return
+	}
+
+	req.push_success(rsp)
+
 	return
 }
+
+/******************************************************************************
+ **函数名称: parse_param
+ **功    能: 解析参数
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数: NONE
+ **返    回:
+ **     param: 推送参数
+ **     err: 错误描述
+ **实现描述: 从url参数中抽取, 包体为透传数据.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *BcPushReq) parse_param(
+	ctx *UsrSvrCntx) (param *BcPushParam, err error) {
+	this := req.ctrl
+	param = &BcPushParam{}
+
+	/* > 提取推送参数 */
+	expire, _ := this.GetInt32("expire")
+	param.expire = uint32(expire)
+
+	param.data = this.Ctx.Input.RequestBody
+
+	/* > 校验参数合法性 */
+	if 0 == len(param.data) {
+		ctx.log.Error("Paramter is invalid. body is empty!")
+		return param, errors.New("Paramter is invalid!")
+	}
+
+	return param, nil
+}
+
+/******************************************************************************
+ **函数名称: push_handler
+ **功    能: 全员推送处理
+ **输入参数:
+ **     ctx: 上下文
+ **     param: 请求参数
+ **输出参数: NONE
+ **返    回:
+ **     rsp: 推送结果
+ **     code: 错误码
+ **     err: 错误信息
+ **实现描述:
+ **     1. 下发给所有在线会话;
+ **     2. expire不为0时, 放入全员离线推送队列, 用户上线时再下发.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *BcPushReq) push_handler(ctx *UsrSvrCntx,
+	param *BcPushParam) (rsp *BcPushRsp, code int, err error) {
+	rsp = &BcPushRsp{}
+
+	rds := ctx.redis.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	/* > 申请推送消息ID */
+	rsp.Msgid, err = ctx.push_alloc(param.data, "", param.expire)
+	if nil != err {
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	/* > 放入离线推送队列 */
+	if 0 != param.expire {
+		key := comm.CHAT_KEY_BC_PUSH_ZSET
+
+		rds.Send("ZREMRANGEBYSCORE", key, "-inf", ctm)
+		rds.Send("ZADD", key, ctm+int64(param.expire), rsp.Msgid)
+		rds.Do("")
+
+		rsp.Offline = 1
+	}
+
+	/* > 下发给在线会话 */
+	sid_list, err := redis.Strings(rds.Do("ZRANGEBYSCORE", comm.IM_KEY_SID_ZSET, ctm, "+inf"))
+	if nil != err {
+		ctx.log.Error("Get sid list failed! errmsg:%s", err.Error())
+		return nil, comm.ERR_SYS_SYSTEM, err
+	}
+
+	rsp.Online = ctx.push_to_sid_list(comm.CMD_BC, sid_list,
+		"", "", rsp.Msgid, param.data, param.expire)
+
+	return rsp, 0, nil
+}
+
+/******************************************************************************
+ **函数名称: push_success
+ **功    能: 全员推送成功
+ **输入参数:
+ **     rsp: 推送结果
+ **输出参数: NONE
+ **返    回: VOID
+ **实现描述: 按照协议返回http应答
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:38:12 #
+ ******************************************************************************/
+func (req *BcPushReq) push_success(rsp *BcPushRsp) {
+	this := req.ctrl
+
+	rsp.Code = 0
+	rsp.ErrMsg = "OK"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/garyburd/redigo/redis"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/im"
+
+	"beehive-im/src/golang/exec/usrsvr/models"
 )
 
 type UsrSvrQueryCtrl struct {
@@ -22,6 +26,21 @@ func (this *UsrSvrQueryCtrl) Query() {
 	case "sid-list":
 		this.SidList(ctx)
 		return
+	case "mark-list":
+		this.MarkList(ctx)
+		return
+	case "friend-list":
+		this.FriendList(ctx)
+		return
+	case "user-info":
+		this.UserInfo(ctx)
+		return
+	case "online-list":
+		this.OnlineList(ctx)
+		return
+	case "conv-list":
+		this.ConvList(ctx)
+		return
 	}
 
 	errmsg := fmt.Sprintf("Unsupport this option:%s", option)
@@ -127,3 +146,341 @@ func (this *UsrSvrQueryCtrl) SidList(ctx *UsrSvrCntx) {
 
 	return
 }
+
+////////////////////////////////////////////////////////////////////////////////
+/* 备注列表 */
+
+/* 应答结果 */
+type MarkListGetRsp struct {
+	Uid    uint64   `json:"uid"`    // 用户ID
+	Len    int      `json:"len"`    // 列表长度
+	List   MarkList `json:"list"`   // 备注列表
+	Code   int      `json:"code"`   // 错误码
+	ErrMsg string   `json:"errmsg"` // 错误描述
+}
+
+type MarkList []MarkListItem
+
+/* 备注信息 */
+type MarkListItem struct {
+	Uid  uint64 `json:"uid"`  // 被备注的用户UID
+	Mark string `json:"mark"` // 备注名
+}
+
+func (list MarkList) Len() int           { return len(list) }
+func (list MarkList) Less(i, j int) bool { return list[i].Uid < list[j].Uid }
+func (list MarkList) Swap(i, j int)      { list[i], list[j] = list[j], list[i] }
+
+/******************************************************************************
+ **函数名称: MarkList
+ **功    能: 获取用户的备注列表
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func (this *UsrSvrQueryCtrl) MarkList(ctx *UsrSvrCntx) {
+	rsp := &MarkListGetRsp{}
+
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	if 0 >= uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalied!")
+		return
+	}
+
+	rsp.Uid = uint64(uid)
+
+	/* > 获取备注列表 */
+	marks, err := models.MarkList(ctx.redis, ctx.mongo, ctx.conf.Mongo.DbName, rsp.Uid)
+	if nil != err {
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	rsp.List = make(MarkList, 0)
+	for duid, mark := range marks {
+		id, _ := strconv.ParseInt(duid, 10, 64)
+		if 0 == id {
+			continue
+		}
+
+		rsp.List = append(rsp.List, MarkListItem{Uid: uint64(id), Mark: mark})
+	}
+
+	sort.Sort(rsp.List)
+
+	/* 回复应答 */
+	rsp.Len = len(rsp.List)
+	rsp.Code = 0
+	rsp.ErrMsg = "Ok"
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 好友列表 */
+
+/* 应答结果 */
+type FriendListGetRsp struct {
+	Uid    uint64       `json:"uid"`    // 用户ID
+	Total  int          `json:"total"`  // 好友总数
+	Next   uint64       `json:"next"`   // 下页游标
+	Len    int          `json:"len"`    // 列表长度
+	List   []FriendItem `json:"list"`   // 好友列表
+	Code   int          `json:"code"`   // 错误码
+	ErrMsg string       `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: FriendList
+ **功    能: 分页获取好友列表
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述:
+ **注意事项:
+ **     请求参数: uid: 用户ID(M) num: 获取数目(O) cursor: 分页游标(O)
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func (this *UsrSvrQueryCtrl) FriendList(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	if 0 >= uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalied!")
+		return
+	}
+
+	num, _ := strconv.ParseUint(this.GetString("num"), 10, 32)
+	cursor, _ := strconv.ParseUint(this.GetString("cursor"), 10, 64)
+
+	/* > 获取好友列表 */
+	list, next, total, err := ctx.friendList(uint64(uid), cursor, uint32(num))
+	if nil != err {
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	} else if nil == list {
+		list = make([]FriendItem, 0)
+	}
+
+	/* 回复应答 */
+	rsp := &FriendListGetRsp{
+		Uid:    uint64(uid),
+		Total:  total,
+		Next:   next,
+		Len:    len(list),
+		List:   list,
+		Code:   0,
+		ErrMsg: "Ok",
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 用户信息 */
+
+/* 应答结果 */
+type UserInfoGetRsp struct {
+	Uid     uint64 `json:"uid"`      // 用户ID
+	Nation  uint64 `json:"nation"`   // 国家ID(国)
+	City    uint64 `json:"city"`     // 城市ID(市)
+	Town    uint64 `json:"town"`     // 城镇ID(县)
+	SidNum  uint32 `json:"sid-num"`  // 累计分配的会话SID数
+	LastSid uint64 `json:"last-sid"` // 最近分配的会话SID
+	FirstTm int64  `json:"first-tm"` // 首次注册时间
+	LastTm  int64  `json:"last-tm"`  // 最近注册时间
+	Code    int    `json:"code"`     // 错误码
+	ErrMsg  string `json:"errmsg"`   // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: UserInfo
+ **功    能: 获取用户注册信息
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述: 从USERDB中查询注册时记录的地区、会话SID数和注册时间
+ **注意事项:
+ **     请求参数: uid: 用户ID(M)
+ **作    者: # agent # 2026.10.18 06:39:22 #
+ ******************************************************************************/
+func (this *UsrSvrQueryCtrl) UserInfo(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseInt(this.GetString("uid"), 10, 64)
+	if 0 >= uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalied!")
+		return
+	}
+
+	/* > 获取注册信息 */
+	info, err := models.UserRegGet(ctx.userdb, uint64(uid))
+	if nil != err {
+		ctx.log.Error("Get user info failed! uid:%d errmsg:%s", uid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	} else if nil == info {
+		this.Error(comm.ERR_SYS_INVALID_USER, "User is not registered!")
+		return
+	}
+
+	/* 回复应答 */
+	rsp := &UserInfoGetRsp{
+		Uid:     info.Uid,
+		Nation:  info.Nation,
+		City:    info.City,
+		Town:    info.Town,
+		SidNum:  info.SidNum,
+		LastSid: info.LastSid,
+		FirstTm: info.FirstTm,
+		LastTm:  info.LastTm,
+		Code:    0,
+		ErrMsg:  "Ok",
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 在线状态 */
+
+/* 应答结果 */
+type OnlineListGetRsp struct {
+	Len    int            `json:"len"`    // 列表长度
+	Online int            `json:"online"` // 在线用户数
+	List   []PresenceItem `json:"list"`   // 在线状态列表
+	Code   int            `json:"code"`   // 错误码
+	ErrMsg string         `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: OnlineList
+ **功    能: 批量查询用户在线状态
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述: 解析用户ID列表(去重), 再通过presence_query()批量查询.
+ **注意事项:
+ **     请求参数: uids: 用户ID列表, 以逗号分隔(M)
+ **作    者: # agent # 2026.10.18 06:57:25 #
+ ******************************************************************************/
+func (this *UsrSvrQueryCtrl) OnlineList(ctx *UsrSvrCntx) {
+	uids := this.GetString("uids")
+	if "" == uids {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uids] is invalied!")
+		return
+	}
+
+	/* > 解析用户ID列表 */
+	uid_list := make([]uint64, 0)
+	uid_map := make(map[uint64]bool)
+
+	for _, str := range strings.Split(uids, ",") {
+		uid, _ := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+		if 0 == uid {
+			errmsg := fmt.Sprintf("Paramter [uids] is invalied! uid:%s", str)
+			this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+			return
+		} else if uid_map[uid] {
+			continue
+		}
+
+		uid_map[uid] = true
+		uid_list = append(uid_list, uid)
+	}
+
+	if len(uid_list) > USRSVR_PRESENCE_QUERY_MAX {
+		errmsg := fmt.Sprintf("Too many uids! max:%d", USRSVR_PRESENCE_QUERY_MAX)
+		this.Error(comm.ERR_SVR_INVALID_PARAM, errmsg)
+		return
+	}
+
+	/* > 批量查询在线状态 */
+	list, err := ctx.presence_query(uid_list)
+	if nil != err {
+		ctx.log.Error("Query online list failed! errmsg:%s", err.Error())
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	/* 回复应答 */
+	rsp := &OnlineListGetRsp{
+		Len:    len(list),
+		List:   list,
+		Code:   0,
+		ErrMsg: "Ok",
+	}
+
+	for idx := range list {
+		if list[idx].Online {
+			rsp.Online += 1
+		}
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+/* 会话列表 */
+
+/* 应答结果 */
+type ConvListGetRsp struct {
+	Uid    uint64         `json:"uid"`    // 用户ID
+	Len    int            `json:"len"`    // 列表长度
+	List   []*im.ConvItem `json:"list"`   // 会话列表
+	Code   int            `json:"code"`   // 错误码
+	ErrMsg string         `json:"errmsg"` // 错误描述
+}
+
+/******************************************************************************
+ **函数名称: ConvList
+ **功    能: 获取用户的会话列表
+ **输入参数:
+ **     ctx: 上下文
+ **输出参数:
+ **返    回:
+ **实现描述: 按最近消息时间倒序返回会话列表(含最近消息预览和未读数)
+ **注意事项:
+ **     请求参数: uid: 用户ID(M) num: 获取数目(O)
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func (this *UsrSvrQueryCtrl) ConvList(ctx *UsrSvrCntx) {
+	uid, _ := strconv.ParseUint(this.GetString("uid"), 10, 64)
+	if 0 == uid {
+		this.Error(comm.ERR_SVR_INVALID_PARAM, "Paramter [uid] is invalied!")
+		return
+	}
+
+	num, _ := strconv.ParseInt(this.GetString("num"), 10, 32)
+	if 0 >= num {
+		num = comm.CHAT_CONV_DEF_NUM
+	} else if num > comm.CHAT_CONV_MAX_NUM {
+		num = comm.CHAT_CONV_MAX_NUM
+	}
+
+	/* > 获取会话列表 */
+	list, err := im.ConvList(ctx.redis, uid, int(num))
+	if nil != err {
+		this.Error(comm.ERR_SYS_SYSTEM, err.Error())
+		return
+	}
+
+	/* > 回复应答 */
+	rsp := &ConvListGetRsp{
+		Uid:    uid,
+		Len:    len(list),
+		List:   list,
+		Code:   0,
+		ErrMsg: "Ok",
+	}
+
+	this.Data["json"] = rsp
+	this.ServeJSON()
+}
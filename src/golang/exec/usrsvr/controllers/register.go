@@ -5,6 +5,8 @@ import (
 
 	"beehive-im/src/golang/lib/comm"
 	"beehive-im/src/golang/lib/mesg/seqsvr"
+
+	"beehive-im/src/golang/exec/usrsvr/models"
 )
 
 /* 注册处理 */
@@ -96,8 +98,8 @@ type UsrSvrRegisterRsp struct {
  **     param: 注册参数
  **输出参数: NONE
  **返    回: VOID
- **实现描述:
- **注意事项:
+ **实现描述: 申请会话SID, 并将注册信息记录到USERDB.
+ **注意事项: 注册信息记录失败时返回错误, 由调用方重新注册.
  **作    者: # Qifeng.zou # 2016.11.24 17:34:27 #
  ******************************************************************************/
 func (this *UsrSvrRegisterCtrl) register_handler(param *UsrSvrRegisterParam) {
@@ -123,6 +125,16 @@ func (this *UsrSvrRegisterCtrl) register_handler(param *UsrSvrRegisterParam) {
 
 	ctx.log.Debug("Alloc sid success! uid:%d sid:%d", param.uid, sid)
 
+	/* > 记录注册信息 */
+	err = models.UserRegAdd(ctx.userdb, param.uid,
+		uint64(sid), param.nation, param.city, param.town)
+	if nil != err {
+		ctx.log.Error("Store register info failed! uid:%d sid:%d errmsg:%s",
+			param.uid, sid, err.Error())
+		this.Error(comm.ERR_SYS_DB, err.Error())
+		return
+	}
+
 	this.success(param, uint64(sid))
 
 	return
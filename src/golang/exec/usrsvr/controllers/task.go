@@ -3,6 +3,7 @@ package controllers
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/garyburd/redigo/redis"
@@ -24,6 +25,7 @@ func (ctx *UsrSvrCntx) task() {
 	for {
 		ctx.listend_dict_update() // 更新侦听层字典
 		ctx.listend_list_update() // 更新侦听层列表
+		ctx.ipdict_watch()        // 检测IP字典是否更新
 
 		time.Sleep(time.Second)
 	}
@@ -89,7 +91,7 @@ func (ctx *UsrSvrCntx) listend_dict_update() {
  **输入参数:
  **     typ: 网络类型(0:Unkonwn 1:TCP 2:WS)
  **输出参数: NONE
- **返    回: 侦听层IP列表
+ **返    回: 侦听层结点列表
  **实现描述:
  **注意事项:
  **作    者: # Qifeng.zou # 2016.11.28 00:09:55 #
@@ -101,7 +103,7 @@ func (ctx *UsrSvrCntx) listend_dict_fetch(typ int) *UsrSvrLsndDictItem {
 	ctm := time.Now().Unix()
 
 	lsnd := &UsrSvrLsndDictItem{
-		list: make(map[string](map[uint32][]string)),
+		list: make(map[string](map[uint32][]*UsrSvrLsndNode)),
 	}
 
 	/* > 获取"国家/地区"列表 */
@@ -125,27 +127,29 @@ func (ctx *UsrSvrCntx) listend_dict_fetch(typ int) *UsrSvrLsndDictItem {
 			return nil
 		}
 
-		operator_set := make(map[uint32][]string, 0)
+		operator_set := make(map[uint32][]*UsrSvrLsndNode, 0)
 
 		operator_num := len(operators)
 		for n := 0; n < operator_num; n += 1 {
 			opid, _ := strconv.ParseInt(operators[n], 10, 32)
 
 			//ctx.log.Debug("    Operator:%d", uint32(opid))
-			/* > 获取"运营商"对应的"IP+PORT"列表 */
-			key := fmt.Sprintf(comm.IM_KEY_LSND_IP_ZSET, typ, nations[m], uint32(opid))
+			/* > 获取"运营商"对应的结点列表 */
+			key := fmt.Sprintf(comm.IM_KEY_LSND_OP_TO_NID_ZSET, typ, nations[m], uint32(opid))
 
-			iplist, err := redis.Strings(rds.Do("ZRANGEBYSCORE", key, ctm, "+inf"))
+			nid_list, err := redis.Ints(rds.Do("ZRANGEBYSCORE", key, ctm, "+inf"))
 			if nil != err {
-				ctx.log.Error("Get operator list by nation failed! errmsg:%s", err.Error())
+				ctx.log.Error("Get listend list by operator failed! errmsg:%s", err.Error())
 				return nil
 			}
 
-			iplist_num := len(iplist)
-			for k := 0; k < iplist_num; k += 1 {
-				//ctx.log.Debug("    iplist:%s", iplist[k])
-				operator_set[uint32(opid)] = append(operator_set[uint32(opid)], iplist[k])
+			nodes, err := ctx.listend_node_fetch(rds, nid_list)
+			if nil != err {
+				ctx.log.Error("Get listend attribute failed! errmsg:%s", err.Error())
+				return nil
 			}
+
+			operator_set[uint32(opid)] = nodes
 		}
 
 		lsnd.list[nations[m]] = operator_set
@@ -154,6 +158,74 @@ func (ctx *UsrSvrCntx) listend_dict_fetch(typ int) *UsrSvrLsndDictItem {
 	return lsnd
 }
 
+/******************************************************************************
+ **函数名称: listend_node_fetch
+ **功    能: 获取侦听层结点信息
+ **输入参数:
+ **     rds: REDIS连接
+ **     nid_list: 结点ID列表
+ **输出参数: NONE
+ **返    回:
+ **     nodes: 结点列表(IPv4/IPv6地址、状态、在线连接数)
+ **     err: 错误描述
+ **实现描述: 通过PIPELINE批量获取结点属性
+ **注意事项:
+ **     1. 地址为空的结点将被忽略;
+ **     2. 结点状态优先取人工设置的状态, 其次取侦听层上报的负载状态.
+ **作    者: # agent # 2026.10.18 06:47:28 #
+ ******************************************************************************/
+func (ctx *UsrSvrCntx) listend_node_fetch(
+	rds redis.Conn, nid_list []int) (nodes []*UsrSvrLsndNode, err error) {
+	num := len(nid_list)
+	for idx := 0; idx < num; idx += 1 {
+		key := fmt.Sprintf(comm.IM_KEY_LSND_ATTR, nid_list[idx])
+		rds.Send("HMGET", key, comm.IM_LSND_ATTR_ADDR, comm.IM_LSND_ATTR_STATUS,
+			comm.IM_LSND_ATTR_CONNECTION, comm.IM_LSND_ATTR_ADDR6, comm.IM_LSND_ATTR_MANUAL)
+	}
+
+	rds.Flush()
+
+	for idx := 0; idx < num; idx += 1 {
+		vals, err := redis.Strings(rds.Receive())
+		if nil != err {
+			return nil, err
+		} else if "" == vals[0] {
+			continue
+		}
+
+		status, err := strconv.ParseInt(vals[4], 10, 32) /* 人工设置优先 */
+		if nil != err {
+			status, err = strconv.ParseInt(vals[1], 10, 32)
+			if nil != err {
+				status = comm.PROC_STATUS_EXEC /* 未设置状态时视为正常 */
+			}
+		}
+
+		conns, _ := strconv.ParseInt(vals[2], 10, 64)
+
+		node := &UsrSvrLsndNode{
+			nid:    uint32(nid_list[idx]),
+			status: int(status),
+			conns:  uint32(conns),
+		}
+
+		/* > 区分地址族(IPv6地址格式为"[IP]:PORT") */
+		if strings.HasPrefix(vals[0], "[") {
+			node.addr6 = vals[0]
+		} else {
+			node.addr = vals[0]
+		}
+
+		if "" != vals[3] {
+			node.addr6 = vals[3]
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 /******************************************************************************
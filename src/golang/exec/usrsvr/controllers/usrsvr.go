@@ -3,6 +3,7 @@ package controllers
 import (
 	"database/sql"
 	"errors"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 
 	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/crypt"
 	"beehive-im/src/golang/lib/dbase"
 	"beehive-im/src/golang/lib/log"
 	"beehive-im/src/golang/lib/mesg/seqsvr"
@@ -21,12 +23,30 @@ import (
 	"beehive-im/src/golang/lib/thrift_pool"
 
 	"beehive-im/src/golang/exec/usrsvr/controllers/conf"
+	"beehive-im/src/golang/exec/usrsvr/models"
 )
 
+/* 侦听层结点 */
+type UsrSvrLsndNode struct {
+	nid    uint32 /* 结点ID */
+	addr   string /* IPv4外网地址(IP+PORT) */
+	addr6  string /* IPv6外网地址([IP]+PORT) */
+	status int    /* 当前状态(comm.PROC_STATUS_*) */
+	conns  uint32 /* 在线连接数 */
+}
+
+/* 获取指定地址族的外网地址(不支持时返回空串) */
+func (node *UsrSvrLsndNode) get_addr(ipv6 bool) string {
+	if ipv6 {
+		return node.addr6
+	}
+	return node.addr
+}
+
 /* 侦听层字典 */
 type UsrSvrLsndDictItem struct {
-	sync.RWMutex                                  /* 读写锁 */
-	list         map[string](map[uint32][]string) /* 侦听层列表:map[TCP/WS](map[国家/地区](map([运营商ID][]IP列表))) */
+	sync.RWMutex                                           /* 读写锁 */
+	list         map[string](map[uint32][]*UsrSvrLsndNode) /* 侦听层列表:map[国家/地区](map([运营商ID][]结点列表)) */
 }
 
 type UsrSvrLsndDict struct {
@@ -48,13 +68,14 @@ type UsrSvrLsndData struct {
 type UsrSvrCntx struct {
 	conf        *conf.UsrSvrConf  /* 配置信息 */
 	log         *logs.BeeLogger   /* 日志对象 */
-	ipdict      *comm.IpDict      /* IP字典 */
+	ipdict      UsrSvrIpDict      /* IP字典 */
 	frwder      *rtmq.Proxy       /* 代理对象 */
 	redis       *redis.Pool       /* REDIS连接池 */
 	mongo       *mongo.Pool       /* MONGO连接池 */
 	userdb      *sql.DB           /* USERDB数据库 */
 	seqsvr_pool *thrift_pool.Pool /* SEQSVR连接池 */
 	listend     UsrSvrLsndData    /* 侦听层数据 */
+	token       *crypt.TokenCtx   /* TOKEN签名对象 */
 }
 
 var g_usrsvr_cntx *UsrSvrCntx /* 全局对象 */
@@ -94,7 +115,7 @@ func UsrSvrInit(conf *conf.UsrSvrConf) (ctx *UsrSvrCntx, err error) {
 	}
 
 	/* > 加载IP字典 */
-	ctx.ipdict, err = comm.LoadIpDict("../conf/ipdict.txt")
+	err = ctx.ipdict_init(USRSVR_IPDICT_PATH)
 	if nil != err {
 		return nil, err
 	}
@@ -102,6 +123,18 @@ func UsrSvrInit(conf *conf.UsrSvrConf) (ctx *UsrSvrCntx, err error) {
 	/* > 创建侦听层列表 */
 	ctx.listend.dict.types = make(map[int]*UsrSvrLsndDictItem)
 
+	/* > TOKEN签名对象 */
+	keys := make(map[string]string)
+	for _, item := range conf.Token.Keys {
+		keys[item.Id] = strings.TrimSpace(item.Key)
+	}
+
+	ctx.token, err = crypt.CreateTokenCtx(conf.Token.Kid, keys)
+	if nil != err {
+		ctx.log.Error("Create token context failed! errmsg:%s", err.Error())
+		return nil, err
+	}
+
 	/* > REDIS连接池 */
 	ctx.redis = rdb.CreatePool(conf.Redis.Addr, conf.Redis.Passwd, 2048)
 	if nil == ctx.redis {
@@ -119,6 +152,11 @@ func UsrSvrInit(conf *conf.UsrSvrConf) (ctx *UsrSvrCntx, err error) {
 		return nil, err
 	}
 
+	err = models.DbHistoryIndex(ctx.mongo, conf.Mongo.DbName)
+	if nil != err {
+		ctx.log.Error("Create history index failed! errmsg:%s", err.Error())
+	}
+
 	/* > MYSQL连接池 */
 	auth := dbase.MySqlAuthStr(conf.UserDb.Usr, conf.UserDb.Passwd, conf.UserDb.Addr, conf.UserDb.Dbname)
 
@@ -168,10 +206,14 @@ func (ctx *UsrSvrCntx) Register() {
 	/* > 私聊消息 */
 	ctx.frwder.Register(comm.CMD_FRIEND_ADD, UsrSvrFriendAddHandler, ctx)
 	ctx.frwder.Register(comm.CMD_FRIEND_DEL, UsrSvrFriendDelHandler, ctx)
+	ctx.frwder.Register(comm.CMD_FRIEND_REPLY, UsrSvrFriendReplyHandler, ctx)
+	ctx.frwder.Register(comm.CMD_FRIEND_LIST, UsrSvrFriendListHandler, ctx)
 	ctx.frwder.Register(comm.CMD_BLACKLIST_ADD, UsrSvrBlacklistAddHandler, ctx)
 	ctx.frwder.Register(comm.CMD_BLACKLIST_DEL, UsrSvrBlacklistDelHandler, ctx)
 	ctx.frwder.Register(comm.CMD_GAG_ADD, UsrSvrGagAddHandler, ctx)
 	ctx.frwder.Register(comm.CMD_GAG_DEL, UsrSvrGagDelHandler, ctx)
+	ctx.frwder.Register(comm.CMD_MARK_ADD, UsrSvrMarkAddHandler, ctx)
+	ctx.frwder.Register(comm.CMD_MARK_DEL, UsrSvrMarkDelHandler, ctx)
 
 	/* > 群聊消息 */
 	ctx.frwder.Register(comm.CMD_GROUP_CREAT, UsrSvrGroupCreatHandler, ctx)
@@ -187,6 +229,7 @@ func (ctx *UsrSvrCntx) Register() {
 	ctx.frwder.Register(comm.CMD_GROUP_MGR_ADD, UsrSvrGroupMgrAddHandler, ctx)
 	ctx.frwder.Register(comm.CMD_GROUP_MGR_DEL, UsrSvrGroupMgrDelHandler, ctx)
 	ctx.frwder.Register(comm.CMD_GROUP_USR_LIST, UsrSvrGroupUsrListHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_JOIN_AUDIT, UsrSvrGroupJoinAuditHandler, ctx)
 }
 
 /******************************************************************************
@@ -203,6 +246,7 @@ func (ctx *UsrSvrCntx) Launch() {
 	ctx.frwder.Launch()
 
 	go ctx.task()
+	go ctx.task_presence()
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/mongo"
+)
+
+/******************************************************************************
+ **函数名称: DbFriendAdd
+ **功    能: 添加好友(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 用户UID
+ **     fuid: 好友UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 存在则更新, 不存在则插入
+ **注意事项: 好友关系为单向记录, 互为好友时需分别调用
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func DbFriendAdd(mongo *mongo.Pool, dbname string, uid uint64, fuid uint64) error {
+	row := &FriendTabRow{
+		Uid:  uid,               // 用户ID
+		Fuid: fuid,              // 好友UID
+		Ctm:  time.Now().Unix(), // 成为好友的时间
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		_, err = c.Upsert(bson.M{"uid": uid, "fuid": fuid}, row)
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_FRIEND, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbFriendDel
+ **功    能: 删除好友(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 用户UID
+ **     fuid: 好友UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **注意事项: 记录不存在时不视为错误
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func DbFriendDel(mongo *mongo.Pool, dbname string, uid uint64, fuid uint64) error {
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Remove(bson.M{"uid": uid, "fuid": fuid})
+		if mgo.ErrNotFound == err {
+			return nil
+		}
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_FRIEND, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbFriendReqAdd
+ **功    能: 记录好友申请(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 被申请的用户UID
+ **     suid: 申请人UID
+ **     mark: 申请附言
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 重复申请时覆盖之前的申请
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func DbFriendReqAdd(mongo *mongo.Pool, dbname string, uid uint64, suid uint64, mark string) error {
+	row := &FriendReqTabRow{
+		Uid:  uid,               // 被申请的用户ID
+		Suid: suid,              // 申请人UID
+		Mark: mark,              // 申请附言
+		Ctm:  time.Now().Unix(), // 申请时间
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		_, err = c.Upsert(bson.M{"uid": uid, "suid": suid}, row)
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_FRIEND_REQ, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbFriendReqDel
+ **功    能: 移除好友申请(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 被申请的用户UID
+ **     suid: 申请人UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **注意事项: 记录不存在时不视为错误
+ **作    者: # agent # 2026.10.18 06:33:39 #
+ ******************************************************************************/
+func DbFriendReqDel(mongo *mongo.Pool, dbname string, uid uint64, suid uint64) error {
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Remove(bson.M{"uid": uid, "suid": suid})
+		if mgo.ErrNotFound == err {
+			return nil
+		}
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_FRIEND_REQ, cb)
+}
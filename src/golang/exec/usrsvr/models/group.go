@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/mongo"
+)
+
+/******************************************************************************
+ **函数名称: DbGroupDismiss
+ **功    能: 记录群组解散(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     gid: 群组ID
+ **     uid: 操作者UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func DbGroupDismiss(mongo *mongo.Pool, dbname string, gid uint64, uid uint64) error {
+	dismiss := &GroupDismissTabRow{
+		Gid: gid,               // 群组ID
+		Uid: uid,               // 操作者UID
+		Ctm: time.Now().Unix(), // 解散时间
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Insert(dismiss)
+	}
+
+	return mongo.Exec(dbname, TAB_GROUP_DISMISS, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbGroupMgrAdd
+ **功    能: 记录群组管理员(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     gid: 群组ID
+ **     uid: 管理员UID
+ **     opid: 操作者UID
+ **     role: 角色
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 存在则更新, 不存在则插入
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func DbGroupMgrAdd(mongo *mongo.Pool, dbname string, gid uint64, uid uint64, opid uint64, role int) error {
+	row := &GroupRoleTabRow{
+		Gid:  gid,               // 群组ID
+		Uid:  uid,               // 管理员UID
+		Role: role,              // 角色
+		Opid: opid,              // 操作者UID
+		Utm:  time.Now().Unix(), // 更新时间
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		_, err = c.Upsert(bson.M{"gid": gid, "uid": uid}, row)
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_GROUP_ROLE, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbGroupMgrDel
+ **功    能: 移除群组管理员(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     gid: 群组ID
+ **     uid: 管理员UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **注意事项: 记录不存在时不视为错误
+ **作    者: # agent # 2026.10.18 06:25:55 #
+ ******************************************************************************/
+func DbGroupMgrDel(mongo *mongo.Pool, dbname string, gid uint64, uid uint64) error {
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Remove(bson.M{"gid": gid, "uid": uid})
+		if mgo.ErrNotFound == err {
+			return nil
+		}
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_GROUP_ROLE, cb)
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/history"
+	"beehive-im/src/golang/lib/mongo"
+)
+
+/******************************************************************************
+ **函数名称: DbHistoryIndex
+ **功    能: 创建历史消息查询所需的索引
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **     私聊消息: (suid, duid, ctm)和(duid, suid, ctm)
+ **     群聊消息: (gid, ctm)和(gid, msgid)
+ **注意事项: 索引已存在时不做处理, 因此每次启动时均可调用.
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func DbHistoryIndex(mongo *mongo.Pool, dbname string) error {
+	chat := func(c *mgo.Collection) (err error) {
+		err = c.EnsureIndex(mgo.Index{Key: []string{"suid", "duid", "ctm"}, Background: true})
+		if nil != err {
+			return err
+		}
+		return c.EnsureIndex(mgo.Index{Key: []string{"duid", "suid", "ctm"}, Background: true})
+	}
+
+	err := mongo.Exec(dbname, history.TAB_CHAT_MESG, chat)
+	if nil != err {
+		return err
+	}
+
+	group := func(c *mgo.Collection) (err error) {
+		err = c.EnsureIndex(mgo.Index{Key: []string{"gid", "ctm"}, Background: true})
+		if nil != err {
+			return err
+		}
+		return c.EnsureIndex(mgo.Index{Key: []string{"gid", "msgid"}, Background: true})
+	}
+
+	return mongo.Exec(dbname, history.TAB_GROUP_MESG, group)
+}
+
+/******************************************************************************
+ **函数名称: DbChatHistory
+ **功    能: 查询私聊历史消息
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     cond: 过滤条件
+ **     sort: 排序方式
+ **     num: 获取条数
+ **输出参数: NONE
+ **返    回:
+ **     rows: 私聊消息列表(按(发送时间, 记录ID)排序)
+ **     err: 错误信息
+ **实现描述:
+ **注意事项: 过滤条件及排序方式见history.ChatHistoryCond
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func DbChatHistory(mongo *mongo.Pool, dbname string,
+	cond bson.M, sort []string, num int) (rows []history.ChatMesgTabRow, err error) {
+	rows = make([]history.ChatMesgTabRow, 0)
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Find(cond).Sort(sort...).Limit(num).All(&rows)
+	}
+
+	err = mongo.Exec(dbname, history.TAB_CHAT_MESG, cb)
+	if nil != err {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+/******************************************************************************
+ **函数名称: DbGroupHistory
+ **功    能: 查询群聊历史消息
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     gid: 群组ID
+ **     param: 查询条件
+ **输出参数: NONE
+ **返    回:
+ **     rows: 群聊消息列表(按群消息ID排序)
+ **     err: 错误信息
+ **实现描述:
+ **注意事项: 过滤条件及排序方式见history.GroupHistoryCond
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func DbGroupHistory(mongo *mongo.Pool, dbname string,
+	gid uint64, param *history.HistoryParam) (rows []history.GroupMesgTabRow, err error) {
+	cond, sort := history.GroupHistoryCond(gid, param)
+
+	/* > 查询历史消息 */
+	rows = make([]history.GroupMesgTabRow, 0)
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Find(cond).Sort(sort).Limit(param.Num).All(&rows)
+	}
+
+	err = mongo.Exec(dbname, history.TAB_GROUP_MESG, cb)
+	if nil != err {
+		return nil, err
+	}
+
+	return rows, nil
+}
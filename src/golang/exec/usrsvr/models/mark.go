@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/garyburd/redigo/redis"
+
+	"beehive-im/src/golang/lib/comm"
+	"beehive-im/src/golang/lib/mongo"
+)
+
+/******************************************************************************
+ **函数名称: DbMarkAdd
+ **功    能: 设置备注(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 用户UID
+ **     duid: 被备注的用户UID
+ **     mark: 备注名
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 存在则更新, 不存在则插入
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func DbMarkAdd(mongo *mongo.Pool, dbname string, uid uint64, duid uint64, mark string) error {
+	row := &MarkTabRow{
+		Uid:  uid,               // 用户ID
+		Duid: duid,              // 被备注的用户UID
+		Mark: mark,              // 备注名
+		Utm:  time.Now().Unix(), // 更新时间
+	}
+
+	cb := func(c *mgo.Collection) (err error) {
+		_, err = c.Upsert(bson.M{"uid": uid, "duid": duid}, row)
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_MARK, cb)
+}
+
+/******************************************************************************
+ **函数名称: DbMarkDel
+ **功    能: 移除备注(同步到数据库...)
+ **输入参数:
+ **     mongo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 用户UID
+ **     duid: 被备注的用户UID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述:
+ **注意事项: 记录不存在时不视为错误
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func DbMarkDel(mongo *mongo.Pool, dbname string, uid uint64, duid uint64) error {
+	cb := func(c *mgo.Collection) (err error) {
+		err = c.Remove(bson.M{"uid": uid, "duid": duid})
+		if mgo.ErrNotFound == err {
+			return nil
+		}
+		return err
+	}
+
+	return mongo.Exec(dbname, TAB_MARK, cb)
+}
+
+/******************************************************************************
+ **函数名称: RdsMarkAdd
+ **功    能: 设置备注(同步到Redis缓存...)
+ **输入参数:
+ **     redis: REDIS对象
+ **     uid: 用户UID
+ **     duid: 被备注的用户UID
+ **     mark: 备注名
+ **输出参数: NONE
+ **返    回: 错误码+错误信息
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func RdsMarkAdd(redis *redis.Pool, uid uint64, duid uint64, mark string) (code uint32, err error) {
+	rds := redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_MARK_TAB, uid)
+
+	_, err = rds.Do("HSET", key, duid, mark)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return comm.OK, nil
+}
+
+/******************************************************************************
+ **函数名称: RdsMarkDel
+ **功    能: 移除备注(同步到Redis缓存...)
+ **输入参数:
+ **     redis: REDIS对象
+ **     uid: 用户UID
+ **     duid: 被备注的用户UID
+ **输出参数: NONE
+ **返    回: 错误码+错误信息
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func RdsMarkDel(redis *redis.Pool, uid uint64, duid uint64) (code uint32, err error) {
+	rds := redis.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_MARK_TAB, uid)
+
+	_, err = rds.Do("HDEL", key, duid)
+	if nil != err {
+		return comm.ERR_SYS_SYSTEM, err
+	}
+
+	return comm.OK, nil
+}
+
+/******************************************************************************
+ **函数名称: MarkList
+ **功    能: 获取用户的备注列表
+ **输入参数:
+ **     pool: REDIS连接池
+ **     mgo: MONGO连接池
+ **     dbname: 数据库名
+ **     uid: 用户UID
+ **输出参数: NONE
+ **返    回:
+ **     list: 备注列表(被备注的用户UID -> 备注名)
+ **     err: 错误信息
+ **实现描述: 优先从缓存中获取, 缓存中不存在时从数据库加载并回写缓存
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:30:14 #
+ ******************************************************************************/
+func MarkList(pool *redis.Pool, mgo_pool *mongo.Pool, dbname string, uid uint64) (list map[string]string, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	/* > 从缓存中获取 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_MARK_TAB, uid)
+
+	list, err = redis.StringMap(rds.Do("HGETALL", key))
+	if nil != err {
+		return nil, err
+	} else if 0 != len(list) {
+		return list, nil
+	}
+
+	/* > 从数据库中加载 */
+	var rows []MarkTabRow
+
+	cb := func(c *mgo.Collection) (err error) {
+		return c.Find(bson.M{"uid": uid}).All(&rows)
+	}
+
+	err = mgo_pool.Exec(dbname, TAB_MARK, cb)
+	if nil != err {
+		return nil, err
+	}
+
+	/* > 回写缓存 */
+	num := len(rows)
+	for idx := 0; idx < num; idx += 1 {
+		duid := fmt.Sprintf("%d", rows[idx].Duid)
+		list[duid] = rows[idx].Mark
+		rds.Do("HSET", key, duid, rows[idx].Mark)
+	}
+
+	return list, nil
+}
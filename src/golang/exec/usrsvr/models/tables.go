@@ -2,7 +2,12 @@ package models
 
 /* 数据库表名定义 */
 const (
-	TAB_BLACKLIST = "BlackList"
+	TAB_BLACKLIST     = "BlackList"
+	TAB_GROUP_DISMISS = "GroupDismiss"
+	TAB_GROUP_ROLE    = "GroupRole"
+	TAB_MARK          = "Mark"
+	TAB_FRIEND        = "Friend"
+	TAB_FRIEND_REQ    = "FriendReq"
 )
 
 /* 用户黑名单 */
@@ -11,3 +16,42 @@ type BlacklistTabRow struct {
 	Buid uint64 "buid" // 被加入UID黑名单列表的用户UID
 	Ctm  int64  "ctm"  // 设置时间
 }
+
+/* 用户备注 */
+type MarkTabRow struct {
+	Uid  uint64 "uid"  // 用户ID
+	Duid uint64 "duid" // 被备注的用户UID
+	Mark string "mark" // 备注名
+	Utm  int64  "utm"  // 更新时间
+}
+
+/* 用户好友 */
+type FriendTabRow struct {
+	Uid  uint64 "uid"  // 用户ID
+	Fuid uint64 "fuid" // 好友UID
+	Ctm  int64  "ctm"  // 成为好友的时间
+}
+
+/* 好友申请 */
+type FriendReqTabRow struct {
+	Uid  uint64 "uid"  // 被申请的用户ID
+	Suid uint64 "suid" // 申请人UID
+	Mark string "mark" // 申请附言
+	Ctm  int64  "ctm"  // 申请时间
+}
+
+/* 群组解散记录 */
+type GroupDismissTabRow struct {
+	Gid uint64 "gid" // 群组ID
+	Uid uint64 "uid" // 操作者UID(群主)
+	Ctm int64  "ctm" // 解散时间
+}
+
+/* 群组角色 */
+type GroupRoleTabRow struct {
+	Gid  uint64 "gid"  // 群组ID
+	Uid  uint64 "uid"  // 用户ID
+	Role int    "role" // 角色(1:群主 2:管理员)
+	Opid uint64 "opid" // 操作者UID
+	Utm  int64  "utm"  // 更新时间
+}
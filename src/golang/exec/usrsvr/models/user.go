@@ -0,0 +1,103 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+/* 用户注册信息 */
+type UserRegInfo struct {
+	Uid     uint64 // 用户ID
+	Nation  uint64 // 国家ID(国)
+	City    uint64 // 城市ID(市)
+	Town    uint64 // 城镇ID(县)
+	SidNum  uint32 // 累计分配的会话SID数(非设备数)
+	LastSid uint64 // 最近一次分配的会话SID
+	FirstTm int64  // 首次注册时间
+	LastTm  int64  // 最近注册时间
+}
+
+/******************************************************************************
+ **函数名称: UserRegAdd
+ **功    能: 记录用户注册信息
+ **输入参数:
+ **     db: MYSQL连接池
+ **     uid: 用户ID
+ **     sid: 会话SID
+ **     nation: 国家ID
+ **     city: 城市ID
+ **     town: 城镇ID
+ **输出参数: NONE
+ **返    回: 错误描述
+ **实现描述: 不存在则插入, 存在则更新地区信息、会话SID数和最近注册时间.
+ **注意事项: 每次注册都会分配新的会话SID, 因此sid_num按注册次数累加, 不代表设备数.
+ **作    者: # agent # 2026.10.18 06:39:22 #
+ ******************************************************************************/
+func UserRegAdd(db *sql.DB, uid uint64, sid uint64, nation uint64, city uint64, town uint64) error {
+	/* > 准备SQL语句 */
+	sqlstr := fmt.Sprintf(`
+    INSERT INTO
+        IM_USR_REG_TAB(
+            uid, nation, city, town, sid_num,
+            last_sid, first_reg_time, last_reg_time)
+    VALUES(?, ?, ?, ?, 1, ?, ?, ?)
+    ON DUPLICATE KEY UPDATE
+        nation=VALUES(nation), city=VALUES(city), town=VALUES(town),
+        sid_num=sid_num+1, last_sid=VALUES(last_sid),
+        last_reg_time=VALUES(last_reg_time)`)
+
+	stmt, err := db.Prepare(sqlstr)
+	if nil != err {
+		return err
+	}
+
+	defer stmt.Close()
+
+	/* > 执行SQL语句 */
+	ctm := time.Now().Unix()
+
+	_, err = stmt.Exec(uid, nation, city, town, sid, ctm, ctm)
+	if nil != err {
+		return err
+	}
+
+	return nil
+}
+
+/******************************************************************************
+ **函数名称: UserRegGet
+ **功    能: 获取用户注册信息
+ **输入参数:
+ **     db: MYSQL连接池
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回:
+ **     info: 注册信息(用户未注册时为nil)
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:39:22 #
+ ******************************************************************************/
+func UserRegGet(db *sql.DB, uid uint64) (info *UserRegInfo, err error) {
+	sqlstr := fmt.Sprintf(`
+    SELECT
+        uid, nation, city, town, sid_num,
+        last_sid, first_reg_time, last_reg_time
+    FROM
+        IM_USR_REG_TAB
+    WHERE
+        uid=?`)
+
+	info = &UserRegInfo{}
+
+	err = db.QueryRow(sqlstr, uid).Scan(&info.Uid, &info.Nation, &info.City,
+		&info.Town, &info.SidNum, &info.LastSid, &info.FirstTm, &info.LastTm)
+	if sql.ErrNoRows == err {
+		return nil, nil
+	} else if nil != err {
+		return nil, err
+	}
+
+	return info, nil
+}
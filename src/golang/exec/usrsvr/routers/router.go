@@ -18,7 +18,8 @@ func Router() {
 	beego.Router("/im/push", &controllers.UsrSvrPushCtrl{}, "post:Push")
 	beego.Router("/im/query", &controllers.UsrSvrQueryCtrl{}, "get:Query")
 	beego.Router("/im/config", &controllers.UsrSvrConfigCtrl{}, "get:Config")
+	beego.Router("/im/history", &controllers.UsrSvrHistoryCtrl{}, "get:Query")
 
-	//beego.Router("/im/group/query", &controllers.UsrSvrGroupQueryCtrl{}, "get:Query")
+	beego.Router("/im/group/query", &controllers.UsrSvrGroupQueryCtrl{}, "get:Query")
 	beego.Router("/im/group/config", &controllers.UsrSvrGroupConfigCtrl{}, "get:Config")
 }
@@ -18,6 +18,7 @@ type LsndConf struct {
 	ConfPath  string                  // 配置路径(自动获取)
 	Log       log.Conf                // 日志配置
 	Operator  LsndConfOperatorXmlData // 运营商信息
+	Ipv6      string                  // 外网IPv6地址(空:不支持IPv6)
 	WebSocket lws.Conf                // WEBSOCKET配置
 	Frwder    rtmq.ProxyConf          // RTMQ配置
 }
@@ -74,6 +75,11 @@ func (conf *LsndConf) GetIp() string {
 	return conf.WebSocket.Ip
 }
 
+/* 获取IPv6地址 */
+func (conf *LsndConf) GetIpv6() string {
+	return conf.Ipv6
+}
+
 /* 获取绑定端口 */
 func (conf *LsndConf) GetPort() uint32 {
 	return conf.WebSocket.Port
@@ -49,6 +49,7 @@ type LsndConfWsSendqXmlData struct {
 /* WEBSOCKET代理配置 */
 type LsndConfWebsocketXmlData struct {
 	Ip          string                       `xml:"IP,attr"`     // 对端IP
+	Ipv6        string                       `xml:"IPV6,attr"`   // 外网IPv6地址(O)
 	Port        uint32                       `xml:"PORT,attr"`   // 对端PORT
 	Connections LsndConfWsConncetionsXmlData `xml:"CONNECTIONS"` // 连接配置
 	Sendq       LsndConfWsSendqXmlData       `xml:"SENDQ"`       // 发送队列配置
@@ -134,6 +135,7 @@ func (conf *LsndConf) parse() (err error) {
 
 	/* > 侦听配置 */
 	conf.WebSocket.Ip = node.WebSocket.Ip                       // IP地址
+	conf.Ipv6 = node.WebSocket.Ipv6                             // IPv6地址
 	conf.WebSocket.Port = node.WebSocket.Port                   // 端口号
 	conf.WebSocket.Max = node.WebSocket.Connections.Max         // 最大连接限制
 	conf.WebSocket.Timeout = node.WebSocket.Connections.Timeout // 连接超时时间
@@ -27,6 +27,8 @@ func (ctx *LsndCntx) MesgRegister() {
 	ctx.callback.Register(comm.CMD_PING, LsndMesgPingHandler, ctx)       /* 心跳请求 */
 	ctx.callback.Register(comm.CMD_SUB, LsndMesgCommHandler, ctx)        /* 订阅请求 */
 	ctx.callback.Register(comm.CMD_UNSUB, LsndMesgUnsubHandler, ctx)     /* 取消订阅 */
+	ctx.callback.Register(comm.CMD_CONV_LIST, LsndMesgCommHandler, ctx)  /* 会话列表 */
+	ctx.callback.Register(comm.CMD_CONV_READ, LsndMesgCommHandler, ctx)  /* 会话已读 */
 
 	/* 私聊消息 */
 	ctx.callback.Register(comm.CMD_CHAT, LsndMesgCommHandler, ctx)
@@ -38,6 +40,15 @@ func (ctx *LsndCntx) MesgRegister() {
 	ctx.callback.Register(comm.CMD_GAG_DEL, LsndMesgCommHandler, ctx)
 	ctx.callback.Register(comm.CMD_MARK_ADD, LsndMesgCommHandler, ctx)
 	ctx.callback.Register(comm.CMD_MARK_DEL, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_FRIEND_REPLY, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_FRIEND_LIST, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_CHAT_RECV, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_CHAT_READ, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_CHAT_RECALL, LsndMesgCommHandler, ctx)
+
+	/* 群聊消息 */
+	ctx.callback.Register(comm.CMD_GROUP_RECALL, LsndMesgCommHandler, ctx)
+	ctx.callback.Register(comm.CMD_GROUP_SYNC, LsndMesgCommHandler, ctx)
 
 	/* 聊天室消息 */
 	ctx.callback.Register(comm.CMD_ROOM_CREAT, LsndMesgCommHandler, ctx)    /* 创建聊天室 */
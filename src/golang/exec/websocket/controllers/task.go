@@ -107,6 +107,11 @@ func (ctx *LsndCntx) gather_base_info() {
 		Connections: proto.Uint32(ctx.chat.SessionCount()), // 会话总数
 	}
 
+	req.Status = proto.Uint32(lsnd_status(req.GetConnections(), ctx.conf.WebSocket.Max)) // 结点状态
+	if "" != ctx.conf.GetIpv6() {
+		req.Ipv6 = proto.String(ctx.conf.GetIpv6()) // IPv6地址(双栈结点)
+	}
+
 	/* 生成PB数据 */
 	body, err := proto.Marshal(req)
 	if nil != err {
@@ -134,6 +139,25 @@ func (ctx *LsndCntx) gather_base_info() {
 	ctx.log.Debug("Send listen report succ!")
 }
 
+/******************************************************************************
+ **函数名称: lsnd_status
+ **功    能: 计算侦听层负载状态
+ **输入参数:
+ **     conns: 在线连接数
+ **     max: 最大连接数(0:不限制)
+ **输出参数: NONE
+ **返    回: 结点状态(comm.PROC_STATUS_*)
+ **实现描述: 在线连接数达到最大连接数的LSND_BUSY_PERCENT时, 视为繁忙.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:44:05 #
+ ******************************************************************************/
+func lsnd_status(conns uint32, max uint32) uint32 {
+	if 0 != max && uint64(conns)*100 >= uint64(max)*comm.LSND_BUSY_PERCENT {
+		return comm.PROC_STATUS_BUSY
+	}
+	return comm.PROC_STATUS_EXEC
+}
+
 type ChatTravRoomListProcCb func(item *chat_tab.ChatRoomItem, param interface{}) int
 
 /******************************************************************************
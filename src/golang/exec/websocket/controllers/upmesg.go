@@ -26,6 +26,9 @@ func (ctx *LsndCntx) UpMesgRegister() {
 	ctx.frwder.Register(comm.CMD_KICK, LsndUpMesgKickHandler, ctx)
 	ctx.frwder.Register(comm.CMD_SUB_ACK, LsndUpMesgSubAckHandler, ctx)
 	ctx.frwder.Register(comm.CMD_UNSUB_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CONV_LIST_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CONV_READ, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CONV_READ_ACK, LsndUpMesgCommHandler, ctx)
 
 	/* > 私聊消息 */
 	ctx.frwder.Register(comm.CMD_CHAT_ACK, LsndUpMesgCommHandler, ctx)
@@ -37,6 +40,20 @@ func (ctx *LsndCntx) UpMesgRegister() {
 	ctx.frwder.Register(comm.CMD_GAG_DEL_ACK, LsndUpMesgCommHandler, ctx)
 	ctx.frwder.Register(comm.CMD_MARK_ADD_ACK, LsndUpMesgCommHandler, ctx)
 	ctx.frwder.Register(comm.CMD_MARK_DEL_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_FRIEND_REPLY_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_FRIEND_LIST_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECV, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECV_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_READ, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_READ_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECALL, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_CHAT_RECALL_ACK, LsndUpMesgCommHandler, ctx)
+
+	/* > 群聊消息 */
+	ctx.frwder.Register(comm.CMD_GROUP_RECALL, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_RECALL_ACK, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_CHAT, LsndUpMesgCommHandler, ctx)
+	ctx.frwder.Register(comm.CMD_GROUP_SYNC_ACK, LsndUpMesgCommHandler, ctx)
 
 	/* > 聊天室消息 */
 	ctx.frwder.Register(comm.CMD_ROOM_JOIN_ACK, LsndUpMesgRoomJoinAckHandler, ctx)
@@ -101,3 +101,113 @@ func GroupGetGidToNidMap(pool *redis.Pool) (m map[uint64][]uint32, err error) {
 
 	return m, nil
 }
+
+/******************************************************************************
+ **函数名称: GroupGetRole
+ **功    能: 获取用户在群组中的角色
+ **输入参数:
+ **     pool: REDIS连接池
+ **     gid: 群ID
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回:
+ **     role: 群组角色(0:普通成员 1:所有者 2:管理员)
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:17:15 #
+ ******************************************************************************/
+func GroupGetRole(pool *redis.Pool, gid uint64, uid uint64) (role int, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid)
+
+	role, err = redis.Int(rds.Do("HGET", key, uid))
+	if redis.ErrNil == err {
+		return 0, nil
+	} else if nil != err {
+		return 0, err
+	}
+
+	return role, nil
+}
+
+/******************************************************************************
+ **函数名称: GroupIsExist
+ **功    能: 判断群组是否存在
+ **输入参数:
+ **     pool: REDIS连接池
+ **     gid: 群ID
+ **输出参数: NONE
+ **返    回: true:存在 false:不存在
+ **实现描述: 群组创建时必然设置了群主, 因此通过角色表判断群组是否存在
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func GroupIsExist(pool *redis.Pool, gid uint64) (ok bool, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_ROLE_TAB, gid)
+
+	return redis.Bool(rds.Do("EXISTS", key))
+}
+
+/******************************************************************************
+ **函数名称: GroupIsMember
+ **功    能: 判断用户是否为群组成员
+ **输入参数:
+ **     pool: REDIS连接池
+ **     gid: 群ID
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回: true:是 false:否
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func GroupIsMember(pool *redis.Pool, gid uint64, uid uint64) (ok bool, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_ZSET, gid)
+
+	_, err = redis.Int64(rds.Do("ZSCORE", key, uid))
+	if redis.ErrNil == err {
+		return false, nil
+	} else if nil != err {
+		return false, err
+	}
+
+	return true, nil
+}
+
+/******************************************************************************
+ **函数名称: GroupInBlacklist
+ **功    能: 判断用户是否在群组黑名单中
+ **输入参数:
+ **     pool: REDIS连接池
+ **     gid: 群ID
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回: true:是 false:否
+ **实现描述:
+ **注意事项: 群组黑名单以ZSET方式存储(成员:UID 分值:加入时间)
+ **作    者: # agent # 2026.10.18 06:22:38 #
+ ******************************************************************************/
+func GroupInBlacklist(pool *redis.Pool, gid uint64, uid uint64) (ok bool, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_GROUP_USR_BLACKLIST_SET, gid)
+
+	_, err = redis.Int64(rds.Do("ZSCORE", key, uid))
+	if redis.ErrNil == err {
+		return false, nil
+	} else if nil != err {
+		return false, err
+	}
+
+	return true, nil
+}
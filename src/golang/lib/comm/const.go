@@ -6,6 +6,53 @@ const (
 	CHAT_NID_TTL            = 30    // 结点ID-TTL
 	CHAT_BAT_NUM            = 1000  // 批量操作个数
 	CHAT_ROOM_GROUP_MAX_NUM = 10000 // 各组最大人数
+	CHAT_GROUP_USR_MAX_NUM  = 500   // 群组默认最大人数
+)
+
+/* 私聊消息回执 */
+const (
+	CHAT_RECEIPT_TTL     = 7 * 86400 // 回执保留时长
+	CHAT_RECEIPT_MAX_NUM = 1000      // 各用户最多保留的回执数
+	CHAT_SENT_TTL        = 7 * 86400 // 已发消息索引保留时长(超时后从数据库校验)
+	CHAT_SENT_MAX_NUM    = 10000     // 各用户最多保留的已发消息索引数
+)
+
+/* 离线消息同步 */
+const (
+	CHAT_SYNC_DEF_NUM = 100 // 每页默认条数
+	CHAT_SYNC_MAX_NUM = 500 // 每页最大条数
+)
+
+/* 会话类型 */
+const (
+	CHAT_CONV_TYPE_CHAT  = 1 // 私聊
+	CHAT_CONV_TYPE_GROUP = 2 // 群聊
+	CHAT_CONV_TYPE_ROOM  = 3 // 聊天室
+)
+
+/* 群组成员列表 */
+const (
+	CHAT_GROUP_USR_LIST_DEF_NUM = 100 // 每页默认人数
+	CHAT_GROUP_USR_LIST_MAX_NUM = 500 // 每页最大人数
+)
+
+/* 会话列表 */
+const (
+	CHAT_CONV_DEF_NUM      = 100  // 每次获取的默认条数
+	CHAT_CONV_MAX_NUM      = 500  // 每次获取的最大条数
+	CHAT_CONV_SAVE_MAX_NUM = 1000 // 每个用户最多保留的会话数
+	CHAT_CONV_PREVIEW_LEN  = 64   // 最近消息预览的最大字符数
+)
+
+/* 上下线通知 */
+const (
+	IM_PRESENCE_DEBOUNCE = 5 // 上下线通知防抖时长(秒)
+)
+
+/* 历史消息查询 */
+const (
+	HISTORY_DEF_NUM = 20  // 每页默认条数
+	HISTORY_MAX_NUM = 200 // 每页最大条数
 )
 
 /* 时间转换成秒 */
@@ -38,6 +85,10 @@ const (
 	PROC_STATUS_BUSY = 2 // 太忙状态
 )
 
+const (
+	LSND_BUSY_PERCENT = 90 // 侦听层繁忙阈值(在线连接数占最大连接数的百分比)
+)
+
 const (
 	SECTION_SID_NUM = 100000 // 各段SID个数
 )
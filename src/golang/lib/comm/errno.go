@@ -49,4 +49,11 @@ const (
 	ERR_SVR_BODY_INVALID   = 20011 // Body invalid| 报体不合法 |
 	ERR_SVR_CHECK_FAIL     = 20012 // Check invalid| 校验失败 |
 	ERR_SVR_SEQ_EXHAUSTION = 20013 // Seqence exhaustion | 序列号耗尽 |
+	ERR_SVR_PERM_DENIED    = 20014 // Permission denied | 权限不足 |
+	ERR_SVR_IN_BLACKLIST   = 20015 // In blacklist | 处于黑名单中 |
+	ERR_SVR_GROUP_FULL     = 20016 // Group is full | 群组人数已满 |
+	ERR_SVR_MULTI_LOGIN    = 20017 // Kicked by multi-device login policy | 其他设备登录, 被踢下线 |
+	ERR_SVR_IN_GAG         = 20018 // In gag list | 处于禁言中 |
+	ERR_SVR_RECALL_TIMEOUT = 20019 // Recall timeout | 超过撤回时限 |
+	ERR_SVR_MESG_NOT_EXIST = 20020 // Message not exist | 消息不存在 |
 )
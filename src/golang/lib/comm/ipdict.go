@@ -2,10 +2,13 @@ package comm
 
 import (
 	"bufio"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -21,17 +24,26 @@ const (
 
 /* IP字典 */
 type IpDict struct {
-	items []IpDictItem // IP列表
-	num   int          // IP列表长度
+	items  []IpDictItem // IPv4列表
+	num    int          // IPv4列表长度
+	items6 []IpDictItem // IPv6列表(按起始IP升序排列)
+}
+
+/* IPv6地址(128位整数) */
+type Ipv6Int struct {
+	hi uint64 // 高64位
+	lo uint64 // 低64位
 }
 
 /* IP项 */
 type IpDictItem struct {
-	start_ip uint32 // 起始IP
-	end_ip   uint32 // 结束IP
-	opid     uint32 // 运营商ID
-	operator string // 运营商名称
-	nation   string // 国家或地区名
+	start_ip  uint32  // 起始IP
+	end_ip    uint32  // 结束IP
+	start_ip6 Ipv6Int // 起始IP(IPv6)
+	end_ip6   Ipv6Int // 结束IP(IPv6)
+	opid      uint32  // 运营商ID
+	operator  string  // 运营商名称
+	nation    string  // 国家或地区名
 }
 
 /******************************************************************************
@@ -64,6 +76,64 @@ func Ipv4Str2Uint32(ipv4 string) uint32 {
 	return ip_int
 }
 
+/******************************************************************************
+ **函数名称: IsIpv6
+ **功    能: 判断是否为IPv6地址字串
+ **输入参数:
+ **     ip: IP字串
+ **输出参数: NONE
+ **返    回: true:IPv6 false:非IPv6
+ **实现描述: IPv6字串必然包含':'字符
+ **注意事项: IPv4映射地址(如"::ffff:1.2.3.4")也视为IPv6字串
+ **作    者: # agent # 2026.10.18 06:49:39 #
+ ******************************************************************************/
+func IsIpv6(ip string) bool {
+	return strings.Contains(ip, ":")
+}
+
+/******************************************************************************
+ **函数名称: Ipv6Str2Int
+ **功    能: 将IPv6字串转换成128位整数
+ **输入参数:
+ **     ipv6: IPv6字串
+ **输出参数: NONE
+ **返    回:
+ **     ip: 128位整数
+ **     ok: 是否转换成功
+ **实现描述: 将格式为"2001:db8::1"的字串转换成高/低64位整数
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:49:39 #
+ ******************************************************************************/
+func Ipv6Str2Int(ipv6 string) (ip Ipv6Int, ok bool) {
+	if !IsIpv6(ipv6) {
+		return ip, false
+	}
+
+	addr := net.ParseIP(ipv6).To16()
+	if nil == addr {
+		return ip, false
+	}
+
+	ip.hi = binary.BigEndian.Uint64(addr[:8])
+	ip.lo = binary.BigEndian.Uint64(addr[8:])
+
+	return ip, true
+}
+
+/* 比较IPv6大小(-1:小于 0:等于 1:大于) */
+func (ip Ipv6Int) Compare(other Ipv6Int) int {
+	if ip.hi < other.hi {
+		return -1
+	} else if ip.hi > other.hi {
+		return 1
+	} else if ip.lo < other.lo {
+		return -1
+	} else if ip.lo > other.lo {
+		return 1
+	}
+	return 0
+}
+
 /******************************************************************************
  **函数名称: LoadIpDict
  **功    能: 加载IP字典
@@ -108,28 +178,53 @@ func LoadIpDict(path string) (dict *IpDict, err error) {
 
 		var item IpDictItem
 
+		item.opid = Ipv4Str2Uint32(segment[IP_DICT_OPID_IDX]) /* 运营商ID */
+		item.operator = segment[len(segment)-1]               /* 运营商名称 */
+		item.nation = segment[IP_DICT_COUNTRY_IDX]            /* 国家或地区 */
+		if "" == item.nation || "" == item.operator {
+			errmsg := fmt.Sprintf("Data isn't right! line:%d", idx)
+			return nil, errors.New(errmsg)
+		}
+
+		/* > IPv6地址段 */
+		if IsIpv6(segment[IP_DICT_START_IP_IDX]) {
+			start, ok := Ipv6Str2Int(segment[IP_DICT_START_IP_IDX]) /* 起始IP */
+			end, ok2 := Ipv6Str2Int(segment[IP_DICT_END_IP_IDX])    /* 结束IP */
+			if !ok || !ok2 || start.Compare(end) > 0 {
+				errmsg := fmt.Sprintf("Data isn't right! line:%d", idx)
+				return nil, errors.New(errmsg)
+			}
+
+			item.start_ip6 = start
+			item.end_ip6 = end
+
+			num := len(dict.items6)
+			if num > 0 && item.start_ip6.Compare(dict.items6[num-1].end_ip6) <= 0 { /* 检测IP是否存在乱序的情况 */
+				errmsg := fmt.Sprintf("Ip addr less than last line! line:%d", idx)
+				return nil, errors.New(errmsg)
+			}
+
+			dict.items6 = append(dict.items6, item)
+			continue
+		}
+
+		/* > IPv4地址段 */
 		item.start_ip = Ipv4Str2Uint32(segment[IP_DICT_START_IP_IDX]) /* 起始IP */
 		item.end_ip = Ipv4Str2Uint32(segment[IP_DICT_END_IP_IDX])     /* 结束IP */
-		item.opid = Ipv4Str2Uint32(segment[IP_DICT_OPID_IDX])         /* 运营商ID */
-		item.operator = segment[len(segment)-1]                       /* 运营商名称 */
-		item.nation = segment[IP_DICT_COUNTRY_IDX]                    /* 国家或地区 */
-		if 0 == item.start_ip || 0 == item.end_ip ||
-			"" == item.nation || "" == item.operator {
+		if 0 == item.start_ip || 0 == item.end_ip {
 			errmsg := fmt.Sprintf("Data isn't right! line:%d", idx)
 			return nil, errors.New(errmsg)
-		} else if idx > 1 {
-			//fmt.Printf("idx:%d len:%d ip:%d\n", idx, len(dict.items), dict.items[idx-2].end_ip)
-			if item.start_ip <= dict.items[idx-2].end_ip { /* 检测IP是否存在乱序的情况 */
+		} else if dict.num > 0 {
+			if item.start_ip <= dict.items[dict.num-1].end_ip { /* 检测IP是否存在乱序的情况 */
 				errmsg := fmt.Sprintf("Ip addr less than last line! line:%d", idx)
 				return nil, errors.New(errmsg)
 			}
 		}
 
 		dict.items = append(dict.items, item)
+		dict.num += 1
 	}
 
-	dict.num = idx
-
 	return dict, nil
 }
 
@@ -137,22 +232,47 @@ func LoadIpDict(path string) (dict *IpDict, err error) {
  **函数名称: Query
  **功    能: 查询IP字典
  **输入参数:
- **     ipv4: IP地址
+ **     ip: IP地址(IPv4或IPv6)
  **输出参数: NONE
  **返    回: IP选项(国家+运营商)
  **实现描述: 二分查找算法
- **注意事项:
+ **注意事项: IPv4映射的IPv6地址(如"::ffff:1.2.3.4")按IPv4查询
  **作    者: # Qifeng.zou # 2016.11.25 23:18:18 #
  ******************************************************************************/
-func (dict *IpDict) Query(ipv4 string) *IpDictItem {
-	var low, mid, high int
+func (dict *IpDict) Query(ip string) *IpDictItem {
+	if IsIpv6(ip) {
+		addr := net.ParseIP(ip)
+		if nil == addr {
+			return nil
+		} else if ipv4 := addr.To4(); nil != ipv4 {
+			return dict.query4(binary.BigEndian.Uint32(ipv4))
+		}
 
-	if 0 == dict.num {
-		return nil
+		ip6, ok := Ipv6Str2Int(ip)
+		if !ok {
+			return nil
+		}
+		return dict.query6(ip6)
 	}
 
-	ip_int := Ipv4Str2Uint32(ipv4)
-	if 0 == ip_int {
+	return dict.query4(Ipv4Str2Uint32(ip))
+}
+
+/******************************************************************************
+ **函数名称: query4
+ **功    能: 查询IPv4字典
+ **输入参数:
+ **     ip_int: IPv4地址
+ **输出参数: NONE
+ **返    回: IP选项(国家+运营商)
+ **实现描述: 二分查找算法
+ **注意事项:
+ **作    者: # Qifeng.zou # 2016.11.25 23:18:18 #
+ ******************************************************************************/
+func (dict *IpDict) query4(ip_int uint32) *IpDictItem {
+	var low, mid, high int
+
+	if 0 == dict.num || 0 == ip_int {
 		return nil
 	}
 
@@ -178,6 +298,35 @@ func (dict *IpDict) Query(ipv4 string) *IpDictItem {
 	return nil
 }
 
+/******************************************************************************
+ **函数名称: query6
+ **功    能: 查询IPv6字典
+ **输入参数:
+ **     ip: IPv6地址
+ **输出参数: NONE
+ **返    回: IP选项(国家+运营商)
+ **实现描述: 二分查找第一个结束IP不小于ip的地址段, 再判断ip是否落在该地址段内.
+ **注意事项: 地址段按起始IP升序排列且互不重叠(加载时已校验)
+ **作    者: # agent # 2026.10.18 06:49:39 #
+ ******************************************************************************/
+func (dict *IpDict) query6(ip Ipv6Int) *IpDictItem {
+	num := len(dict.items6)
+
+	idx := sort.Search(num, func(i int) bool {
+		return dict.items6[i].end_ip6.Compare(ip) >= 0
+	})
+	if idx < num && dict.items6[idx].start_ip6.Compare(ip) <= 0 {
+		return &dict.items6[idx] // found
+	}
+
+	return nil
+}
+
+/* 获取字典条目数(IPv4+IPv6) */
+func (dict *IpDict) GetNum() int {
+	return dict.num + len(dict.items6)
+}
+
 /* 获取国家或地区 */
 func (item *IpDictItem) GetNation() string {
 	return item.nation
@@ -0,0 +1,69 @@
+package comm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+/* 测试字典(IPv4与IPv6混排, 各自按起始IP升序排列) */
+const test_ip_dict = `1.0.0.0,1.0.0.255,AU,-,-,-,1,APNIC
+1.0.1.0,1.0.3.255,CN,-,-,-,2,CHINANET
+2001:200::,2001:200:ffff:ffff:ffff:ffff:ffff:ffff,JP,-,-,-,3,WIDE
+2400:da00::,2400:da00:ffff:ffff:ffff:ffff:ffff:ffff,CN,-,-,-,4,ALIYUN
+2408:8000::,2408:8fff:ffff:ffff:ffff:ffff:ffff:ffff,CN,-,-,-,5,UNICOM
+`
+
+func TestIpDictQueryIpv6(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ipdict")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ipdict.txt")
+	if err = ioutil.WriteFile(path, []byte(test_ip_dict), 0644); nil != err {
+		t.Fatal(err)
+	}
+
+	dict, err := LoadIpDict(path)
+	if nil != err {
+		t.Fatalf("LoadIpDict failed! errmsg:%s", err.Error())
+	} else if 5 != dict.GetNum() {
+		t.Fatalf("GetNum() = %d, want 5", dict.GetNum())
+	}
+
+	cases := []struct {
+		ip       string
+		operator string // 空:查不到
+	}{
+		{"2001:200::1", "WIDE"},                             // 地址段内
+		{"2001:200::", "WIDE"},                              // 起始IP
+		{"2001:200:ffff:ffff:ffff:ffff:ffff:ffff", "WIDE"},  // 结束IP
+		{"2001:201::", ""},                                  // 地址段之间
+		{"2400:da00:1234::8", "ALIYUN"},                     // 中间地址段
+		{"2408:8abc::1", "UNICOM"},                          // 最后一个地址段
+		{"2409::1", ""},                                     // 大于所有地址段
+		{"::1", ""},                                         // 小于所有地址段
+		{"2001:0200:0000:0000:0000:0000:0000:0001", "WIDE"}, // 非压缩格式
+		{"::ffff:1.0.2.3", "CHINANET"},                      // IPv4映射地址按IPv4查询
+		{"1.0.0.8", "APNIC"},                                // IPv4
+		{"2001:200::zz", ""},                                // 非法地址
+	}
+
+	for _, c := range cases {
+		item := dict.Query(c.ip)
+		if "" == c.operator {
+			if nil != item {
+				t.Errorf("Query(%s) = %s, want nil", c.ip, item.GetOperator())
+			}
+			continue
+		} else if nil == item {
+			t.Errorf("Query(%s) = nil, want %s", c.ip, c.operator)
+			continue
+		} else if c.operator != item.GetOperator() {
+			t.Errorf("Query(%s) = %s, want %s", c.ip, item.GetOperator(), c.operator)
+		}
+	}
+}
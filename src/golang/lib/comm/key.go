@@ -1,17 +1,26 @@
 package comm
 
 const (
-	IM_FMT_IP_PORT_STR     = "%s:%d"           //| IP+PORT
-	CHAT_FMT_UID_SID_STR   = "%d:%d"           // 格式:${UID}:${SID} 说明:主键CHAT_KEY_RID_TO_UID_SID_ZSET的成员
-	CHAT_FMT_UID_MSGID_STR = "uid:%d:msgid:%d" //| STRING | UID+MSGID
+	IM_FMT_IP_PORT_STR      = "%s:%d"           //| IP+PORT
+	IM_FMT_IP6_PORT_STR     = "[%s]:%d"         //| IPv6+PORT
+	CHAT_FMT_UID_SID_STR    = "%d:%d"           // 格式:${UID}:${SID} 说明:主键CHAT_KEY_RID_TO_UID_SID_ZSET的成员
+	CHAT_FMT_UID_MSGID_STR  = "uid:%d:msgid:%d" //| STRING | UID+MSGID
+	CHAT_FMT_SID_MSGID_STR  = "%d:%d"           // 格式:${SID}:${MSGID} 说明:主键CHAT_KEY_USR_SEND_MESG_HTAB的字段
+	CHAT_FMT_OFFLINE_STR    = "%d:%d:%d"        // 格式:${SUID}:${SID}:${MSGID} 说明:主键CHAT_KEY_USR_OFFLINE_ZSET的成员
+	CHAT_FMT_RECEIPT_STR    = "%d:%d:%d:%d:%d"  // 格式:${CMD}:${SID}:${MSGID}:${DUID}:${TIME} 说明:主键CHAT_KEY_USR_RECEIPT_ZSET的成员
+	CHAT_FMT_SENT_STR       = "%d:%d:%d"        // 格式:${SID}:${MSGID}:${DUID} 说明:主键CHAT_KEY_USR_SENT_ZSET的成员
+	CHAT_FMT_GROUP_SENT_STR = "%d:%d"           // 格式:${MSGID}:${UID} 说明:主键CHAT_KEY_GROUP_SENT_ZSET的成员
+	CHAT_FMT_CONV_STR       = "%d:%d"           // 格式:${TYPE}:${ID} 说明:主键CHAT_KEY_USR_CONV_ZSET的成员
 )
 
 /* 侦听层结点属性 */
 const (
 	IM_LSND_ATTR_ADDR       = "ATTR"        //| IP地址
+	IM_LSND_ATTR_ADDR6      = "ADDR6"       //| IPv6地址(双栈结点)
 	IM_LSND_ATTR_PORT       = "PORT"        //| 侦听PORT
 	IM_LSND_ATTR_TYPE       = "TYPE"        //| 侦听层类型(0:未知 1:TCP 2:WS)
-	IM_LSND_ATTR_STATUS     = "STATUS"      //| 侦听层状态
+	IM_LSND_ATTR_STATUS     = "STATUS"      //| 侦听层状态(侦听层上报)
+	IM_LSND_ATTR_MANUAL     = "MANUAL"      //| 侦听层状态(人工设置, 优先于上报状态)
 	IM_LSND_ATTR_CONNECTION = "CONNECTIONS" //| 在线连接数
 )
 
@@ -24,41 +33,88 @@ const (
 
 /* 群组属性 */
 const (
-	CHAT_GID_ATTR_SWITCH = "SWITCH" //| 开关状态
+	CHAT_GID_ATTR_SWITCH       = "SWITCH"       //| 开关状态
+	CHAT_GID_ATTR_INVITE_AUDIT = "INVITE-AUDIT" //| 邀请入群是否需审核(0:否 1:是)
+)
+
+/* 用户离线通知属性 */
+const (
+	CHAT_NOTIFY_ATTR_QUIET_START = "QUIET-START" //| 免打扰开始时间(格式:HHMM)
+	CHAT_NOTIFY_ATTR_QUIET_END   = "QUIET-END"   //| 免打扰结束时间(格式:HHMM)
+	CHAT_NOTIFY_ATTR_QUIET_TZ    = "QUIET-TZ"    //| 免打扰时段所用时区(UTC偏移分钟数, 如:480表示UTC+8)
 )
 
 //#IM系统REDIS键值定义列表
 const (
 	//|**宏**|**键值**|**类型**|**描述**|**备注**|
-	IM_KEY_SID_ZSET       = "im:sid:zset"          //*| ZSET | 会话SID集合 | 成员:SID 分值:TTL |
-	IM_KEY_UID_ZSET       = "im:uid:zset"          //| ZSET | 用户UID集合 | 成员:UID 分值:TTL |
-	IM_KEY_SID_INCR       = "im:sid:incr"          //*| STRING | 会话SID增量器 | 只增不减 注意:sid不能为0 |
-	IM_KEY_SID_ATTR       = "im:sid:%d:attr"       //*| HASH | 会话SID属性 | 包含UID/NID |
-	IM_KEY_UID_TO_SID_SET = "im:uid:%d:to:sid:set" //| SET | 用户UID对应的会话SID集合 | SID集合 |
+	IM_KEY_SID_ZSET        = "im:sid:zset"           //*| ZSET | 会话SID集合 | 成员:SID 分值:TTL |
+	IM_KEY_UID_ZSET        = "im:uid:zset"           //| ZSET | 用户UID集合 | 成员:UID 分值:TTL |
+	IM_KEY_SID_INCR        = "im:sid:incr"           //*| STRING | 会话SID增量器 | 只增不减 注意:sid不能为0 |
+	IM_KEY_SID_ATTR        = "im:sid:%d:attr"        //*| HASH | 会话SID属性 | 包含CID/UID/NID/APP/VERSION/TERMINAL/ONLINE_TM |
+	IM_KEY_UID_TO_SID_SET  = "im:uid:%d:to:sid:set"  //| SET | 用户UID对应的会话SID集合 | SID集合 |
+	IM_KEY_APP_TO_SID_ZSET = "im:app:%s:to:sid:zset" //| ZSET | 应用APP对应的会话SID集合 | 成员:SID 分值:TTL |
+
+	//|**宏**|**键值**|**类型**|**描述**|**备注**|
+	//鉴权
+	IM_KEY_TOKEN_REVOKE_UID_ZSET = "im:token:revoke:uid:zset" //| ZSET | TOKEN吊销列表(按用户) | 成员:UID 分值:吊销时间 说明:签发时间不晚于吊销时间的TOKEN均失效 |
+	IM_KEY_TOKEN_REVOKE_SID_ZSET = "im:token:revoke:sid:zset" //| ZSET | TOKEN吊销列表(按会话) | 成员:SID 分值:吊销时间 说明:签发时间不晚于吊销时间的TOKEN均失效 |
+
+	//|**宏**|**键值**|**类型**|**描述**|**备注**|
+	//上下线通知
+	IM_KEY_PRESENCE_PENDING_ZSET = "im:presence:pending:zset"    //| ZSET | 待通知上下线状态的用户 | 成员:UID 分值:通知时间(防抖) |
+	IM_KEY_PRESENCE_ONLINE_SET   = "im:presence:online:set"      //| SET | 已通知上线的用户 | 成员:UID |
+	IM_KEY_PRESENCE_SUB_ZSET     = "im:uid:%d:presence:sub:zset" //| ZSET | 订阅某用户上下线通知的会话 | 成员:SID 分值:TTL |
 
 	//|**宏**|**键值**|**类型**|**描述**|**备注**|
 	//私聊
 	CHAT_KEY_USR_SEND_MESG_HTAB        = "chat:uid:%d:send:mesg:htab"     //| HTAB | 用户发送的私聊消息 | 字段:消息ID 内容:消息内容 |
 	CHAT_KEY_PRIVATE_MESG_TIMEOUT_ZSET = "chat:private:mesg:timeout:zset" //| ZSET | 私聊消息超时管理 | 成员:消息ID 分值:发起时间 |
-	CHAT_KEY_USR_OFFLINE_ZSET          = "chat:uid:%d:offline:zset"       //| ZSET | 用户离线数据队列 | 成员:消息ID 分值:发起时间 |
+	CHAT_KEY_USR_OFFLINE_ZSET          = "chat:uid:%d:offline:zset"       //| ZSET | 用户离线数据队列 | 成员:CHAT_FMT_OFFLINE_STR 分值:离线消息序列号 |
+	CHAT_KEY_USR_OFFLINE_SEQ_INCR      = "chat:uid:%d:offline:seq:incr"   //| STRING | 用户离线消息序列号增量器 | 只增不减(用作同步游标), 初始值不小于离线队列中的旧分值(时间戳) |
 	CHAT_KEY_USR_BLACKLIST_TAB         = "chat:uid:%d:blacklist:tab"      //| HASH | 用户黑名单记录 | 成员:用户UID FIELD:被踢用户UID VALUE:加入黑名单的时间 |
 	CHAT_KEY_USR_GAG_ZSET              = "chat:uid:%d:gag:zset"           //| ZSET | 用户禁言记录 | 成员:用户UID 分值:设置禁言的时间 |
+	CHAT_KEY_USR_MARK_TAB              = "chat:uid:%d:mark:tab"           //| HASH | 用户备注列表 | FIELD:被备注用户UID VALUE:备注名 |
+	CHAT_KEY_USR_FRIEND_ZSET           = "chat:uid:%d:friend:zset"        //| ZSET | 用户好友列表 | 成员:好友UID 分值:成为好友的时间 |
+	CHAT_KEY_USR_FRIEND_REQ_TAB        = "chat:uid:%d:friend:req:tab"     //| HASH | 待处理的好友申请 | FIELD:申请人UID VALUE:申请附言 |
+	CHAT_KEY_USR_RECEIPT_ZSET          = "chat:uid:%d:receipt:zset"       //| ZSET | 用户所发私聊消息的回执 | 成员:CHAT_FMT_RECEIPT_STR 分值:回执序列号 |
+	CHAT_KEY_USR_RECEIPT_SEQ_INCR      = "chat:uid:%d:receipt:seq:incr"   //| STRING | 用户回执序列号增量器 | 只增不减(用作回执同步游标) |
+	CHAT_KEY_USR_SENT_ZSET             = "chat:uid:%d:sent:zset"          //| ZSET | 用户所发私聊消息索引 | 成员:CHAT_FMT_SENT_STR 分值:发送时间 说明:用于校验消息回执及撤回 |
+	CHAT_KEY_USR_REVOKE_ZSET           = "chat:uid:%d:revoke:zset"        //| ZSET | 用户已撤回的私聊消息 | 成员:CHAT_FMT_SID_MSGID_STR 分值:撤回时间 说明:消息尚未入库时由存储任务补标撤回 |
+	CHAT_KEY_USR_CONV_ZSET             = "chat:uid:%d:conv:zset"          //| ZSET | 用户会话列表 | 成员:CHAT_FMT_CONV_STR 分值:最近消息时间 |
+	CHAT_KEY_USR_CONV_LAST_TAB         = "chat:uid:%d:conv:last:htab"     //| HASH | 用户会话最近消息 | FIELD:CHAT_FMT_CONV_STR VALUE:最近消息预览(JSON) |
+	CHAT_KEY_USR_CONV_UNREAD_TAB       = "chat:uid:%d:conv:unread:htab"   //| HASH | 用户会话未读数 | FIELD:CHAT_FMT_CONV_STR VALUE:未读消息数 |
+	CHAT_KEY_USR_DEV_TOKEN_TAB         = "chat:uid:%d:dev:token:htab"     //| HASH | 用户设备推送TOKEN | FIELD:设备TOKEN VALUE:平台(ios/android等) |
+	CHAT_KEY_DEV_TOKEN_TO_UID_TAB      = "chat:dev:token:to:uid:htab"     //| HASH | 设备TOKEN所属用户 | FIELD:设备TOKEN VALUE:UID 说明:同一设备只归属最近注册的用户 |
+	CHAT_KEY_USR_NOTIFY_ATTR           = "chat:uid:%d:notify:attr"        //| HASH | 用户离线通知属性 | 字段见CHAT_NOTIFY_ATTR_* |
+	CHAT_KEY_USR_NOTIFY_RATE           = "chat:uid:%d:notify:rate:%d"     //| STRING | 用户离线通知计数 | ${UID}:${分钟数} 用于频率限制, 自动过期 |
+	//|**宏**|**键值**|**类型**|**描述**|**备注**|
+	//推送
+	CHAT_KEY_PUSH_MSGID_INCR     = "chat:push:msgid:incr"         //| STRING | 推送消息ID增量器 | 只增不减 |
+	CHAT_KEY_PUSH_MESG           = "chat:push:%d:mesg"            //| HASH | 推送消息内容 | DATA:透传数据 VERSION:APP版本 到达过期时间后自动删除 |
+	CHAT_KEY_USR_PUSH_ZSET       = "chat:uid:%d:push:zset"        //| ZSET | 用户离线推送队列 | 成员:推送消息ID 分值:过期时间 |
+	CHAT_KEY_USR_PUSH_CURSOR_TAB = "chat:uid:%d:push:cursor:htab" //| HASH | 用户公共推送接收进度 | FIELD:APP名(全员推送为*) VALUE:已接收的最大推送消息ID |
+	CHAT_KEY_APP_PUSH_ZSET       = "chat:app:%s:push:zset"        //| ZSET | 应用离线推送队列 | 成员:推送消息ID 分值:过期时间 |
+	CHAT_KEY_BC_PUSH_ZSET        = "chat:bc:push:zset"            //| ZSET | 全员离线推送队列 | 成员:推送消息ID 分值:过期时间 |
 	//|**宏**|**键值**|**类型**|**描述**|**备注**|
 	//群聊
 	CHAT_KEY_GID_INCR                = "chat:gid:incr"                 //*| STRING | 群组GID记录器|
 	CHAT_KEY_GID_ZSET                = "chat:gid:zset"                 //| ZSET | 群ID集合 | 成员:GID 分值:TTL |
 	CHAT_KEY_GID_ATTR                = "chat:gid:%d:attr"              //*| HASH |群组属性信息| SWITCH:(0:打开 1:关闭) |
-	CHAT_KEY_UID_TO_GID              = "chat:uid:%d:to:gid:htab"       //| HASH | 用户UID对应的GID集合 | 成员:GID 分值:0 |
+	CHAT_KEY_UID_TO_GID              = "chat:uid:%d:to:gid:htab"       //| HASH | 用户UID对应的GID集合 | 成员:GID 值:已读的最大群消息ID(群聊消息同步游标) |
 	CHAT_KEY_GID_TO_NID_ZSET         = "chat:gid:%d:to:nid:zset"       //| ZSET | 某群->帧听层 | 成员:NID 分值:TTL |
 	CHAT_KEY_GROUP_CAP_ZSET          = "chat:group:cap:zset"           //*| ZSET | 群组容量 | 成员:GID 分值:容量 |
 	CHAT_KEY_GID_TO_UID_ZSET         = "chat:gid:%d:to:uid:zset"       //| ZSET | 某群在线用户列表 | 成员:UID 分值:TTL |
 	CHAT_KEY_GID_TO_SID_ZSET         = "chat:gid:%d:to:sid:zset"       //| ZSET | 某群SID列表 | 成员:SID 分值:TTL |
 	CHAT_KEY_GROUP_MESG_QUEUE        = "chat:gid:%d:mesg:queue"        //| LIST | 群聊消息队列 |
-	CHAT_KEY_GROUP_MSGID_INCR        = "chat:gid:%d:msgid:incr"        //| STRING | 群聊消息序列递增记录 |
+	CHAT_KEY_GROUP_MSGID_INCR        = "chat:gid:%d:msgid:incr"        //| STRING | 群聊消息序列递增记录 | 即当前最大群消息ID |
 	CHAT_KEY_GROUP_USR_GAG_SET       = "chat:gid:%d:usr:gag:set"       //*| SET | 群组用户禁言名单 | 成员:UID |
 	CHAT_KEY_GROUP_USR_BLACKLIST_SET = "chat:gid:%d:usr:blacklist:set" //*| SET | 群组用户黑名单 | 成员:UID |
 	CHAT_KEY_GROUP_ROLE_TAB          = "chat:gid:%d:role:tab"          //*| HASH | 群组管理人员名单 | 成员:UID 值:角色(1:OWNER 2:管理员) |
 	CHAT_KEY_GROUP_INFO_TAB          = "chat:gid:%d:info:tab"          //*| HASH | 群组基本信息管理 |
+	CHAT_KEY_GROUP_USR_ZSET          = "chat:gid:%d:usr:zset"          //| ZSET | 群组成员列表 | 成员:UID 分值:入群时间 |
+	CHAT_KEY_GROUP_JOIN_PENDING_TAB  = "chat:gid:%d:join:pending:htab" //| HASH | 群组待审核的入群申请 | 键:申请人UID 值:邀请人UID(0:主动申请) |
+	CHAT_KEY_GROUP_SENT_ZSET         = "chat:gid:%d:sent:zset"         //| ZSET | 群聊消息索引 | 成员:CHAT_FMT_GROUP_SENT_STR 分值:发送时间 说明:用于校验撤回 |
+	CHAT_KEY_GROUP_REVOKE_ZSET       = "chat:gid:%d:revoke:zset"       //| ZSET | 已撤回的群聊消息 | 成员:群消息ID 分值:撤回时间 说明:消息尚未入库时由存储任务补标撤回 |
 	//|**宏**|**键值**|**类型**|**描述**|**备注**|
 	IM_KEY_LSND_TYPE_ZSET      = "im:lsnd:type:zset"                           //| ZSET | 帧听层"类型"集合 | 成员:"网络类型" 分值:TTL |
 	IM_KEY_LSND_NATION_ZSET    = "im:lsnd:type:%d:nation:zset"                 //| ZSET | 某"类型"的帧听层"地区/国家"集合 | 成员:"国家/地区" 分值:TTL |
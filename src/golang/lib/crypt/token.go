@@ -0,0 +1,193 @@
+package crypt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* 签名TOKEN版本 */
+const TOKEN_VERSION = "v1"
+
+/* TOKEN内容 */
+type Token struct {
+	Uid uint64 // 用户ID
+	Sid uint64 // 会话SID
+	Ctm int64  // 签发时间
+	Ttl int64  // 过期时间
+	Kid string // 签名密钥ID
+}
+
+/* TOKEN签名对象 */
+type TokenCtx struct {
+	kid  string            // 当前签名密钥ID
+	keys map[string][]byte // 密钥列表(密钥ID -> 密钥)
+}
+
+/******************************************************************************
+ **函数名称: CreateTokenCtx
+ **功    能: 创建TOKEN签名对象
+ **输入参数:
+ **     kid: 当前签名密钥ID
+ **     keys: 密钥列表(密钥ID -> 密钥)
+ **输出参数: NONE
+ **返    回:
+ **     ctx: 签名对象
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **     1. 签发时使用kid对应的密钥, 校验时根据TOKEN中的密钥ID查找密钥, 以此支持密钥轮换;
+ **     2. 密钥ID中不能包含'.'字符.
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func CreateTokenCtx(kid string, keys map[string]string) (ctx *TokenCtx, err error) {
+	ctx = &TokenCtx{
+		kid:  kid,
+		keys: make(map[string][]byte),
+	}
+
+	for id, key := range keys {
+		if "" == id || strings.Contains(id, ".") || "" == key {
+			return nil, errors.New(fmt.Sprintf("Token key is invalid! kid:%s", id))
+		}
+		ctx.keys[id] = []byte(key)
+	}
+
+	if _, ok := ctx.keys[kid]; !ok {
+		return nil, errors.New(fmt.Sprintf("Didn't find sign key! kid:%s", kid))
+	}
+
+	return ctx, nil
+}
+
+/******************************************************************************
+ **函数名称: sign
+ **功    能: 计算签名
+ **输入参数:
+ **     key: 密钥
+ **     data: 待签名数据
+ **输出参数: NONE
+ **返    回: 签名(BASE64编码)
+ **实现描述: HMAC-SHA256
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (ctx *TokenCtx) sign(key []byte, data string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+/******************************************************************************
+ **函数名称: Sign
+ **功    能: 签发TOKEN
+ **输入参数:
+ **     uid: 用户ID
+ **     sid: 会话SID
+ **     ctm: 签发时间
+ **     ttl: 过期时间
+ **输出参数: NONE
+ **返    回: TOKEN字串
+ **实现描述:
+ **注意事项:
+ **     TOKEN的格式"v1.${kid}.${payload}.${sign}"
+ **     kid: 签名密钥ID
+ **     payload: "uid:${uid}:sid:${sid}:ctm:${ctm}:ttl:${ttl}"的BASE64编码
+ **     sign: 对"v1.${kid}.${payload}"的HMAC-SHA256签名的BASE64编码
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (ctx *TokenCtx) Sign(uid uint64, sid uint64, ctm int64, ttl int64) string {
+	payload := fmt.Sprintf("uid:%d:sid:%d:ctm:%d:ttl:%d", uid, sid, ctm, ttl)
+
+	data := fmt.Sprintf("%s.%s.%s", TOKEN_VERSION, ctx.kid,
+		base64.RawURLEncoding.EncodeToString([]byte(payload)))
+
+	return fmt.Sprintf("%s.%s", data, ctx.sign(ctx.keys[ctx.kid], data))
+}
+
+/******************************************************************************
+ **函数名称: Verify
+ **功    能: 校验TOKEN
+ **输入参数:
+ **     token: TOKEN字串
+ **输出参数: NONE
+ **返    回:
+ **     tk: TOKEN内容
+ **     err: 错误描述
+ **实现描述: 校验签名并提取TOKEN内容
+ **注意事项: 不校验是否过期及是否被吊销, 由调用者负责.
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func (ctx *TokenCtx) Verify(token string) (tk *Token, err error) {
+	words := strings.Split(token, ".")
+	if 4 != len(words) || TOKEN_VERSION != words[0] {
+		return nil, errors.New("Token format not right!")
+	}
+
+	/* > 校验签名 */
+	key, ok := ctx.keys[words[1]]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Unknown token key! kid:%s", words[1]))
+	}
+
+	data := fmt.Sprintf("%s.%s.%s", words[0], words[1], words[2])
+	if !hmac.Equal([]byte(ctx.sign(key, data)), []byte(words[3])) {
+		return nil, errors.New("Token sign is invalid!")
+	}
+
+	/* > 提取TOKEN内容 */
+	payload, err := base64.RawURLEncoding.DecodeString(words[2])
+	if nil != err {
+		return nil, err
+	}
+
+	fields := strings.Split(string(payload), ":")
+	if 8 != len(fields) {
+		return nil, errors.New("Token payload not right!")
+	}
+
+	tk = &Token{Kid: words[1]}
+
+	tk.Uid, _ = strconv.ParseUint(fields[1], 10, 64)
+	tk.Sid, _ = strconv.ParseUint(fields[3], 10, 64)
+	tk.Ctm, _ = strconv.ParseInt(fields[5], 10, 64)
+	tk.Ttl, _ = strconv.ParseInt(fields[7], 10, 64)
+
+	return tk, nil
+}
+
+/******************************************************************************
+ **函数名称: IsSignedToken
+ **功    能: 判断是否为签名TOKEN
+ **输入参数:
+ **     token: TOKEN字串
+ **输出参数: NONE
+ **返    回: true:签名TOKEN false:旧版TOKEN
+ **实现描述: 旧版TOKEN为标准BASE64编码, 不会包含'.'字符.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func IsSignedToken(token string) bool {
+	return strings.HasPrefix(token, TOKEN_VERSION+".")
+}
+
+/******************************************************************************
+ **函数名称: TokenDigest
+ **功    能: 计算TOKEN摘要
+ **输入参数:
+ **     token: TOKEN字串
+ **输出参数: NONE
+ **返    回: TOKEN摘要(SHA256的前16个十六进制字符)
+ **实现描述:
+ **注意事项: 用于日志输出, 防止完整TOKEN泄露到日志中.
+ **作    者: # agent # 2026.10.18 07:42:45 #
+ ******************************************************************************/
+func TokenDigest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%x", sum[:8])
+}
@@ -0,0 +1,230 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+/* 历史消息查询条件 */
+type HistoryParam struct {
+	Sender uint64 // 发送者UID(0:不过滤)
+	Stm    int64  // 起始时间(0:不限制)
+	Etm    int64  // 截止时间(0:不限制)
+	Msgid  uint64 // 消息ID游标(0:不限制 不包含游标本身)
+	Cursor string // 私聊分页游标(空:不限制 不包含游标本身 格式:${CTM}:${ID})
+	Asc    bool   // 是否从旧到新(false:从新到旧)
+	Num    int    // 获取条数
+}
+
+/******************************************************************************
+ **函数名称: HistoryParamParse
+ **功    能: 解析历史消息的公共查询条件
+ **输入参数:
+ **     get: 请求参数获取函数
+ **输出参数: NONE
+ **返    回: 查询条件
+ **实现描述: 获取数目为0时取HISTORY_DEF_NUM, 最多HISTORY_MAX_NUM条.
+ **注意事项:
+ **     请求参数: sender: 发送者UID(O) stm: 起始时间(O) etm: 截止时间(O)
+ **               msgid: 消息ID游标(O) cursor: 私聊分页游标(O)
+ **               order: 排序方式(O) num: 获取数目(O)
+ **作    者: # agent # 2026.10.18 07:55:26 #
+ ******************************************************************************/
+func HistoryParamParse(get func(key string) string) *HistoryParam {
+	param := &HistoryParam{}
+
+	param.Sender, _ = strconv.ParseUint(get("sender"), 10, 64)
+	param.Stm, _ = strconv.ParseInt(get("stm"), 10, 64)
+	param.Etm, _ = strconv.ParseInt(get("etm"), 10, 64)
+	param.Msgid, _ = strconv.ParseUint(get("msgid"), 10, 64)
+	param.Cursor = get("cursor")
+	param.Asc = ("asc" == get("order"))
+
+	num, _ := strconv.ParseInt(get("num"), 10, 32)
+	if 0 >= num {
+		num = comm.HISTORY_DEF_NUM
+	} else if num > comm.HISTORY_MAX_NUM {
+		num = comm.HISTORY_MAX_NUM
+	}
+	param.Num = int(num)
+
+	return param
+}
+
+/******************************************************************************
+ **函数名称: HistoryCtmCond
+ **功    能: 生成发送时间的过滤条件
+ **输入参数:
+ **     param: 查询条件
+ **输出参数: NONE
+ **返    回: 过滤条件(不限制时返回nil)
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:15:52 #
+ ******************************************************************************/
+func HistoryCtmCond(param *HistoryParam) bson.M {
+	if 0 == param.Stm && 0 == param.Etm {
+		return nil
+	}
+
+	cond := bson.M{}
+	if 0 != param.Stm {
+		cond["$gte"] = param.Stm
+	}
+	if 0 != param.Etm {
+		cond["$lte"] = param.Etm
+	}
+
+	return cond
+}
+
+/******************************************************************************
+ **函数名称: ChatCursor
+ **功    能: 生成私聊分页游标
+ **输入参数:
+ **     row: 私聊消息
+ **输出参数: NONE
+ **返    回: 分页游标(格式:${CTM}:${ID})
+ **实现描述: 发送时间只精确到秒, 因此附加记录ID以区分同一秒内的消息.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:55:26 #
+ ******************************************************************************/
+func ChatCursor(row *ChatMesgTabRow) string {
+	return fmt.Sprintf("%d:%s", row.Ctm, row.Id.Hex())
+}
+
+/******************************************************************************
+ **函数名称: chat_cursor_parse
+ **功    能: 解析私聊分页游标
+ **输入参数:
+ **     cursor: 分页游标(格式:${CTM}:${ID})
+ **输出参数: NONE
+ **返    回:
+ **     ctm: 发送时间
+ **     id: 记录ID
+ **     err: 错误描述
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:55:26 #
+ ******************************************************************************/
+func chat_cursor_parse(cursor string) (ctm int64, id bson.ObjectId, err error) {
+	fields := strings.SplitN(cursor, ":", 2)
+	if 2 != len(fields) {
+		return 0, "", errors.New("Cursor format is invalid!")
+	}
+
+	ctm, err = strconv.ParseInt(fields[0], 10, 64)
+	if nil != err {
+		return 0, "", errors.New("Cursor time is invalid!")
+	} else if !bson.IsObjectIdHex(fields[1]) {
+		return 0, "", errors.New("Cursor id is invalid!")
+	}
+
+	return ctm, bson.ObjectIdHex(fields[1]), nil
+}
+
+/******************************************************************************
+ **函数名称: ChatHistoryCond
+ **功    能: 生成私聊历史消息的查询条件
+ **输入参数:
+ **     uid: 用户UID
+ **     peer: 对端UID(0:该用户的所有私聊消息)
+ **     param: 查询条件
+ **输出参数: NONE
+ **返    回:
+ **     cond: 过滤条件
+ **     sort: 排序方式
+ **     err: 错误描述
+ **实现描述: 按(ctm, _id)排序及分页:
+ **     从新到旧: ctm < 游标ctm, 或ctm = 游标ctm且_id < 游标_id;
+ **     从旧到新: ctm > 游标ctm, 或ctm = 游标ctm且_id > 游标_id.
+ **注意事项: 私聊消息ID由发送方会话分配, 不是全局有序的, 因此不支持按msgid分页.
+ **作    者: # agent # 2026.10.18 07:55:26 #
+ ******************************************************************************/
+func ChatHistoryCond(uid uint64, peer uint64,
+	param *HistoryParam) (cond bson.M, sort []string, err error) {
+	if 0 != param.Msgid {
+		return nil, nil, errors.New("Paramter [msgid] isn't supported, use [cursor]!")
+	}
+
+	cond = bson.M{}
+	if 0 == peer {
+		cond["$or"] = []bson.M{{"suid": uid}, {"duid": uid}}
+	} else {
+		cond["$or"] = []bson.M{{"suid": uid, "duid": peer}, {"suid": peer, "duid": uid}}
+	}
+
+	if 0 != param.Sender {
+		cond["suid"] = param.Sender
+	}
+
+	if ctm := HistoryCtmCond(param); nil != ctm {
+		cond["ctm"] = ctm
+	}
+
+	op := "$lt"
+	sort = []string{"-ctm", "-_id"}
+	if param.Asc {
+		op = "$gt"
+		sort = []string{"ctm", "_id"}
+	}
+
+	/* > 分页游标 */
+	if "" != param.Cursor {
+		ctm, id, err := chat_cursor_parse(param.Cursor)
+		if nil != err {
+			return nil, nil, err
+		}
+
+		cond["$and"] = []bson.M{{"$or": []bson.M{
+			{"ctm": bson.M{op: ctm}},
+			{"ctm": ctm, "_id": bson.M{op: id}},
+		}}}
+	}
+
+	return cond, sort, nil
+}
+
+/******************************************************************************
+ **函数名称: GroupHistoryCond
+ **功    能: 生成群聊历史消息的查询条件
+ **输入参数:
+ **     gid: 群组ID
+ **     param: 查询条件
+ **输出参数: NONE
+ **返    回:
+ **     cond: 过滤条件
+ **     sort: 排序方式
+ **实现描述: 按群消息ID排序及分页
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:55:26 #
+ ******************************************************************************/
+func GroupHistoryCond(gid uint64, param *HistoryParam) (cond bson.M, sort string) {
+	cond = bson.M{"gid": gid}
+
+	if 0 != param.Sender {
+		cond["uid"] = param.Sender
+	}
+
+	if ctm := HistoryCtmCond(param); nil != ctm {
+		cond["ctm"] = ctm
+	}
+
+	sort = "-msgid"
+	if param.Asc {
+		sort = "msgid"
+		if 0 != param.Msgid {
+			cond["msgid"] = bson.M{"$gt": param.Msgid}
+		}
+	} else if 0 != param.Msgid {
+		cond["msgid"] = bson.M{"$lt": param.Msgid}
+	}
+
+	return cond, sort
+}
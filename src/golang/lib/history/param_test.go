@@ -0,0 +1,112 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+func TestHistoryParamParse(t *testing.T) {
+	cases := []struct {
+		name  string
+		query map[string]string
+		num   int
+		asc   bool
+	}{
+		{"default num", map[string]string{}, comm.HISTORY_DEF_NUM, false},
+		{"negative num", map[string]string{"num": "-1"}, comm.HISTORY_DEF_NUM, false},
+		{"normal num", map[string]string{"num": "50", "order": "asc"}, 50, true},
+		{"max num", map[string]string{"num": "100000"}, comm.HISTORY_MAX_NUM, false},
+	}
+
+	for _, c := range cases {
+		param := HistoryParamParse(func(key string) string { return c.query[key] })
+		if param.Num != c.num || param.Asc != c.asc {
+			t.Errorf("%s: num:%d asc:%v, want num:%d asc:%v",
+				c.name, param.Num, param.Asc, c.num, c.asc)
+		}
+	}
+}
+
+func TestChatHistoryCond(t *testing.T) {
+	id := bson.ObjectIdHex("5a1b2c3d4e5f60718293a4b5")
+	cursor := ChatCursor(&ChatMesgTabRow{Id: id, Ctm: 1500000000})
+
+	cases := []struct {
+		name  string
+		param HistoryParam
+		sort  []string
+		page  []bson.M // 分页条件(nil:无)
+		fail  bool
+	}{
+		{"first page desc", HistoryParam{}, []string{"-ctm", "-_id"}, nil, false},
+		{"first page asc", HistoryParam{Asc: true}, []string{"ctm", "_id"}, nil, false},
+		{"next page desc", HistoryParam{Cursor: cursor}, []string{"-ctm", "-_id"},
+			[]bson.M{{"$or": []bson.M{
+				{"ctm": bson.M{"$lt": int64(1500000000)}},
+				{"ctm": int64(1500000000), "_id": bson.M{"$lt": id}},
+			}}}, false},
+		{"next page asc", HistoryParam{Cursor: cursor, Asc: true}, []string{"ctm", "_id"},
+			[]bson.M{{"$or": []bson.M{
+				{"ctm": bson.M{"$gt": int64(1500000000)}},
+				{"ctm": int64(1500000000), "_id": bson.M{"$gt": id}},
+			}}}, false},
+		{"msgid not supported", HistoryParam{Msgid: 10}, nil, nil, true},
+		{"cursor without id", HistoryParam{Cursor: "1500000000"}, nil, nil, true},
+		{"cursor with bad time", HistoryParam{Cursor: "abc:" + id.Hex()}, nil, nil, true},
+		{"cursor with bad id", HistoryParam{Cursor: "1500000000:xyz"}, nil, nil, true},
+	}
+
+	for _, c := range cases {
+		cond, sort, err := ChatHistoryCond(1, 2, &c.param)
+		if c.fail {
+			if nil == err {
+				t.Errorf("%s: expect error", c.name)
+			}
+			continue
+		} else if nil != err {
+			t.Errorf("%s: unexpected error:%s", c.name, err.Error())
+			continue
+		}
+
+		if !reflect.DeepEqual(sort, c.sort) {
+			t.Errorf("%s: sort:%v, want %v", c.name, sort, c.sort)
+		}
+
+		page, ok := cond["$and"]
+		if nil == c.page {
+			if ok {
+				t.Errorf("%s: unexpected page cond:%v", c.name, page)
+			}
+		} else if !reflect.DeepEqual(page, c.page) {
+			t.Errorf("%s: page cond:%v, want %v", c.name, page, c.page)
+		}
+	}
+}
+
+func TestGroupHistoryCond(t *testing.T) {
+	cases := []struct {
+		name  string
+		param HistoryParam
+		sort  string
+		msgid interface{} // 分页条件(nil:无)
+	}{
+		{"first page desc", HistoryParam{}, "-msgid", nil},
+		{"first page asc", HistoryParam{Asc: true}, "msgid", nil},
+		{"next page desc", HistoryParam{Msgid: 100}, "-msgid", bson.M{"$lt": uint64(100)}},
+		{"next page asc", HistoryParam{Msgid: 100, Asc: true}, "msgid", bson.M{"$gt": uint64(100)}},
+	}
+
+	for _, c := range cases {
+		cond, sort := GroupHistoryCond(1, &c.param)
+		if sort != c.sort {
+			t.Errorf("%s: sort:%s, want %s", c.name, sort, c.sort)
+		}
+		if msgid := cond["msgid"]; !reflect.DeepEqual(msgid, c.msgid) {
+			t.Errorf("%s: msgid cond:%v, want %v", c.name, msgid, c.msgid)
+		}
+	}
+}
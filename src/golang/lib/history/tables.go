@@ -0,0 +1,35 @@
+package history
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+/* MONGO集合(由msgsvr写入, usrsvr查询) */
+const (
+	TAB_CHAT_MESG  = "chat-mesg"  // 私聊消息
+	TAB_GROUP_MESG = "group-mesg" // 群聊消息
+)
+
+/* 私聊消息 */
+type ChatMesgTabRow struct {
+	Id      bson.ObjectId "_id,omitempty" // 记录ID(由MONGO生成, 用作分页游标)
+	Suid    uint64        "suid"          // 发送方UID
+	Duid    uint64        "duid"          // 接收方UID
+	Sid     uint64        "sid"           // 发送方会话SID
+	Msgid   uint64        "msgid"         // 消息ID
+	Ctm     int64         "ctm"           // 发送时间
+	Revoked uint32        "revoked"       // 是否已撤回(0:否 1:是)
+	Rtm     int64         "rtm"           // 撤回时间
+	Data    []byte        "data"          // 原始数据包
+}
+
+/* 群聊消息 */
+type GroupMesgTabRow struct {
+	Gid     uint64 "gid"     // 群组ID
+	Uid     uint64 "uid"     // 发送者UID
+	Msgid   uint64 "msgid"   // 群消息ID
+	Ctm     int64  "ctm"     // 发送时间
+	Revoked uint32 "revoked" // 是否已撤回(0:否 1:是)
+	Rtm     int64  "rtm"     // 撤回时间
+	Data    []byte "data"    // 原始数据包
+}
@@ -17,12 +17,16 @@ type SidAttr struct {
 	cid uint64 // 连接CID
 	uid uint64 // 用户ID
 	nid uint32 // 侦听层ID
+	app string // APP名
+	ver string // APP版本
 }
 
 func (attr *SidAttr) GetSid() uint64 { return attr.sid }
 func (attr *SidAttr) GetCid() uint64 { return attr.cid }
 func (attr *SidAttr) GetUid() uint64 { return attr.uid }
 func (attr *SidAttr) GetNid() uint32 { return attr.nid }
+func (attr *SidAttr) GetApp() string { return attr.app }
+func (attr *SidAttr) GetVer() string { return attr.ver }
 
 /******************************************************************************
  **函数名称: GetSidAttr
@@ -42,22 +46,73 @@ func GetSidAttr(pool *redis.Pool, sid uint64) (attr *SidAttr, err error) {
 	/* 获取会话属性 */
 	key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid)
 
-	vals, err := redis.Strings(rds.Do("HMGET", key, "CID", "UID", "NID"))
+	vals, err := redis.Strings(rds.Do("HMGET", key, "CID", "UID", "NID", "APP", "VERSION"))
 	if nil != err {
 		return nil, err
 	}
 
+	return sid_attr_parse(sid, vals), nil
+}
+
+/******************************************************************************
+ **函数名称: GetSidAttrList
+ **功    能: 批量获取会话属性
+ **输入参数:
+ **     pool: REDIS连接池
+ **     sid_list: 会话SID列表
+ **输出参数: NONE
+ **返    回:
+ **     list: 会话属性列表(与sid_list一一对应)
+ **     err: 错误信息
+ **实现描述: 通过管道批量获取, REDIS交互次数与会话数无关.
+ **注意事项:
+ **作    者: # agent # 2026.10.18 08:20:36 #
+ ******************************************************************************/
+func GetSidAttrList(pool *redis.Pool, sid_list []uint64) (list []*SidAttr, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	num := len(sid_list)
+	if 0 == num {
+		return nil, nil
+	}
+
+	for idx := 0; idx < num; idx += 1 {
+		key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid_list[idx])
+		rds.Send("HMGET", key, "CID", "UID", "NID", "APP", "VERSION")
+	}
+
+	replies, err := redis.Values(rds.Do(""))
+	if nil != err {
+		return nil, err
+	}
+
+	list = make([]*SidAttr, num)
+	for idx := 0; idx < num; idx += 1 {
+		vals, err := redis.Strings(replies[idx], nil)
+		if nil != err {
+			return nil, err
+		}
+		list[idx] = sid_attr_parse(sid_list[idx], vals)
+	}
+
+	return list, nil
+}
+
+/* 解析会话属性(vals: CID, UID, NID, APP, VERSION) */
+func sid_attr_parse(sid uint64, vals []string) *SidAttr {
 	cid, _ := strconv.ParseInt(vals[0], 10, 64)
 	uid, _ := strconv.ParseInt(vals[1], 10, 64)
 	nid, _ := strconv.ParseInt(vals[2], 10, 64)
 
-	attr = &SidAttr{
+	return &SidAttr{
 		sid: sid,
 		cid: uint64(cid),
 		uid: uint64(uid),
 		nid: uint32(nid),
+		app: vals[3],
+		ver: vals[4],
 	}
-	return attr, nil
 }
 
 /******************************************************************************
@@ -70,7 +125,7 @@ func GetSidAttr(pool *redis.Pool, sid uint64) (attr *SidAttr, err error) {
  **     nid: 节点ID
  **输出参数: NONE
  **返    回: 错误信息
- **实现描述:
+ **实现描述: 清理会话数据后, 标记会话所属用户的在线状态可能发生变化.
  **注意事项: 当会话属性中的cid和nid与参数不一致时, 不进行清理操作.
  **作    者: # Qifeng.zou # 2017.05.10 06:32:05 #
  ******************************************************************************/
@@ -92,6 +147,17 @@ func CleanSessionData(pool *redis.Pool, sid uint64, cid uint64, nid uint32) erro
 		return errors.New("Data is collision!") /* 数据不一致, 不进行清理操作 */
 	}
 
+	/* > 标记在线状态变化(防抖后通知好友及订阅者) */
+	if 0 != attr.GetUid() {
+		defer PresenceMark(pool, attr.GetUid())
+	}
+
+	/* > 删除APP对应的会话 */
+	if "" != attr.GetApp() {
+		key := fmt.Sprintf(comm.IM_KEY_APP_TO_SID_ZSET, attr.GetApp())
+		pl.Send("ZREM", key, sid)
+	}
+
 	/* > 删除SID对应的数据 */
 	key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid)
 
@@ -116,8 +182,8 @@ func CleanSessionData(pool *redis.Pool, sid uint64, cid uint64, nid uint32) erro
  **     sid: 会话SID
  **输出参数: NONE
  **返    回: 错误信息
- **实现描述:
- **注意事项:
+ **实现描述: 清理会话数据后, 标记会话所属用户的在线状态可能发生变化.
+ **注意事项: 会话超时(如进程崩溃)时由TASKER调用, 此时不会收到下线请求.
  **作    者: # Qifeng.zou # 2017.01.09 08:35:54 #
  ******************************************************************************/
 func CleanSessionDataBySid(pool *redis.Pool, sid uint64) error {
@@ -130,9 +196,16 @@ func CleanSessionDataBySid(pool *redis.Pool, sid uint64) error {
 		pl.Close()
 	}()
 
-	/* > 删除SID对应的数据 */
+	/* > 获取会话所属用户(清理后将无法获取) */
 	key := fmt.Sprintf(comm.IM_KEY_SID_ATTR, sid)
 
+	uid, _ := redis.Uint64(rds.Do("HGET", key, "UID"))
+	if 0 != uid {
+		defer PresenceMark(pool, uid) /* 标记在线状态变化 */
+	}
+
+	/* > 删除SID对应的数据 */
+
 	num, err := redis.Int(rds.Do("DEL", key))
 	if nil != err {
 		return err
@@ -185,6 +258,10 @@ func UpdateSessionData(pool *redis.Pool, sid uint64, cid uint64, nid uint32) (co
 	ttl := time.Now().Unix() + comm.CHAT_SID_TTL
 	pl.Send("ZADD", comm.IM_KEY_SID_ZSET, ttl, sid)
 	pl.Send("ZADD", comm.IM_KEY_UID_ZSET, ttl, attr.uid)
+	if "" != attr.app {
+		key := fmt.Sprintf(comm.IM_KEY_APP_TO_SID_ZSET, attr.app)
+		pl.Send("ZADD", key, ttl, sid)
+	}
 
 	return 0, nil
 }
@@ -0,0 +1,288 @@
+package im
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/garyburd/redigo/redis"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+/* 会话最近消息预览 */
+type ConvLast struct {
+	Uid     uint64 `json:"uid"`     // 发送者UID
+	Msgid   uint64 `json:"msgid"`   // 消息ID
+	Text    string `json:"text"`    // 消息内容(已截断 撤回后为空)
+	Revoked uint32 `json:"revoked"` // 是否已撤回(0:否 1:是)
+}
+
+/* 会话信息 */
+type ConvItem struct {
+	Type   uint32    `json:"type"`   // 会话类型(1:私聊 2:群聊 3:聊天室)
+	Id     uint64    `json:"id"`     // 会话ID(对端UID/GID)
+	Ltm    int64     `json:"ltm"`    // 最近消息时间
+	Unread uint32    `json:"unread"` // 未读消息数
+	Last   *ConvLast `json:"last"`   // 最近消息预览
+}
+
+/******************************************************************************
+ **函数名称: ConvPreview
+ **功    能: 生成最近消息预览
+ **输入参数:
+ **     uid: 发送者UID
+ **     msgid: 消息ID
+ **     text: 消息内容
+ **输出参数: NONE
+ **返    回: 最近消息预览
+ **实现描述: 消息内容最多保留CHAT_CONV_PREVIEW_LEN个字符
+ **注意事项: 按字符截断, 避免截断多字节字符
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func ConvPreview(uid uint64, msgid uint64, text string) *ConvLast {
+	runes := []rune(text)
+	if len(runes) > comm.CHAT_CONV_PREVIEW_LEN {
+		text = string(runes[:comm.CHAT_CONV_PREVIEW_LEN])
+	}
+
+	return &ConvLast{Uid: uid, Msgid: msgid, Text: text}
+}
+
+// 更新会话信息(KEYS[1]:会话列表 KEYS[2]:最近消息 KEYS[3]:未读数 ARGV[1]:最近消息时间
+// ARGV[2]:会话 ARGV[3]:最近消息预览 ARGV[4]:是否增加未读数 ARGV[5]:最大会话数)
+// 返回: 被淘汰的会话数
+var conv_update_script = redis.NewScript(3, `
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+redis.call("HSET", KEYS[2], ARGV[2], ARGV[3])
+if "1" == ARGV[4] then
+    redis.call("HINCRBY", KEYS[3], ARGV[2], 1)
+end
+local over = redis.call("ZCARD", KEYS[1]) - tonumber(ARGV[5])
+if over <= 0 then
+    return 0
+end
+local fields = redis.call("ZRANGE", KEYS[1], 0, over - 1)
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, over - 1)
+redis.call("HDEL", KEYS[2], unpack(fields))
+redis.call("HDEL", KEYS[3], unpack(fields))
+return over`)
+
+/******************************************************************************
+ **函数名称: ConvUpdate
+ **功    能: 更新用户的会话信息
+ **输入参数:
+ **     pl: REDIS连接(以PIPELINE方式发送)
+ **     uid: 用户ID
+ **     typ: 会话类型
+ **     id: 会话ID
+ **     ctm: 最近消息时间
+ **     last: 最近消息预览
+ **     unread: 是否增加未读数
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 更新会话列表的时间和最近消息, 并按需增加未读数;
+ **     会话数超过CHAT_CONV_SAVE_MAX_NUM时, 淘汰最久未更新的会话及其最近消息和未读数.
+ **注意事项: 只调用Send(), 由调用者负责Flush()或Do("").
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func ConvUpdate(pl redis.Conn, uid uint64,
+	typ uint32, id uint64, ctm int64, last *ConvLast, unread bool) {
+	field := fmt.Sprintf(comm.CHAT_FMT_CONV_STR, typ, id)
+
+	data, _ := json.Marshal(last)
+
+	incr := 0
+	if unread {
+		incr = 1
+	}
+
+	conv_update_script.Send(pl,
+		fmt.Sprintf(comm.CHAT_KEY_USR_CONV_ZSET, uid),
+		fmt.Sprintf(comm.CHAT_KEY_USR_CONV_LAST_TAB, uid),
+		fmt.Sprintf(comm.CHAT_KEY_USR_CONV_UNREAD_TAB, uid),
+		ctm, field, data, incr, comm.CHAT_CONV_SAVE_MAX_NUM)
+}
+
+// 撤回最近消息(KEYS[1]:最近消息 ARGV[1]:会话 ARGV[2]:发送者UID ARGV[3]:消息ID ARGV[4]:撤回标识)
+// 返回: 1:已替换 0:最近消息不是被撤回的消息
+var conv_revoke_script = redis.NewScript(1, `
+local data = redis.call("HGET", KEYS[1], ARGV[1])
+if not data then
+    return 0
+end
+local last = cjson.decode(data)
+if tonumber(ARGV[2]) ~= last.uid or tonumber(ARGV[3]) ~= last.msgid then
+    return 0
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[4])
+return 1`)
+
+/******************************************************************************
+ **函数名称: ConvRevoke
+ **功    能: 撤回会话的最近消息预览
+ **输入参数:
+ **     pl: REDIS连接(以PIPELINE方式发送)
+ **     uid: 用户ID
+ **     typ: 会话类型
+ **     id: 会话ID
+ **     suid: 被撤回消息的发送者UID
+ **     msgid: 被撤回消息的消息ID
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 最近消息正是被撤回的消息时, 将其替换为撤回标识(revoked为1且不含内容).
+ **注意事项: 只调用Send(), 由调用者负责Flush()或Do("").
+ **作    者: # agent # 2026.10.18 08:03:32 #
+ ******************************************************************************/
+func ConvRevoke(pl redis.Conn, uid uint64,
+	typ uint32, id uint64, suid uint64, msgid uint64) {
+	field := fmt.Sprintf(comm.CHAT_FMT_CONV_STR, typ, id)
+
+	data, _ := json.Marshal(&ConvLast{Uid: suid, Msgid: msgid, Revoked: 1})
+
+	conv_revoke_script.Send(pl,
+		fmt.Sprintf(comm.CHAT_KEY_USR_CONV_LAST_TAB, uid), field, suid, msgid, data)
+}
+
+/******************************************************************************
+ **函数名称: ConvRemove
+ **功    能: 移除用户的会话
+ **输入参数:
+ **     pl: REDIS连接(以PIPELINE方式发送)
+ **     uid: 用户ID
+ **     typ: 会话类型
+ **     id: 会话ID
+ **输出参数: NONE
+ **返    回: NONE
+ **实现描述: 从会话列表中移除, 并清除其最近消息和未读数.
+ **注意事项: 只调用Send(), 由调用者负责Flush()或Do("").
+ **作    者: # agent # 2026.10.18 08:03:32 #
+ ******************************************************************************/
+func ConvRemove(pl redis.Conn, uid uint64, typ uint32, id uint64) {
+	field := fmt.Sprintf(comm.CHAT_FMT_CONV_STR, typ, id)
+
+	pl.Send("ZREM", fmt.Sprintf(comm.CHAT_KEY_USR_CONV_ZSET, uid), field)
+	pl.Send("HDEL", fmt.Sprintf(comm.CHAT_KEY_USR_CONV_LAST_TAB, uid), field)
+	pl.Send("HDEL", fmt.Sprintf(comm.CHAT_KEY_USR_CONV_UNREAD_TAB, uid), field)
+}
+
+/******************************************************************************
+ **函数名称: ConvList
+ **功    能: 获取用户的会话列表
+ **输入参数:
+ **     pool: REDIS连接池
+ **     uid: 用户ID
+ **     num: 获取条数
+ **输出参数: NONE
+ **返    回:
+ **     list: 会话列表(按最近消息时间倒序)
+ **     err: 错误信息
+ **实现描述:
+ **注意事项:
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func ConvList(pool *redis.Pool, uid uint64, num int) (list []*ConvItem, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	/* > 获取最近的会话 */
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_CONV_ZSET, uid)
+
+	vals, err := redis.Strings(rds.Do("ZREVRANGE", key, 0, num-1, "WITHSCORES"))
+	if nil != err {
+		return nil, err
+	}
+
+	list = make([]*ConvItem, 0, len(vals)/2)
+	fields := make([]string, 0, len(vals)/2)
+	for idx := 0; idx+1 < len(vals); idx += 2 {
+		words := strings.Split(vals[idx], ":")
+		if 2 != len(words) {
+			continue
+		}
+
+		typ, _ := strconv.ParseUint(words[0], 10, 32)
+		id, _ := strconv.ParseUint(words[1], 10, 64)
+		ltm, _ := strconv.ParseInt(vals[idx+1], 10, 64)
+
+		list = append(list, &ConvItem{Type: uint32(typ), Id: id, Ltm: ltm})
+		fields = append(fields, vals[idx])
+	}
+
+	if 0 == len(fields) {
+		return list, nil
+	}
+
+	/* > 获取最近消息和未读数 */
+	last_key := fmt.Sprintf(comm.CHAT_KEY_USR_CONV_LAST_TAB, uid)
+	unread_key := fmt.Sprintf(comm.CHAT_KEY_USR_CONV_UNREAD_TAB, uid)
+
+	for _, field := range fields {
+		rds.Send("HGET", last_key, field)
+		rds.Send("HGET", unread_key, field)
+	}
+
+	rds.Flush()
+
+	for _, item := range list {
+		data, err := redis.Bytes(rds.Receive())
+		if nil == err {
+			last := &ConvLast{}
+			if nil == json.Unmarshal(data, last) {
+				item.Last = last
+			}
+		}
+
+		unread, err := redis.Int(rds.Receive())
+		if nil == err && unread > 0 {
+			item.Unread = uint32(unread)
+		}
+	}
+
+	return list, nil
+}
+
+// 减少未读数(KEYS[1]:未读数 ARGV[1]:会话 ARGV[2]:已读条数(0:全部已读))
+// 返回: 剩余未读数
+var conv_read_script = redis.NewScript(1, `
+local unread = tonumber(redis.call("HGET", KEYS[1], ARGV[1]) or 0)
+local num = tonumber(ARGV[2])
+if 0 == num or unread <= num then
+    redis.call("HDEL", KEYS[1], ARGV[1])
+    return 0
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], -num)`)
+
+/******************************************************************************
+ **函数名称: ConvRead
+ **功    能: 减少会话的未读数
+ **输入参数:
+ **     pool: REDIS连接池
+ **     uid: 用户ID
+ **     typ: 会话类型
+ **     id: 会话ID
+ **     num: 已读条数(0:全部已读)
+ **输出参数: NONE
+ **返    回:
+ **     unread: 剩余未读数
+ **     err: 错误信息
+ **实现描述: 未读数不会小于0
+ **注意事项: 读取、扣减与清除通过LUA脚本原子执行, 避免并发已读时未读数被扣成负数.
+ **作    者: # agent # 2026.10.18 07:18:45 #
+ ******************************************************************************/
+func ConvRead(pool *redis.Pool, uid uint64,
+	typ uint32, id uint64, num uint32) (unread uint32, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	key := fmt.Sprintf(comm.CHAT_KEY_USR_CONV_UNREAD_TAB, uid)
+	field := fmt.Sprintf(comm.CHAT_FMT_CONV_STR, typ, id)
+
+	left, err := redis.Int64(conv_read_script.Do(rds, key, field, num))
+	if nil != err {
+		return 0, err
+	}
+
+	return uint32(left), nil
+}
@@ -0,0 +1,37 @@
+package im
+
+import (
+	"strings"
+	"testing"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+func TestConvPreview(t *testing.T) {
+	max := comm.CHAT_CONV_PREVIEW_LEN
+
+	cases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"empty", "", ""},
+		{"short ascii", "hello", "hello"},
+		{"ascii at limit", strings.Repeat("a", max), strings.Repeat("a", max)},
+		{"ascii over limit", strings.Repeat("a", max+1), strings.Repeat("a", max)},
+		{"chinese at limit", strings.Repeat("中", max), strings.Repeat("中", max)},
+		{"chinese over limit", strings.Repeat("中", max+10), strings.Repeat("中", max)},
+		{"mixed over limit", "a" + strings.Repeat("文", max), "a" + strings.Repeat("文", max-1)},
+		{"emoji over limit", strings.Repeat("😀", max+1), strings.Repeat("😀", max)},
+	}
+
+	for _, c := range cases {
+		last := ConvPreview(1, 2, c.text)
+		if last.Text != c.want {
+			t.Errorf("%s: text:%q, want %q", c.name, last.Text, c.want)
+		} else if 1 != last.Uid || 2 != last.Msgid || 0 != last.Revoked {
+			t.Errorf("%s: uid:%d msgid:%d revoked:%d, want 1 2 0",
+				c.name, last.Uid, last.Msgid, last.Revoked)
+		}
+	}
+}
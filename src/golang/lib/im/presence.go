@@ -0,0 +1,35 @@
+package im
+
+import (
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+/******************************************************************************
+ **函数名称: PresenceMark
+ **功    能: 标记用户在线状态可能发生变化
+ **输入参数:
+ **     pool: REDIS连接池
+ **     uid: 用户ID
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 将用户放入待通知集合, 防抖时长过后由USRSVR判断是否需要通知.
+ **注意事项:
+ **     1. 使用ZADD NX, 防抖时长内的多次变化不会推迟通知时间;
+ **     2. 防抖时长内反复上下线时, 只会按最终状态通知一次(或不通知);
+ **     3. 上线、下线及会话数据清理(含超时清理)时均需调用.
+ **作    者: # agent # 2026.10.18 07:46:48 #
+ ******************************************************************************/
+func PresenceMark(pool *redis.Pool, uid uint64) error {
+	rds := pool.Get()
+	defer rds.Close()
+
+	ttl := time.Now().Unix() + comm.IM_PRESENCE_DEBOUNCE
+
+	_, err := rds.Do("ZADD", comm.IM_KEY_PRESENCE_PENDING_ZSET, "NX", ttl, uid)
+
+	return err
+}
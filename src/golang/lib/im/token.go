@@ -0,0 +1,99 @@
+package im
+
+import (
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"beehive-im/src/golang/lib/comm"
+)
+
+/******************************************************************************
+ **函数名称: TokenRevoke
+ **功    能: 吊销TOKEN
+ **输入参数:
+ **     pool: REDIS连接池
+ **     uid: 用户ID(0:不按用户吊销)
+ **     sid: 会话SID(0:不按会话吊销)
+ **     ttl: TOKEN的最大有效时长(秒)
+ **输出参数: NONE
+ **返    回: 错误信息
+ **实现描述: 记录吊销时间, 签发时间不晚于吊销时间的TOKEN均失效.
+ **注意事项: 超过TOKEN最大有效时长的吊销记录已无意义, 顺便清理.
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func TokenRevoke(pool *redis.Pool, uid uint64, sid uint64, ttl int64) error {
+	rds := pool.Get()
+	defer rds.Close()
+
+	ctm := time.Now().Unix()
+
+	rds.Send("MULTI")
+	if 0 != uid {
+		rds.Send("ZADD", comm.IM_KEY_TOKEN_REVOKE_UID_ZSET, ctm, uid)
+	}
+	if 0 != sid {
+		rds.Send("ZADD", comm.IM_KEY_TOKEN_REVOKE_SID_ZSET, ctm, sid)
+	}
+	rds.Send("ZREMRANGEBYSCORE", comm.IM_KEY_TOKEN_REVOKE_UID_ZSET, "-inf", ctm-ttl)
+	rds.Send("ZREMRANGEBYSCORE", comm.IM_KEY_TOKEN_REVOKE_SID_ZSET, "-inf", ctm-ttl)
+
+	_, err := rds.Do("EXEC")
+
+	return err
+}
+
+/******************************************************************************
+ **函数名称: IsTokenRevoked
+ **功    能: 判断TOKEN是否已被吊销
+ **输入参数:
+ **     pool: REDIS连接池
+ **     uid: 用户ID
+ **     sid: 会话SID
+ **     ctm: TOKEN签发时间
+ **输出参数: NONE
+ **返    回:
+ **     revoked: 是否已被吊销
+ **     err: 错误信息
+ **实现描述: 查询用户和会话的吊销时间, 签发时间不晚于吊销时间时表示已被吊销.
+ **注意事项: 签发时间与吊销时间的精度为秒, 吊销当秒签发的TOKEN同样失效.
+ **作    者: # agent # 2026.10.18 06:45:32 #
+ ******************************************************************************/
+func IsTokenRevoked(pool *redis.Pool, uid uint64, sid uint64, ctm int64) (revoked bool, err error) {
+	rds := pool.Get()
+	defer rds.Close()
+
+	rds.Send("ZSCORE", comm.IM_KEY_TOKEN_REVOKE_UID_ZSET, uid)
+	rds.Send("ZSCORE", comm.IM_KEY_TOKEN_REVOKE_SID_ZSET, sid)
+	rds.Flush()
+
+	for idx := 0; idx < 2; idx += 1 {
+		tm, err := redis.Int64(rds.Receive())
+		if redis.ErrNil == err {
+			continue
+		} else if nil != err {
+			return false, err
+		} else if token_revoked_at(ctm, tm) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+/******************************************************************************
+ **函数名称: token_revoked_at
+ **功    能: 判断签发时间是否在吊销时间之前
+ **输入参数:
+ **     ctm: TOKEN签发时间
+ **     tm: 吊销时间
+ **输出参数: NONE
+ **返    回: true:已被吊销 false:未被吊销
+ **实现描述:
+ **注意事项: 时间精度为秒, 无法区分同一秒内签发与吊销的先后, 因此同一秒签发的
+ **     TOKEN视为已被吊销, 防止吊销前签发的TOKEN在吊销当秒内依然有效.
+ **作    者: # agent # 2026.10.18 07:42:45 #
+ ******************************************************************************/
+func token_revoked_at(ctm int64, tm int64) bool {
+	return ctm <= tm
+}
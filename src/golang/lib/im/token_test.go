@@ -0,0 +1,26 @@
+package im
+
+import (
+	"testing"
+)
+
+func TestTokenRevokedAt(t *testing.T) {
+	cases := []struct {
+		name    string
+		ctm     int64 // TOKEN签发时间
+		tm      int64 // 吊销时间
+		revoked bool
+	}{
+		{"issued before revoke", 1500000000, 1500000001, true},
+		{"issued long before revoke", 1400000000, 1500000000, true},
+		{"issued in revoke second", 1500000000, 1500000000, true},
+		{"issued after revoke", 1500000001, 1500000000, false},
+	}
+
+	for _, c := range cases {
+		if revoked := token_revoked_at(c.ctm, c.tm); revoked != c.revoked {
+			t.Errorf("%s: token_revoked_at(%d, %d) = %v, want %v",
+				c.name, c.ctm, c.tm, revoked, c.revoked)
+		}
+	}
+}
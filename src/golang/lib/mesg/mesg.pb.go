@@ -19,6 +19,12 @@ It has these top-level messages:
 	MesgSync
 	MesgSyncAck
 	MesgKick
+	MesgConvList
+	MesgConvListAck
+	MesgConvRead
+	MesgConvReadAck
+	MesgOnlineNtf
+	MesgOfflineNtf
 	MesgChat
 	MesgChatAck
 	MesgFriendAdd
@@ -37,6 +43,14 @@ It has these top-level messages:
 	MesgMarkAddAck
 	MesgMarkDel
 	MesgMarkDelAck
+	MesgFriendReply
+	MesgFriendReplyAck
+	MesgFriendList
+	MesgFriendListAck
+	MesgChatReceipt
+	MesgChatReceiptAck
+	MesgChatRecall
+	MesgChatRecallAck
 	MesgGroupCreat
 	MesgGroupCreatAck
 	MesgGroupDismiss
@@ -65,6 +79,12 @@ It has these top-level messages:
 	MesgGroupMgrDelAck
 	MesgGroupUsrList
 	MesgGroupUsrListAck
+	MesgGroupJoinAudit
+	MesgGroupJoinAuditAck
+	MesgGroupRecall
+	MesgGroupRecallAck
+	MesgGroupSync
+	MesgGroupSyncAck
 	MesgGroupJoinNtf
 	MesgGroupQuitNtf
 	MesgGroupKickNtf
@@ -74,6 +94,7 @@ It has these top-level messages:
 	MesgGroupBlDelNtf
 	MesgGroupMgrAddNtf
 	MesgGroupMgrDelNtf
+	MesgGroupDismissNtf
 	MesgRoomCreat
 	MesgRoomCreatAck
 	MesgRoomDismiss
@@ -257,6 +278,7 @@ func (m *MesgOnlineAck) GetErrmsg() string {
 // 协议格式:
 type MesgSub struct {
 	Cmd              *uint32 `protobuf:"varint,1,opt,name=cmd" json:"cmd,omitempty"`
+	Uid              *uint64 `protobuf:"varint,2,opt,name=uid" json:"uid,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
@@ -272,6 +294,13 @@ func (m *MesgSub) GetCmd() uint32 {
 	return 0
 }
 
+func (m *MesgSub) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0108
 // 命令描述: 订阅应答(SUB-ACK)
@@ -315,6 +344,7 @@ func (m *MesgSubAck) GetErrmsg() string {
 // 协议格式:
 type MesgUnsub struct {
 	Cmd              *uint32 `protobuf:"varint,1,req,name=cmd" json:"cmd,omitempty"`
+	Uid              *uint64 `protobuf:"varint,2,opt,name=uid" json:"uid,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
@@ -330,6 +360,13 @@ func (m *MesgUnsub) GetCmd() uint32 {
 	return 0
 }
 
+func (m *MesgUnsub) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x010A
 // 命令描述: 取消订阅应答(UNSUB-ACK)
@@ -402,6 +439,9 @@ func (m *MesgError) GetErrmsg() string {
 // 协议格式: NONE
 type MesgSync struct {
 	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Since            *uint64 `protobuf:"varint,2,opt,name=since" json:"since,omitempty"`
+	Num              *uint32 `protobuf:"varint,3,opt,name=num" json:"num,omitempty"`
+	ReceiptSince     *uint64 `protobuf:"varint,4,opt,name=receipt_since" json:"receipt_since,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
@@ -417,6 +457,27 @@ func (m *MesgSync) GetUid() uint64 {
 	return 0
 }
 
+func (m *MesgSync) GetSince() uint64 {
+	if m != nil && m.Since != nil {
+		return *m.Since
+	}
+	return 0
+}
+
+func (m *MesgSync) GetNum() uint32 {
+	if m != nil && m.Num != nil {
+		return *m.Num
+	}
+	return 0
+}
+
+func (m *MesgSync) GetReceiptSince() uint64 {
+	if m != nil && m.ReceiptSince != nil {
+		return *m.ReceiptSince
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x010E
 // 命令描述: 同步消息应答(SYNC-ACK)
@@ -425,6 +486,10 @@ type MesgSyncAck struct {
 	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
 	Code             *uint32 `protobuf:"varint,2,req,name=code" json:"code,omitempty"`
 	Errmsg           *string `protobuf:"bytes,3,req,name=errmsg" json:"errmsg,omitempty"`
+	Next             *uint64 `protobuf:"varint,4,opt,name=next" json:"next,omitempty"`
+	More             *uint32 `protobuf:"varint,5,opt,name=more" json:"more,omitempty"`
+	ReceiptNext      *uint64 `protobuf:"varint,6,opt,name=receipt_next" json:"receipt_next,omitempty"`
+	ReceiptMore      *uint32 `protobuf:"varint,7,opt,name=receipt_more" json:"receipt_more,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
@@ -454,6 +519,34 @@ func (m *MesgSyncAck) GetErrmsg() string {
 	return ""
 }
 
+func (m *MesgSyncAck) GetNext() uint64 {
+	if m != nil && m.Next != nil {
+		return *m.Next
+	}
+	return 0
+}
+
+func (m *MesgSyncAck) GetMore() uint32 {
+	if m != nil && m.More != nil {
+		return *m.More
+	}
+	return 0
+}
+
+func (m *MesgSyncAck) GetReceiptNext() uint64 {
+	if m != nil && m.ReceiptNext != nil {
+		return *m.ReceiptNext
+	}
+	return 0
+}
+
+func (m *MesgSyncAck) GetReceiptMore() uint32 {
+	if m != nil && m.ReceiptMore != nil {
+		return *m.ReceiptMore
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0110
 // 命令描述: 踢连接下线(KICK)
@@ -483,6 +576,252 @@ func (m *MesgKick) GetErrmsg() string {
 	return ""
 }
 
+//
+// 命令ID: 0x0112
+// 命令描述: 会话列表(CONV-LIST)
+// 协议格式:
+type MesgConvList struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Num              *uint32 `protobuf:"varint,2,opt,name=num" json:"num,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgConvList) Reset()                    { *m = MesgConvList{} }
+func (m *MesgConvList) String() string            { return proto.CompactTextString(m) }
+func (*MesgConvList) ProtoMessage()               {}
+func (*MesgConvList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *MesgConvList) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgConvList) GetNum() uint32 {
+	if m != nil && m.Num != nil {
+		return *m.Num
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0113
+// 命令描述: 会话列表应答(CONV-LIST-ACK)
+// 协议格式:
+type MesgConvListAck struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	List             *string `protobuf:"bytes,2,req,name=list" json:"list,omitempty"`
+	Code             *uint32 `protobuf:"varint,3,opt,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,4,opt,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgConvListAck) Reset()                    { *m = MesgConvListAck{} }
+func (m *MesgConvListAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgConvListAck) ProtoMessage()               {}
+func (*MesgConvListAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *MesgConvListAck) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgConvListAck) GetList() string {
+	if m != nil && m.List != nil {
+		return *m.List
+	}
+	return ""
+}
+
+func (m *MesgConvListAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgConvListAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0114
+// 命令描述: 会话已读(CONV-READ)
+// 协议格式:
+type MesgConvRead struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Type             *uint32 `protobuf:"varint,2,req,name=type" json:"type,omitempty"`
+	Id               *uint64 `protobuf:"varint,3,req,name=id" json:"id,omitempty"`
+	Num              *uint32 `protobuf:"varint,4,opt,name=num" json:"num,omitempty"`
+	Unread           *uint32 `protobuf:"varint,5,opt,name=unread" json:"unread,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgConvRead) Reset()                    { *m = MesgConvRead{} }
+func (m *MesgConvRead) String() string            { return proto.CompactTextString(m) }
+func (*MesgConvRead) ProtoMessage()               {}
+func (*MesgConvRead) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *MesgConvRead) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgConvRead) GetType() uint32 {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return 0
+}
+
+func (m *MesgConvRead) GetId() uint64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *MesgConvRead) GetNum() uint32 {
+	if m != nil && m.Num != nil {
+		return *m.Num
+	}
+	return 0
+}
+
+func (m *MesgConvRead) GetUnread() uint32 {
+	if m != nil && m.Unread != nil {
+		return *m.Unread
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0115
+// 命令描述: 会话已读应答(CONV-READ-ACK)
+// 协议格式:
+type MesgConvReadAck struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Type             *uint32 `protobuf:"varint,2,req,name=type" json:"type,omitempty"`
+	Id               *uint64 `protobuf:"varint,3,req,name=id" json:"id,omitempty"`
+	Unread           *uint32 `protobuf:"varint,4,opt,name=unread" json:"unread,omitempty"`
+	Code             *uint32 `protobuf:"varint,5,opt,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,6,opt,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgConvReadAck) Reset()                    { *m = MesgConvReadAck{} }
+func (m *MesgConvReadAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgConvReadAck) ProtoMessage()               {}
+func (*MesgConvReadAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *MesgConvReadAck) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgConvReadAck) GetType() uint32 {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return 0
+}
+
+func (m *MesgConvReadAck) GetId() uint64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *MesgConvReadAck) GetUnread() uint32 {
+	if m != nil && m.Unread != nil {
+		return *m.Unread
+	}
+	return 0
+}
+
+func (m *MesgConvReadAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgConvReadAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0151
+// 命令描述: 上线通知(ONLINE-NTF)
+// 协议格式:
+type MesgOnlineNtf struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Time             *uint64 `protobuf:"varint,2,req,name=time" json:"time,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgOnlineNtf) Reset()                    { *m = MesgOnlineNtf{} }
+func (m *MesgOnlineNtf) String() string            { return proto.CompactTextString(m) }
+func (*MesgOnlineNtf) ProtoMessage()               {}
+func (*MesgOnlineNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *MesgOnlineNtf) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgOnlineNtf) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0153
+// 命令描述: 下线通知(OFFLINE-NTF)
+// 协议格式:
+type MesgOfflineNtf struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Time             *uint64 `protobuf:"varint,2,req,name=time" json:"time,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgOfflineNtf) Reset()                    { *m = MesgOfflineNtf{} }
+func (m *MesgOfflineNtf) String() string            { return proto.CompactTextString(m) }
+func (*MesgOfflineNtf) ProtoMessage()               {}
+func (*MesgOfflineNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *MesgOfflineNtf) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgOfflineNtf) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0201
 // 命令描述: 私聊消息(CHAT)
@@ -494,13 +833,15 @@ type MesgChat struct {
 	Time             *uint64 `protobuf:"varint,4,req,name=time" json:"time,omitempty"`
 	Text             *string `protobuf:"bytes,5,req,name=text" json:"text,omitempty"`
 	Data             []byte  `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
+	Sid              *uint64 `protobuf:"varint,7,opt,name=sid" json:"sid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,8,opt,name=msgid" json:"msgid,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgChat) Reset()                    { *m = MesgChat{} }
 func (m *MesgChat) String() string            { return proto.CompactTextString(m) }
 func (*MesgChat) ProtoMessage()               {}
-func (*MesgChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*MesgChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *MesgChat) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -544,6 +885,20 @@ func (m *MesgChat) GetData() []byte {
 	return nil
 }
 
+func (m *MesgChat) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
+func (m *MesgChat) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0202
 // 命令描述: 私聊消息应答(CHAT-ACK)
@@ -553,13 +908,14 @@ type MesgChatAck struct {
 	Duid             *uint64 `protobuf:"varint,2,req,name=duid" json:"duid,omitempty"`
 	Code             *uint32 `protobuf:"varint,3,req,name=code" json:"code,omitempty"`
 	Errmsg           *string `protobuf:"bytes,4,req,name=errmsg" json:"errmsg,omitempty"`
+	Sid              *uint64 `protobuf:"varint,5,opt,name=sid" json:"sid,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgChatAck) Reset()                    { *m = MesgChatAck{} }
 func (m *MesgChatAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgChatAck) ProtoMessage()               {}
-func (*MesgChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*MesgChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *MesgChatAck) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -589,6 +945,13 @@ func (m *MesgChatAck) GetErrmsg() string {
 	return ""
 }
 
+func (m *MesgChatAck) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0203
 // 命令描述: 添加好友(FRIEND-ADD)
@@ -603,7 +966,7 @@ type MesgFriendAdd struct {
 func (m *MesgFriendAdd) Reset()                    { *m = MesgFriendAdd{} }
 func (m *MesgFriendAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgFriendAdd) ProtoMessage()               {}
-func (*MesgFriendAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*MesgFriendAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *MesgFriendAdd) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -639,7 +1002,7 @@ type MesgFriendAddAck struct {
 func (m *MesgFriendAddAck) Reset()                    { *m = MesgFriendAddAck{} }
 func (m *MesgFriendAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgFriendAddAck) ProtoMessage()               {}
-func (*MesgFriendAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*MesgFriendAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *MesgFriendAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -662,13 +1025,14 @@ func (m *MesgFriendAddAck) GetErrmsg() string {
 type MesgFriendDel struct {
 	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
 	Duid             *uint64 `protobuf:"varint,2,req,name=duid" json:"duid,omitempty"`
+	Mutual           *uint32 `protobuf:"varint,3,opt,name=mutual" json:"mutual,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgFriendDel) Reset()                    { *m = MesgFriendDel{} }
 func (m *MesgFriendDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgFriendDel) ProtoMessage()               {}
-func (*MesgFriendDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*MesgFriendDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *MesgFriendDel) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -684,6 +1048,13 @@ func (m *MesgFriendDel) GetDuid() uint64 {
 	return 0
 }
 
+func (m *MesgFriendDel) GetMutual() uint32 {
+	if m != nil && m.Mutual != nil {
+		return *m.Mutual
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0206
 // 命令描述: 删除好友应答(FRIEND-DEL-ACK)
@@ -697,7 +1068,7 @@ type MesgFriendDelAck struct {
 func (m *MesgFriendDelAck) Reset()                    { *m = MesgFriendDelAck{} }
 func (m *MesgFriendDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgFriendDelAck) ProtoMessage()               {}
-func (*MesgFriendDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*MesgFriendDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *MesgFriendDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -726,7 +1097,7 @@ type MesgBlacklistAdd struct {
 func (m *MesgBlacklistAdd) Reset()                    { *m = MesgBlacklistAdd{} }
 func (m *MesgBlacklistAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgBlacklistAdd) ProtoMessage()               {}
-func (*MesgBlacklistAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*MesgBlacklistAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *MesgBlacklistAdd) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -755,7 +1126,7 @@ type MesgBlacklistAddAck struct {
 func (m *MesgBlacklistAddAck) Reset()                    { *m = MesgBlacklistAddAck{} }
 func (m *MesgBlacklistAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgBlacklistAddAck) ProtoMessage()               {}
-func (*MesgBlacklistAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*MesgBlacklistAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *MesgBlacklistAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -784,7 +1155,7 @@ type MesgBlacklistDel struct {
 func (m *MesgBlacklistDel) Reset()                    { *m = MesgBlacklistDel{} }
 func (m *MesgBlacklistDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgBlacklistDel) ProtoMessage()               {}
-func (*MesgBlacklistDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*MesgBlacklistDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *MesgBlacklistDel) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -813,7 +1184,7 @@ type MesgBlacklistDelAck struct {
 func (m *MesgBlacklistDelAck) Reset()                    { *m = MesgBlacklistDelAck{} }
 func (m *MesgBlacklistDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgBlacklistDelAck) ProtoMessage()               {}
-func (*MesgBlacklistDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*MesgBlacklistDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *MesgBlacklistDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -842,7 +1213,7 @@ type MesgGagAdd struct {
 func (m *MesgGagAdd) Reset()                    { *m = MesgGagAdd{} }
 func (m *MesgGagAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgGagAdd) ProtoMessage()               {}
-func (*MesgGagAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*MesgGagAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *MesgGagAdd) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -871,7 +1242,7 @@ type MesgGagAddAck struct {
 func (m *MesgGagAddAck) Reset()                    { *m = MesgGagAddAck{} }
 func (m *MesgGagAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGagAddAck) ProtoMessage()               {}
-func (*MesgGagAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*MesgGagAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *MesgGagAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -900,7 +1271,7 @@ type MesgGagDel struct {
 func (m *MesgGagDel) Reset()                    { *m = MesgGagDel{} }
 func (m *MesgGagDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgGagDel) ProtoMessage()               {}
-func (*MesgGagDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*MesgGagDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *MesgGagDel) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -929,7 +1300,7 @@ type MesgGagDelAck struct {
 func (m *MesgGagDelAck) Reset()                    { *m = MesgGagDelAck{} }
 func (m *MesgGagDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGagDelAck) ProtoMessage()               {}
-func (*MesgGagDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*MesgGagDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *MesgGagDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -952,14 +1323,15 @@ func (m *MesgGagDelAck) GetErrmsg() string {
 type MesgMarkAdd struct {
 	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
 	Duid             *uint64 `protobuf:"varint,2,req,name=duid" json:"duid,omitempty"`
-	Mark             *uint64 `protobuf:"varint,3,req,name=mark" json:"mark,omitempty"`
+	Mark             *uint64 `protobuf:"varint,3,opt,name=mark" json:"mark,omitempty"`
+	Name             *string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgMarkAdd) Reset()                    { *m = MesgMarkAdd{} }
 func (m *MesgMarkAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgMarkAdd) ProtoMessage()               {}
-func (*MesgMarkAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*MesgMarkAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *MesgMarkAdd) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -982,6 +1354,13 @@ func (m *MesgMarkAdd) GetMark() uint64 {
 	return 0
 }
 
+func (m *MesgMarkAdd) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
 //
 // 命令ID: 0x0211
 // 命令描述: 备注此人应答(MARK-ADD-ACK)
@@ -995,7 +1374,7 @@ type MesgMarkAddAck struct {
 func (m *MesgMarkAddAck) Reset()                    { *m = MesgMarkAddAck{} }
 func (m *MesgMarkAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgMarkAddAck) ProtoMessage()               {}
-func (*MesgMarkAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*MesgMarkAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *MesgMarkAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1024,7 +1403,7 @@ type MesgMarkDel struct {
 func (m *MesgMarkDel) Reset()                    { *m = MesgMarkDel{} }
 func (m *MesgMarkDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgMarkDel) ProtoMessage()               {}
-func (*MesgMarkDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*MesgMarkDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *MesgMarkDel) GetSuid() uint64 {
 	if m != nil && m.Suid != nil {
@@ -1050,19 +1429,395 @@ type MesgMarkDelAck struct {
 	XXX_unrecognized []byte  `json:"-"`
 }
 
-func (m *MesgMarkDelAck) Reset()                    { *m = MesgMarkDelAck{} }
-func (m *MesgMarkDelAck) String() string            { return proto.CompactTextString(m) }
-func (*MesgMarkDelAck) ProtoMessage()               {}
-func (*MesgMarkDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
-
-func (m *MesgMarkDelAck) GetCode() uint32 {
+func (m *MesgMarkDelAck) Reset()                    { *m = MesgMarkDelAck{} }
+func (m *MesgMarkDelAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgMarkDelAck) ProtoMessage()               {}
+func (*MesgMarkDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *MesgMarkDelAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgMarkDelAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0214
+// 命令描述: 好友申请回复(FRIEND-REPLY)
+// 协议格式:
+type MesgFriendReply struct {
+	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
+	Duid             *uint64 `protobuf:"varint,2,req,name=duid" json:"duid,omitempty"`
+	Pass             *uint32 `protobuf:"varint,3,req,name=pass" json:"pass,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgFriendReply) Reset()                    { *m = MesgFriendReply{} }
+func (m *MesgFriendReply) String() string            { return proto.CompactTextString(m) }
+func (*MesgFriendReply) ProtoMessage()               {}
+func (*MesgFriendReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *MesgFriendReply) GetSuid() uint64 {
+	if m != nil && m.Suid != nil {
+		return *m.Suid
+	}
+	return 0
+}
+
+func (m *MesgFriendReply) GetDuid() uint64 {
+	if m != nil && m.Duid != nil {
+		return *m.Duid
+	}
+	return 0
+}
+
+func (m *MesgFriendReply) GetPass() uint32 {
+	if m != nil && m.Pass != nil {
+		return *m.Pass
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0215
+// 命令描述: 好友申请回复应答(FRIEND-REPLY-ACK)
+// 协议格式:
+type MesgFriendReplyAck struct {
+	Code             *uint32 `protobuf:"varint,1,req,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,2,req,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgFriendReplyAck) Reset()                    { *m = MesgFriendReplyAck{} }
+func (m *MesgFriendReplyAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgFriendReplyAck) ProtoMessage()               {}
+func (*MesgFriendReplyAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *MesgFriendReplyAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgFriendReplyAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0216
+// 命令描述: 好友列表(FRIEND-LIST)
+// 协议格式:
+type MesgFriendList struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Num              *uint32 `protobuf:"varint,2,req,name=num" json:"num,omitempty"`
+	Cursor           *uint64 `protobuf:"varint,3,opt,name=cursor" json:"cursor,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgFriendList) Reset()                    { *m = MesgFriendList{} }
+func (m *MesgFriendList) String() string            { return proto.CompactTextString(m) }
+func (*MesgFriendList) ProtoMessage()               {}
+func (*MesgFriendList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *MesgFriendList) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgFriendList) GetNum() uint32 {
+	if m != nil && m.Num != nil {
+		return *m.Num
+	}
+	return 0
+}
+
+func (m *MesgFriendList) GetCursor() uint64 {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0217
+// 命令描述: 好友列表应答(FRIEND-LIST-ACK)
+// 协议格式:
+type MesgFriendListAck struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	List             *string `protobuf:"bytes,2,req,name=list" json:"list,omitempty"`
+	Next             *uint64 `protobuf:"varint,3,opt,name=next" json:"next,omitempty"`
+	Total            *uint32 `protobuf:"varint,4,opt,name=total" json:"total,omitempty"`
+	Code             *uint32 `protobuf:"varint,5,opt,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,6,opt,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgFriendListAck) Reset()                    { *m = MesgFriendListAck{} }
+func (m *MesgFriendListAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgFriendListAck) ProtoMessage()               {}
+func (*MesgFriendListAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *MesgFriendListAck) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgFriendListAck) GetList() string {
+	if m != nil && m.List != nil {
+		return *m.List
+	}
+	return ""
+}
+
+func (m *MesgFriendListAck) GetNext() uint64 {
+	if m != nil && m.Next != nil {
+		return *m.Next
+	}
+	return 0
+}
+
+func (m *MesgFriendListAck) GetTotal() uint32 {
+	if m != nil && m.Total != nil {
+		return *m.Total
+	}
+	return 0
+}
+
+func (m *MesgFriendListAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgFriendListAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0218
+// 命令描述: 送达回执(CHAT-RECV)
+// 协议格式:
+type MesgChatReceipt struct {
+	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
+	Sid              *uint64 `protobuf:"varint,2,req,name=sid" json:"sid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,req,name=msgid" json:"msgid,omitempty"`
+	Duid             *uint64 `protobuf:"varint,4,req,name=duid" json:"duid,omitempty"`
+	Time             *uint64 `protobuf:"varint,5,opt,name=time" json:"time,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgChatReceipt) Reset()                    { *m = MesgChatReceipt{} }
+func (m *MesgChatReceipt) String() string            { return proto.CompactTextString(m) }
+func (*MesgChatReceipt) ProtoMessage()               {}
+func (*MesgChatReceipt) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *MesgChatReceipt) GetSuid() uint64 {
+	if m != nil && m.Suid != nil {
+		return *m.Suid
+	}
+	return 0
+}
+
+func (m *MesgChatReceipt) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
+func (m *MesgChatReceipt) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgChatReceipt) GetDuid() uint64 {
+	if m != nil && m.Duid != nil {
+		return *m.Duid
+	}
+	return 0
+}
+
+func (m *MesgChatReceipt) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0219
+// 命令描述: 送达回执应答(CHAT-RECV-ACK)
+// 协议格式:
+type MesgChatReceiptAck struct {
+	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
+	Sid              *uint64 `protobuf:"varint,2,req,name=sid" json:"sid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,req,name=msgid" json:"msgid,omitempty"`
+	Code             *uint32 `protobuf:"varint,4,req,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,5,req,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgChatReceiptAck) Reset()                    { *m = MesgChatReceiptAck{} }
+func (m *MesgChatReceiptAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgChatReceiptAck) ProtoMessage()               {}
+func (*MesgChatReceiptAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *MesgChatReceiptAck) GetSuid() uint64 {
+	if m != nil && m.Suid != nil {
+		return *m.Suid
+	}
+	return 0
+}
+
+func (m *MesgChatReceiptAck) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
+func (m *MesgChatReceiptAck) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgChatReceiptAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgChatReceiptAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x021C
+// 命令描述: 私聊消息撤回(CHAT-RECALL)
+// 协议格式:
+type MesgChatRecall struct {
+	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
+	Sid              *uint64 `protobuf:"varint,2,req,name=sid" json:"sid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,req,name=msgid" json:"msgid,omitempty"`
+	Duid             *uint64 `protobuf:"varint,4,req,name=duid" json:"duid,omitempty"`
+	Time             *uint64 `protobuf:"varint,5,opt,name=time" json:"time,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgChatRecall) Reset()                    { *m = MesgChatRecall{} }
+func (m *MesgChatRecall) String() string            { return proto.CompactTextString(m) }
+func (*MesgChatRecall) ProtoMessage()               {}
+func (*MesgChatRecall) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *MesgChatRecall) GetSuid() uint64 {
+	if m != nil && m.Suid != nil {
+		return *m.Suid
+	}
+	return 0
+}
+
+func (m *MesgChatRecall) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
+func (m *MesgChatRecall) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgChatRecall) GetDuid() uint64 {
+	if m != nil && m.Duid != nil {
+		return *m.Duid
+	}
+	return 0
+}
+
+func (m *MesgChatRecall) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x021D
+// 命令描述: 私聊消息撤回应答(CHAT-RECALL-ACK)
+// 协议格式:
+type MesgChatRecallAck struct {
+	Suid             *uint64 `protobuf:"varint,1,req,name=suid" json:"suid,omitempty"`
+	Sid              *uint64 `protobuf:"varint,2,req,name=sid" json:"sid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,req,name=msgid" json:"msgid,omitempty"`
+	Code             *uint32 `protobuf:"varint,4,req,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,5,req,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgChatRecallAck) Reset()                    { *m = MesgChatRecallAck{} }
+func (m *MesgChatRecallAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgChatRecallAck) ProtoMessage()               {}
+func (*MesgChatRecallAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *MesgChatRecallAck) GetSuid() uint64 {
+	if m != nil && m.Suid != nil {
+		return *m.Suid
+	}
+	return 0
+}
+
+func (m *MesgChatRecallAck) GetSid() uint64 {
+	if m != nil && m.Sid != nil {
+		return *m.Sid
+	}
+	return 0
+}
+
+func (m *MesgChatRecallAck) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgChatRecallAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
 		return *m.Code
 	}
 	return 0
 }
 
-func (m *MesgMarkDelAck) GetErrmsg() string {
+func (m *MesgChatRecallAck) GetErrmsg() string {
 	if m != nil && m.Errmsg != nil {
 		return *m.Errmsg
 	}
@@ -1084,7 +1839,7 @@ type MesgGroupCreat struct {
 func (m *MesgGroupCreat) Reset()                    { *m = MesgGroupCreat{} }
 func (m *MesgGroupCreat) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupCreat) ProtoMessage()               {}
-func (*MesgGroupCreat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*MesgGroupCreat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *MesgGroupCreat) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1127,7 +1882,7 @@ type MesgGroupCreatAck struct {
 func (m *MesgGroupCreatAck) Reset()                    { *m = MesgGroupCreatAck{} }
 func (m *MesgGroupCreatAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupCreatAck) ProtoMessage()               {}
-func (*MesgGroupCreatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*MesgGroupCreatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *MesgGroupCreatAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1156,7 +1911,7 @@ type MesgGroupDismiss struct {
 func (m *MesgGroupDismiss) Reset()                    { *m = MesgGroupDismiss{} }
 func (m *MesgGroupDismiss) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupDismiss) ProtoMessage()               {}
-func (*MesgGroupDismiss) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*MesgGroupDismiss) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *MesgGroupDismiss) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1185,7 +1940,7 @@ type MesgGroupDismissAck struct {
 func (m *MesgGroupDismissAck) Reset()                    { *m = MesgGroupDismissAck{} }
 func (m *MesgGroupDismissAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupDismissAck) ProtoMessage()               {}
-func (*MesgGroupDismissAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*MesgGroupDismissAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *MesgGroupDismissAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1214,7 +1969,7 @@ type MesgGroupJoin struct {
 func (m *MesgGroupJoin) Reset()                    { *m = MesgGroupJoin{} }
 func (m *MesgGroupJoin) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupJoin) ProtoMessage()               {}
-func (*MesgGroupJoin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*MesgGroupJoin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *MesgGroupJoin) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1243,7 +1998,7 @@ type MesgGroupJoinAck struct {
 func (m *MesgGroupJoinAck) Reset()                    { *m = MesgGroupJoinAck{} }
 func (m *MesgGroupJoinAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupJoinAck) ProtoMessage()               {}
-func (*MesgGroupJoinAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*MesgGroupJoinAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *MesgGroupJoinAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1272,7 +2027,7 @@ type MesgGroupQuit struct {
 func (m *MesgGroupQuit) Reset()                    { *m = MesgGroupQuit{} }
 func (m *MesgGroupQuit) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupQuit) ProtoMessage()               {}
-func (*MesgGroupQuit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*MesgGroupQuit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *MesgGroupQuit) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1301,7 +2056,7 @@ type MesgGroupQuitAck struct {
 func (m *MesgGroupQuitAck) Reset()                    { *m = MesgGroupQuitAck{} }
 func (m *MesgGroupQuitAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupQuitAck) ProtoMessage()               {}
-func (*MesgGroupQuitAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*MesgGroupQuitAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *MesgGroupQuitAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1331,7 +2086,7 @@ type MesgGroupInvite struct {
 func (m *MesgGroupInvite) Reset()                    { *m = MesgGroupInvite{} }
 func (m *MesgGroupInvite) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupInvite) ProtoMessage()               {}
-func (*MesgGroupInvite) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*MesgGroupInvite) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *MesgGroupInvite) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1367,7 +2122,7 @@ type MesgGroupInviteAck struct {
 func (m *MesgGroupInviteAck) Reset()                    { *m = MesgGroupInviteAck{} }
 func (m *MesgGroupInviteAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupInviteAck) ProtoMessage()               {}
-func (*MesgGroupInviteAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*MesgGroupInviteAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *MesgGroupInviteAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1394,13 +2149,16 @@ type MesgGroupChat struct {
 	Time             *uint64 `protobuf:"varint,4,req,name=time" json:"time,omitempty"`
 	Text             *string `protobuf:"bytes,5,req,name=text" json:"text,omitempty"`
 	Data             []byte  `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,7,opt,name=msgid" json:"msgid,omitempty"`
+	Revoked          *uint32 `protobuf:"varint,8,opt,name=revoked" json:"revoked,omitempty"`
+	At               *string `protobuf:"bytes,9,opt,name=at" json:"at,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgGroupChat) Reset()                    { *m = MesgGroupChat{} }
 func (m *MesgGroupChat) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupChat) ProtoMessage()               {}
-func (*MesgGroupChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*MesgGroupChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *MesgGroupChat) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1444,6 +2202,27 @@ func (m *MesgGroupChat) GetData() []byte {
 	return nil
 }
 
+func (m *MesgGroupChat) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgGroupChat) GetRevoked() uint32 {
+	if m != nil && m.Revoked != nil {
+		return *m.Revoked
+	}
+	return 0
+}
+
+func (m *MesgGroupChat) GetAt() string {
+	if m != nil && m.At != nil {
+		return *m.At
+	}
+	return ""
+}
+
 //
 // 命令ID: 0x030C
 // 命令描述: 群聊消息应答(GROUP-CHAT-ACK)
@@ -1451,13 +2230,14 @@ func (m *MesgGroupChat) GetData() []byte {
 type MesgGroupChatAck struct {
 	Code             *uint32 `protobuf:"varint,1,req,name=code" json:"code,omitempty"`
 	Errmsg           *string `protobuf:"bytes,2,req,name=errmsg" json:"errmsg,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,opt,name=msgid" json:"msgid,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgGroupChatAck) Reset()                    { *m = MesgGroupChatAck{} }
 func (m *MesgGroupChatAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupChatAck) ProtoMessage()               {}
-func (*MesgGroupChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*MesgGroupChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *MesgGroupChatAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1473,6 +2253,13 @@ func (m *MesgGroupChatAck) GetErrmsg() string {
 	return ""
 }
 
+func (m *MesgGroupChatAck) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x030D
 // 命令描述: 群组踢人(GROUP-KICK)
@@ -1486,7 +2273,7 @@ type MesgGroupKick struct {
 func (m *MesgGroupKick) Reset()                    { *m = MesgGroupKick{} }
 func (m *MesgGroupKick) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupKick) ProtoMessage()               {}
-func (*MesgGroupKick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*MesgGroupKick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *MesgGroupKick) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1515,7 +2302,7 @@ type MesgGroupKickAck struct {
 func (m *MesgGroupKickAck) Reset()                    { *m = MesgGroupKickAck{} }
 func (m *MesgGroupKickAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupKickAck) ProtoMessage()               {}
-func (*MesgGroupKickAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*MesgGroupKickAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *MesgGroupKickAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1544,7 +2331,7 @@ type MesgGroupGagAdd struct {
 func (m *MesgGroupGagAdd) Reset()                    { *m = MesgGroupGagAdd{} }
 func (m *MesgGroupGagAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagAdd) ProtoMessage()               {}
-func (*MesgGroupGagAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*MesgGroupGagAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *MesgGroupGagAdd) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1573,7 +2360,7 @@ type MesgGroupGagAddAck struct {
 func (m *MesgGroupGagAddAck) Reset()                    { *m = MesgGroupGagAddAck{} }
 func (m *MesgGroupGagAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagAddAck) ProtoMessage()               {}
-func (*MesgGroupGagAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*MesgGroupGagAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *MesgGroupGagAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1602,7 +2389,7 @@ type MesgGroupGagDel struct {
 func (m *MesgGroupGagDel) Reset()                    { *m = MesgGroupGagDel{} }
 func (m *MesgGroupGagDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagDel) ProtoMessage()               {}
-func (*MesgGroupGagDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*MesgGroupGagDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *MesgGroupGagDel) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1631,7 +2418,7 @@ type MesgGroupGagDelAck struct {
 func (m *MesgGroupGagDelAck) Reset()                    { *m = MesgGroupGagDelAck{} }
 func (m *MesgGroupGagDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagDelAck) ProtoMessage()               {}
-func (*MesgGroupGagDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*MesgGroupGagDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *MesgGroupGagDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1660,7 +2447,7 @@ type MesgGroupBlAdd struct {
 func (m *MesgGroupBlAdd) Reset()                    { *m = MesgGroupBlAdd{} }
 func (m *MesgGroupBlAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlAdd) ProtoMessage()               {}
-func (*MesgGroupBlAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*MesgGroupBlAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *MesgGroupBlAdd) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1689,7 +2476,7 @@ type MesgGroupBlAddAck struct {
 func (m *MesgGroupBlAddAck) Reset()                    { *m = MesgGroupBlAddAck{} }
 func (m *MesgGroupBlAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlAddAck) ProtoMessage()               {}
-func (*MesgGroupBlAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*MesgGroupBlAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *MesgGroupBlAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1718,7 +2505,7 @@ type MesgGroupBlDel struct {
 func (m *MesgGroupBlDel) Reset()                    { *m = MesgGroupBlDel{} }
 func (m *MesgGroupBlDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlDel) ProtoMessage()               {}
-func (*MesgGroupBlDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*MesgGroupBlDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 func (m *MesgGroupBlDel) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1747,7 +2534,7 @@ type MesgGroupBlDelAck struct {
 func (m *MesgGroupBlDelAck) Reset()                    { *m = MesgGroupBlDelAck{} }
 func (m *MesgGroupBlDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlDelAck) ProtoMessage()               {}
-func (*MesgGroupBlDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*MesgGroupBlDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *MesgGroupBlDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1776,7 +2563,7 @@ type MesgGroupMgrAdd struct {
 func (m *MesgGroupMgrAdd) Reset()                    { *m = MesgGroupMgrAdd{} }
 func (m *MesgGroupMgrAdd) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrAdd) ProtoMessage()               {}
-func (*MesgGroupMgrAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*MesgGroupMgrAdd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *MesgGroupMgrAdd) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1805,7 +2592,7 @@ type MesgGroupMgrAddAck struct {
 func (m *MesgGroupMgrAddAck) Reset()                    { *m = MesgGroupMgrAddAck{} }
 func (m *MesgGroupMgrAddAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrAddAck) ProtoMessage()               {}
-func (*MesgGroupMgrAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*MesgGroupMgrAddAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *MesgGroupMgrAddAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1834,7 +2621,7 @@ type MesgGroupMgrDel struct {
 func (m *MesgGroupMgrDel) Reset()                    { *m = MesgGroupMgrDel{} }
 func (m *MesgGroupMgrDel) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrDel) ProtoMessage()               {}
-func (*MesgGroupMgrDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*MesgGroupMgrDel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *MesgGroupMgrDel) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1863,7 +2650,7 @@ type MesgGroupMgrDelAck struct {
 func (m *MesgGroupMgrDelAck) Reset()                    { *m = MesgGroupMgrDelAck{} }
 func (m *MesgGroupMgrDelAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrDelAck) ProtoMessage()               {}
-func (*MesgGroupMgrDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*MesgGroupMgrDelAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *MesgGroupMgrDelAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -1886,13 +2673,14 @@ func (m *MesgGroupMgrDelAck) GetErrmsg() string {
 type MesgGroupUsrList struct {
 	Gid              *uint64 `protobuf:"varint,1,req,name=gid" json:"gid,omitempty"`
 	Num              *uint32 `protobuf:"varint,2,req,name=num" json:"num,omitempty"`
+	Cursor           *uint64 `protobuf:"varint,3,opt,name=cursor" json:"cursor,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgGroupUsrList) Reset()                    { *m = MesgGroupUsrList{} }
 func (m *MesgGroupUsrList) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupUsrList) ProtoMessage()               {}
-func (*MesgGroupUsrList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*MesgGroupUsrList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
 func (m *MesgGroupUsrList) GetGid() uint64 {
 	if m != nil && m.Gid != nil {
@@ -1908,6 +2696,13 @@ func (m *MesgGroupUsrList) GetNum() uint32 {
 	return 0
 }
 
+func (m *MesgGroupUsrList) GetCursor() uint64 {
+	if m != nil && m.Cursor != nil {
+		return *m.Cursor
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x031D
 // 命令描述: 群员列表应答(GROUP-USR-LIST-ACK)
@@ -1915,13 +2710,17 @@ func (m *MesgGroupUsrList) GetNum() uint32 {
 type MesgGroupUsrListAck struct {
 	Gid              *uint64 `protobuf:"varint,1,req,name=gid" json:"gid,omitempty"`
 	List             *string `protobuf:"bytes,2,req,name=list" json:"list,omitempty"`
+	Next             *uint64 `protobuf:"varint,3,opt,name=next" json:"next,omitempty"`
+	Total            *uint32 `protobuf:"varint,4,opt,name=total" json:"total,omitempty"`
+	Code             *uint32 `protobuf:"varint,5,opt,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,6,opt,name=errmsg" json:"errmsg,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgGroupUsrListAck) Reset()                    { *m = MesgGroupUsrListAck{} }
 func (m *MesgGroupUsrListAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupUsrListAck) ProtoMessage()               {}
-func (*MesgGroupUsrListAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*MesgGroupUsrListAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 func (m *MesgGroupUsrListAck) GetGid() uint64 {
 	if m != nil && m.Gid != nil {
@@ -1937,6 +2736,288 @@ func (m *MesgGroupUsrListAck) GetList() string {
 	return ""
 }
 
+func (m *MesgGroupUsrListAck) GetNext() uint64 {
+	if m != nil && m.Next != nil {
+		return *m.Next
+	}
+	return 0
+}
+
+func (m *MesgGroupUsrListAck) GetTotal() uint32 {
+	if m != nil && m.Total != nil {
+		return *m.Total
+	}
+	return 0
+}
+
+func (m *MesgGroupUsrListAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgGroupUsrListAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x031E
+// 命令描述: 入群审核(GROUP-JOIN-AUDIT)
+// 协议格式:
+type MesgGroupJoinAudit struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Gid              *uint64 `protobuf:"varint,2,req,name=gid" json:"gid,omitempty"`
+	To               *uint64 `protobuf:"varint,3,req,name=to" json:"to,omitempty"`
+	Pass             *uint32 `protobuf:"varint,4,req,name=pass" json:"pass,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupJoinAudit) Reset()                    { *m = MesgGroupJoinAudit{} }
+func (m *MesgGroupJoinAudit) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupJoinAudit) ProtoMessage()               {}
+func (*MesgGroupJoinAudit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *MesgGroupJoinAudit) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgGroupJoinAudit) GetGid() uint64 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
+func (m *MesgGroupJoinAudit) GetTo() uint64 {
+	if m != nil && m.To != nil {
+		return *m.To
+	}
+	return 0
+}
+
+func (m *MesgGroupJoinAudit) GetPass() uint32 {
+	if m != nil && m.Pass != nil {
+		return *m.Pass
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x031F
+// 命令描述: 入群审核应答(GROUP-JOIN-AUDIT-ACK)
+// 协议格式:
+type MesgGroupJoinAuditAck struct {
+	Code             *uint32 `protobuf:"varint,1,req,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,2,req,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupJoinAuditAck) Reset()                    { *m = MesgGroupJoinAuditAck{} }
+func (m *MesgGroupJoinAuditAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupJoinAuditAck) ProtoMessage()               {}
+func (*MesgGroupJoinAuditAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *MesgGroupJoinAuditAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgGroupJoinAuditAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0320
+// 命令描述: 群聊消息撤回(GROUP-RECALL)
+// 协议格式:
+type MesgGroupRecall struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Gid              *uint64 `protobuf:"varint,2,req,name=gid" json:"gid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,3,req,name=msgid" json:"msgid,omitempty"`
+	Time             *uint64 `protobuf:"varint,4,opt,name=time" json:"time,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupRecall) Reset()                    { *m = MesgGroupRecall{} }
+func (m *MesgGroupRecall) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupRecall) ProtoMessage()               {}
+func (*MesgGroupRecall) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *MesgGroupRecall) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgGroupRecall) GetGid() uint64 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
+func (m *MesgGroupRecall) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgGroupRecall) GetTime() uint64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0321
+// 命令描述: 群聊消息撤回应答(GROUP-RECALL-ACK)
+// 协议格式:
+type MesgGroupRecallAck struct {
+	Gid              *uint64 `protobuf:"varint,1,req,name=gid" json:"gid,omitempty"`
+	Msgid            *uint64 `protobuf:"varint,2,req,name=msgid" json:"msgid,omitempty"`
+	Code             *uint32 `protobuf:"varint,3,req,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,4,req,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupRecallAck) Reset()                    { *m = MesgGroupRecallAck{} }
+func (m *MesgGroupRecallAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupRecallAck) ProtoMessage()               {}
+func (*MesgGroupRecallAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *MesgGroupRecallAck) GetGid() uint64 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
+func (m *MesgGroupRecallAck) GetMsgid() uint64 {
+	if m != nil && m.Msgid != nil {
+		return *m.Msgid
+	}
+	return 0
+}
+
+func (m *MesgGroupRecallAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgGroupRecallAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
+//
+// 命令ID: 0x0322
+// 命令描述: 群聊消息同步(GROUP-SYNC)
+// 协议格式:
+type MesgGroupSync struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Gid              *uint64 `protobuf:"varint,2,opt,name=gid" json:"gid,omitempty"`
+	Since            *uint64 `protobuf:"varint,3,opt,name=since" json:"since,omitempty"`
+	Num              *uint32 `protobuf:"varint,4,opt,name=num" json:"num,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupSync) Reset()                    { *m = MesgGroupSync{} }
+func (m *MesgGroupSync) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupSync) ProtoMessage()               {}
+func (*MesgGroupSync) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *MesgGroupSync) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgGroupSync) GetGid() uint64 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
+func (m *MesgGroupSync) GetSince() uint64 {
+	if m != nil && m.Since != nil {
+		return *m.Since
+	}
+	return 0
+}
+
+func (m *MesgGroupSync) GetNum() uint32 {
+	if m != nil && m.Num != nil {
+		return *m.Num
+	}
+	return 0
+}
+
+//
+// 命令ID: 0x0323
+// 命令描述: 群聊消息同步应答(GROUP-SYNC-ACK)
+// 协议格式:
+type MesgGroupSyncAck struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	List             *string `protobuf:"bytes,2,req,name=list" json:"list,omitempty"`
+	Code             *uint32 `protobuf:"varint,3,opt,name=code" json:"code,omitempty"`
+	Errmsg           *string `protobuf:"bytes,4,opt,name=errmsg" json:"errmsg,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupSyncAck) Reset()                    { *m = MesgGroupSyncAck{} }
+func (m *MesgGroupSyncAck) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupSyncAck) ProtoMessage()               {}
+func (*MesgGroupSyncAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *MesgGroupSyncAck) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgGroupSyncAck) GetList() string {
+	if m != nil && m.List != nil {
+		return *m.List
+	}
+	return ""
+}
+
+func (m *MesgGroupSyncAck) GetCode() uint32 {
+	if m != nil && m.Code != nil {
+		return *m.Code
+	}
+	return 0
+}
+
+func (m *MesgGroupSyncAck) GetErrmsg() string {
+	if m != nil && m.Errmsg != nil {
+		return *m.Errmsg
+	}
+	return ""
+}
+
 //
 // 命令ID: 0x0350
 // 命令描述: 入群通知(GROUP-JOIN-NTF)
@@ -1950,7 +3031,7 @@ type MesgGroupJoinNtf struct {
 func (m *MesgGroupJoinNtf) Reset()                    { *m = MesgGroupJoinNtf{} }
 func (m *MesgGroupJoinNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupJoinNtf) ProtoMessage()               {}
-func (*MesgGroupJoinNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*MesgGroupJoinNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *MesgGroupJoinNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -1979,7 +3060,7 @@ type MesgGroupQuitNtf struct {
 func (m *MesgGroupQuitNtf) Reset()                    { *m = MesgGroupQuitNtf{} }
 func (m *MesgGroupQuitNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupQuitNtf) ProtoMessage()               {}
-func (*MesgGroupQuitNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*MesgGroupQuitNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *MesgGroupQuitNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2008,7 +3089,7 @@ type MesgGroupKickNtf struct {
 func (m *MesgGroupKickNtf) Reset()                    { *m = MesgGroupKickNtf{} }
 func (m *MesgGroupKickNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupKickNtf) ProtoMessage()               {}
-func (*MesgGroupKickNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*MesgGroupKickNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *MesgGroupKickNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2037,7 +3118,7 @@ type MesgGroupGagAddNtf struct {
 func (m *MesgGroupGagAddNtf) Reset()                    { *m = MesgGroupGagAddNtf{} }
 func (m *MesgGroupGagAddNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagAddNtf) ProtoMessage()               {}
-func (*MesgGroupGagAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*MesgGroupGagAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *MesgGroupGagAddNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2066,7 +3147,7 @@ type MesgGroupGagDelNtf struct {
 func (m *MesgGroupGagDelNtf) Reset()                    { *m = MesgGroupGagDelNtf{} }
 func (m *MesgGroupGagDelNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupGagDelNtf) ProtoMessage()               {}
-func (*MesgGroupGagDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*MesgGroupGagDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *MesgGroupGagDelNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2095,7 +3176,7 @@ type MesgGroupBlAddNtf struct {
 func (m *MesgGroupBlAddNtf) Reset()                    { *m = MesgGroupBlAddNtf{} }
 func (m *MesgGroupBlAddNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlAddNtf) ProtoMessage()               {}
-func (*MesgGroupBlAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*MesgGroupBlAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *MesgGroupBlAddNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2124,7 +3205,7 @@ type MesgGroupBlDelNtf struct {
 func (m *MesgGroupBlDelNtf) Reset()                    { *m = MesgGroupBlDelNtf{} }
 func (m *MesgGroupBlDelNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupBlDelNtf) ProtoMessage()               {}
-func (*MesgGroupBlDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*MesgGroupBlDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *MesgGroupBlDelNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2153,7 +3234,7 @@ type MesgGroupMgrAddNtf struct {
 func (m *MesgGroupMgrAddNtf) Reset()                    { *m = MesgGroupMgrAddNtf{} }
 func (m *MesgGroupMgrAddNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrAddNtf) ProtoMessage()               {}
-func (*MesgGroupMgrAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*MesgGroupMgrAddNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *MesgGroupMgrAddNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2182,7 +3263,7 @@ type MesgGroupMgrDelNtf struct {
 func (m *MesgGroupMgrDelNtf) Reset()                    { *m = MesgGroupMgrDelNtf{} }
 func (m *MesgGroupMgrDelNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgGroupMgrDelNtf) ProtoMessage()               {}
-func (*MesgGroupMgrDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*MesgGroupMgrDelNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *MesgGroupMgrDelNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2198,6 +3279,35 @@ func (m *MesgGroupMgrDelNtf) GetGid() uint64 {
 	return 0
 }
 
+//
+// 命令ID: 0x0368
+// 命令描述: 解散群组通知(GROUP-DISMISS-NTF)
+// 协议格式:
+type MesgGroupDismissNtf struct {
+	Uid              *uint64 `protobuf:"varint,1,req,name=uid" json:"uid,omitempty"`
+	Gid              *uint64 `protobuf:"varint,2,req,name=gid" json:"gid,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MesgGroupDismissNtf) Reset()                    { *m = MesgGroupDismissNtf{} }
+func (m *MesgGroupDismissNtf) String() string            { return proto.CompactTextString(m) }
+func (*MesgGroupDismissNtf) ProtoMessage()               {}
+func (*MesgGroupDismissNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *MesgGroupDismissNtf) GetUid() uint64 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *MesgGroupDismissNtf) GetGid() uint64 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0401
 // 命令描述: 创建聊天室(ROOM-CREAT)
@@ -2212,7 +3322,7 @@ type MesgRoomCreat struct {
 func (m *MesgRoomCreat) Reset()                    { *m = MesgRoomCreat{} }
 func (m *MesgRoomCreat) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomCreat) ProtoMessage()               {}
-func (*MesgRoomCreat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*MesgRoomCreat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *MesgRoomCreat) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2250,7 +3360,7 @@ type MesgRoomCreatAck struct {
 func (m *MesgRoomCreatAck) Reset()                    { *m = MesgRoomCreatAck{} }
 func (m *MesgRoomCreatAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomCreatAck) ProtoMessage()               {}
-func (*MesgRoomCreatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*MesgRoomCreatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 func (m *MesgRoomCreatAck) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2293,7 +3403,7 @@ type MesgRoomDismiss struct {
 func (m *MesgRoomDismiss) Reset()                    { *m = MesgRoomDismiss{} }
 func (m *MesgRoomDismiss) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomDismiss) ProtoMessage()               {}
-func (*MesgRoomDismiss) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*MesgRoomDismiss) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *MesgRoomDismiss) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2322,7 +3432,7 @@ type MesgRoomDismissAck struct {
 func (m *MesgRoomDismissAck) Reset()                    { *m = MesgRoomDismissAck{} }
 func (m *MesgRoomDismissAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomDismissAck) ProtoMessage()               {}
-func (*MesgRoomDismissAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*MesgRoomDismissAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 func (m *MesgRoomDismissAck) GetCode() uint32 {
 	if m != nil && m.Code != nil {
@@ -2352,7 +3462,7 @@ type MesgRoomJoin struct {
 func (m *MesgRoomJoin) Reset()                    { *m = MesgRoomJoin{} }
 func (m *MesgRoomJoin) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomJoin) ProtoMessage()               {}
-func (*MesgRoomJoin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*MesgRoomJoin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 func (m *MesgRoomJoin) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2384,7 +3494,7 @@ type MesgRoomJoinAck struct {
 func (m *MesgRoomJoinAck) Reset()                    { *m = MesgRoomJoinAck{} }
 func (m *MesgRoomJoinAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomJoinAck) ProtoMessage()               {}
-func (*MesgRoomJoinAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*MesgRoomJoinAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 func (m *MesgRoomJoinAck) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2434,7 +3544,7 @@ type MesgRoomQuit struct {
 func (m *MesgRoomQuit) Reset()                    { *m = MesgRoomQuit{} }
 func (m *MesgRoomQuit) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomQuit) ProtoMessage()               {}
-func (*MesgRoomQuit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*MesgRoomQuit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *MesgRoomQuit) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2465,7 +3575,7 @@ type MesgRoomQuitAck struct {
 func (m *MesgRoomQuitAck) Reset()                    { *m = MesgRoomQuitAck{} }
 func (m *MesgRoomQuitAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomQuitAck) ProtoMessage()               {}
-func (*MesgRoomQuitAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*MesgRoomQuitAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 func (m *MesgRoomQuitAck) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2508,7 +3618,7 @@ type MesgRoomKick struct {
 func (m *MesgRoomKick) Reset()                    { *m = MesgRoomKick{} }
 func (m *MesgRoomKick) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomKick) ProtoMessage()               {}
-func (*MesgRoomKick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*MesgRoomKick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *MesgRoomKick) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2539,7 +3649,7 @@ type MesgRoomKickAck struct {
 func (m *MesgRoomKickAck) Reset()                    { *m = MesgRoomKickAck{} }
 func (m *MesgRoomKickAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomKickAck) ProtoMessage()               {}
-func (*MesgRoomKickAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*MesgRoomKickAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
 func (m *MesgRoomKickAck) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2587,7 +3697,7 @@ type MesgRoomChat struct {
 func (m *MesgRoomChat) Reset()                    { *m = MesgRoomChat{} }
 func (m *MesgRoomChat) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomChat) ProtoMessage()               {}
-func (*MesgRoomChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*MesgRoomChat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
 func (m *MesgRoomChat) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2654,7 +3764,7 @@ type MesgRoomChatAck struct {
 func (m *MesgRoomChatAck) Reset()                    { *m = MesgRoomChatAck{} }
 func (m *MesgRoomChatAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomChatAck) ProtoMessage()               {}
-func (*MesgRoomChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*MesgRoomChatAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 func (m *MesgRoomChatAck) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2708,7 +3818,7 @@ type MesgRoomBc struct {
 func (m *MesgRoomBc) Reset()                    { *m = MesgRoomBc{} }
 func (m *MesgRoomBc) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomBc) ProtoMessage()               {}
-func (*MesgRoomBc) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*MesgRoomBc) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 func (m *MesgRoomBc) GetRid() uint64 {
 	if m != nil && m.Rid != nil {
@@ -2767,7 +3877,7 @@ type MesgRoomBcAck struct {
 func (m *MesgRoomBcAck) Reset()                    { *m = MesgRoomBcAck{} }
 func (m *MesgRoomBcAck) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomBcAck) ProtoMessage()               {}
-func (*MesgRoomBcAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*MesgRoomBcAck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *MesgRoomBcAck) GetRid() uint64 {
 	if m != nil && m.Rid != nil {
@@ -2810,7 +3920,7 @@ type MesgRoomUsrNum struct {
 func (m *MesgRoomUsrNum) Reset()                    { *m = MesgRoomUsrNum{} }
 func (m *MesgRoomUsrNum) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomUsrNum) ProtoMessage()               {}
-func (*MesgRoomUsrNum) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*MesgRoomUsrNum) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *MesgRoomUsrNum) GetRid() uint64 {
 	if m != nil && m.Rid != nil {
@@ -2840,7 +3950,7 @@ type MesgRoomLsnStat struct {
 func (m *MesgRoomLsnStat) Reset()                    { *m = MesgRoomLsnStat{} }
 func (m *MesgRoomLsnStat) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomLsnStat) ProtoMessage()               {}
-func (*MesgRoomLsnStat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*MesgRoomLsnStat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 func (m *MesgRoomLsnStat) GetRid() uint64 {
 	if m != nil && m.Rid != nil {
@@ -2876,7 +3986,7 @@ type MesgRoomJoinNtf struct {
 func (m *MesgRoomJoinNtf) Reset()                    { *m = MesgRoomJoinNtf{} }
 func (m *MesgRoomJoinNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomJoinNtf) ProtoMessage()               {}
-func (*MesgRoomJoinNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*MesgRoomJoinNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *MesgRoomJoinNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2905,7 +4015,7 @@ type MesgRoomQuitNtf struct {
 func (m *MesgRoomQuitNtf) Reset()                    { *m = MesgRoomQuitNtf{} }
 func (m *MesgRoomQuitNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomQuitNtf) ProtoMessage()               {}
-func (*MesgRoomQuitNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*MesgRoomQuitNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 func (m *MesgRoomQuitNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2934,7 +4044,7 @@ type MesgRoomKickNtf struct {
 func (m *MesgRoomKickNtf) Reset()                    { *m = MesgRoomKickNtf{} }
 func (m *MesgRoomKickNtf) String() string            { return proto.CompactTextString(m) }
 func (*MesgRoomKickNtf) ProtoMessage()               {}
-func (*MesgRoomKickNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*MesgRoomKickNtf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 func (m *MesgRoomKickNtf) GetUid() uint64 {
 	if m != nil && m.Uid != nil {
@@ -2962,13 +4072,15 @@ type MesgLsndInfo struct {
 	Ip               *string `protobuf:"bytes,5,req,name=ip" json:"ip,omitempty"`
 	Port             *uint32 `protobuf:"varint,6,req,name=port" json:"port,omitempty"`
 	Connections      *uint32 `protobuf:"varint,7,req,name=connections" json:"connections,omitempty"`
+	Ipv6             *string `protobuf:"bytes,8,opt,name=ipv6" json:"ipv6,omitempty"`
+	Status           *uint32 `protobuf:"varint,9,opt,name=status" json:"status,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *MesgLsndInfo) Reset()                    { *m = MesgLsndInfo{} }
 func (m *MesgLsndInfo) String() string            { return proto.CompactTextString(m) }
 func (*MesgLsndInfo) ProtoMessage()               {}
-func (*MesgLsndInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*MesgLsndInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 func (m *MesgLsndInfo) GetType() uint32 {
 	if m != nil && m.Type != nil {
@@ -3019,6 +4131,20 @@ func (m *MesgLsndInfo) GetConnections() uint32 {
 	return 0
 }
 
+func (m *MesgLsndInfo) GetIpv6() string {
+	if m != nil && m.Ipv6 != nil {
+		return *m.Ipv6
+	}
+	return ""
+}
+
+func (m *MesgLsndInfo) GetStatus() uint32 {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return 0
+}
+
 //
 // 命令ID: 0x0603
 // 命令描述: 转发层信息上报 (FRWD-INFO)
@@ -3034,7 +4160,7 @@ type MesgFrwdInfo struct {
 func (m *MesgFrwdInfo) Reset()                    { *m = MesgFrwdInfo{} }
 func (m *MesgFrwdInfo) String() string            { return proto.CompactTextString(m) }
 func (*MesgFrwdInfo) ProtoMessage()               {}
-func (*MesgFrwdInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*MesgFrwdInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 func (m *MesgFrwdInfo) GetNid() uint32 {
 	if m != nil && m.Nid != nil {
@@ -3075,6 +4201,12 @@ func init() {
 	proto.RegisterType((*MesgSync)(nil), "mesg_sync")
 	proto.RegisterType((*MesgSyncAck)(nil), "mesg_sync_ack")
 	proto.RegisterType((*MesgKick)(nil), "mesg_kick")
+	proto.RegisterType((*MesgConvList)(nil), "mesg_conv_list")
+	proto.RegisterType((*MesgConvListAck)(nil), "mesg_conv_list_ack")
+	proto.RegisterType((*MesgConvRead)(nil), "mesg_conv_read")
+	proto.RegisterType((*MesgConvReadAck)(nil), "mesg_conv_read_ack")
+	proto.RegisterType((*MesgOnlineNtf)(nil), "mesg_online_ntf")
+	proto.RegisterType((*MesgOfflineNtf)(nil), "mesg_offline_ntf")
 	proto.RegisterType((*MesgChat)(nil), "mesg_chat")
 	proto.RegisterType((*MesgChatAck)(nil), "mesg_chat_ack")
 	proto.RegisterType((*MesgFriendAdd)(nil), "mesg_friend_add")
@@ -3093,6 +4225,14 @@ func init() {
 	proto.RegisterType((*MesgMarkAddAck)(nil), "mesg_mark_add_ack")
 	proto.RegisterType((*MesgMarkDel)(nil), "mesg_mark_del")
 	proto.RegisterType((*MesgMarkDelAck)(nil), "mesg_mark_del_ack")
+	proto.RegisterType((*MesgFriendReply)(nil), "mesg_friend_reply")
+	proto.RegisterType((*MesgFriendReplyAck)(nil), "mesg_friend_reply_ack")
+	proto.RegisterType((*MesgFriendList)(nil), "mesg_friend_list")
+	proto.RegisterType((*MesgFriendListAck)(nil), "mesg_friend_list_ack")
+	proto.RegisterType((*MesgChatReceipt)(nil), "mesg_chat_receipt")
+	proto.RegisterType((*MesgChatReceiptAck)(nil), "mesg_chat_receipt_ack")
+	proto.RegisterType((*MesgChatRecall)(nil), "mesg_chat_recall")
+	proto.RegisterType((*MesgChatRecallAck)(nil), "mesg_chat_recall_ack")
 	proto.RegisterType((*MesgGroupCreat)(nil), "mesg_group_creat")
 	proto.RegisterType((*MesgGroupCreatAck)(nil), "mesg_group_creat_ack")
 	proto.RegisterType((*MesgGroupDismiss)(nil), "mesg_group_dismiss")
@@ -3121,6 +4261,12 @@ func init() {
 	proto.RegisterType((*MesgGroupMgrDelAck)(nil), "mesg_group_mgr_del_ack")
 	proto.RegisterType((*MesgGroupUsrList)(nil), "mesg_group_usr_list")
 	proto.RegisterType((*MesgGroupUsrListAck)(nil), "mesg_group_usr_list_ack")
+	proto.RegisterType((*MesgGroupJoinAudit)(nil), "mesg_group_join_audit")
+	proto.RegisterType((*MesgGroupJoinAuditAck)(nil), "mesg_group_join_audit_ack")
+	proto.RegisterType((*MesgGroupRecall)(nil), "mesg_group_recall")
+	proto.RegisterType((*MesgGroupRecallAck)(nil), "mesg_group_recall_ack")
+	proto.RegisterType((*MesgGroupSync)(nil), "mesg_group_sync")
+	proto.RegisterType((*MesgGroupSyncAck)(nil), "mesg_group_sync_ack")
 	proto.RegisterType((*MesgGroupJoinNtf)(nil), "mesg_group_join_ntf")
 	proto.RegisterType((*MesgGroupQuitNtf)(nil), "mesg_group_quit_ntf")
 	proto.RegisterType((*MesgGroupKickNtf)(nil), "mesg_group_kick_ntf")
@@ -3130,6 +4276,7 @@ func init() {
 	proto.RegisterType((*MesgGroupBlDelNtf)(nil), "mesg_group_bl_del_ntf")
 	proto.RegisterType((*MesgGroupMgrAddNtf)(nil), "mesg_group_mgr_add_ntf")
 	proto.RegisterType((*MesgGroupMgrDelNtf)(nil), "mesg_group_mgr_del_ntf")
+	proto.RegisterType((*MesgGroupDismissNtf)(nil), "mesg_group_dismiss_ntf")
 	proto.RegisterType((*MesgRoomCreat)(nil), "mesg_room_creat")
 	proto.RegisterType((*MesgRoomCreatAck)(nil), "mesg_room_creat_ack")
 	proto.RegisterType((*MesgRoomDismiss)(nil), "mesg_room_dismiss")
@@ -3156,69 +4303,97 @@ func init() {
 func init() { proto.RegisterFile("mesg.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1019 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x5f, 0x93, 0x9b, 0x36,
-	0x10, 0xc0, 0xc7, 0x9c, 0xef, 0x4f, 0xf6, 0xcc, 0x5d, 0xe2, 0x5c, 0x1b, 0xfa, 0x76, 0xc3, 0x93,
-	0x9b, 0x4e, 0x2e, 0x7f, 0x9a, 0x76, 0x26, 0xb9, 0x2f, 0xd0, 0xce, 0xa4, 0x8f, 0x7d, 0xe8, 0x13,
-	0xc5, 0x20, 0xbb, 0xaa, 0x41, 0x22, 0x12, 0x5c, 0x2e, 0x5f, 0xa0, 0x9f, 0xbb, 0x23, 0x21, 0x81,
-	0x64, 0x30, 0x08, 0x4f, 0x1e, 0x31, 0xbb, 0xfb, 0xdb, 0x5d, 0xed, 0x6a, 0x17, 0x03, 0xe4, 0x88,
-	0x6f, 0xef, 0x0a, 0x46, 0x4b, 0x1a, 0x6e, 0xe0, 0x52, 0x3c, 0x45, 0x94, 0x64, 0x98, 0xa0, 0xe5,
-	0x25, 0x9c, 0x54, 0x38, 0x0d, 0x66, 0xb7, 0xde, 0x6a, 0x2e, 0x1e, 0x38, 0x4e, 0x03, 0x4f, 0x3e,
-	0xf8, 0x70, 0x5a, 0xd2, 0x1d, 0x22, 0xc1, 0xc9, 0xad, 0xb7, 0x7a, 0x22, 0xde, 0xc5, 0x45, 0x11,
-	0xcc, 0xe5, 0xc3, 0x35, 0x9c, 0x3f, 0x20, 0xc6, 0x31, 0x25, 0xc1, 0xa9, 0xfc, 0xe1, 0x29, 0x5c,
-	0x94, 0x88, 0xe5, 0x98, 0xc4, 0x59, 0x70, 0x76, 0x3b, 0x5b, 0xf9, 0xe1, 0x7f, 0x33, 0xb8, 0x36,
-	0x40, 0x51, 0x9c, 0xec, 0x06, 0x60, 0xe2, 0x01, 0x7d, 0x96, 0xa8, 0xf9, 0x54, 0xd4, 0x72, 0x01,
-	0xf3, 0x84, 0xa6, 0x28, 0x38, 0xbf, 0xf5, 0x56, 0xfe, 0xf2, 0x0a, 0xce, 0x10, 0x63, 0x39, 0xdf,
-	0x06, 0x17, 0x42, 0x3e, 0x7c, 0x01, 0x17, 0xd2, 0x0f, 0x5e, 0xad, 0x85, 0xe5, 0x24, 0x17, 0x0e,
-	0x08, 0x0f, 0x3f, 0xc0, 0x42, 0xbf, 0xd0, 0xde, 0xd5, 0x2f, 0x3d, 0xc3, 0xa6, 0xb7, 0x67, 0x53,
-	0x26, 0x23, 0xfc, 0xa1, 0x4e, 0x69, 0x54, 0x11, 0xcb, 0xaa, 0xb7, 0xf2, 0xc3, 0x7b, 0xb8, 0x6a,
-	0x5f, 0x4d, 0xb5, 0xfb, 0x52, 0xd9, 0x45, 0x8c, 0x51, 0xd6, 0xc8, 0xce, 0xf6, 0x64, 0x3d, 0x29,
-	0x1b, 0xc0, 0x93, 0xda, 0xfd, 0xaf, 0x24, 0xb1, 0x32, 0x1b, 0x7e, 0x04, 0xbf, 0x79, 0xd3, 0xcd,
-	0xfb, 0xb0, 0x07, 0x3f, 0x2a, 0xab, 0x3b, 0x9c, 0xec, 0x46, 0x1c, 0xf8, 0x5b, 0x89, 0x26, 0xff,
-	0xc4, 0xa5, 0x10, 0xe5, 0x16, 0x23, 0xad, 0xcc, 0x4a, 0xca, 0xd0, 0x03, 0xca, 0x24, 0x42, 0xa6,
-	0xa0, 0xc4, 0x39, 0x92, 0xe7, 0x2b, 0x45, 0x4b, 0xf4, 0x58, 0xaa, 0xc3, 0x15, 0x8a, 0x71, 0x19,
-	0xcb, 0x83, 0x5d, 0x84, 0xbf, 0xab, 0x40, 0x04, 0x41, 0x06, 0x32, 0x44, 0xd1, 0xce, 0x9e, 0xec,
-	0x39, 0x2b, 0xcb, 0x28, 0xbc, 0x57, 0xd5, 0xb8, 0x61, 0x18, 0x91, 0x34, 0x8a, 0xd3, 0x74, 0xcc,
-	0x58, 0x1e, 0xb3, 0x9d, 0x4a, 0xca, 0xcf, 0xf0, 0x7c, 0x4f, 0x59, 0x7b, 0x33, 0x90, 0x9e, 0x57,
-	0x36, 0x31, 0x45, 0xd9, 0x10, 0x71, 0x9f, 0x91, 0xa2, 0xcc, 0x81, 0xf1, 0x06, 0x96, 0x52, 0x69,
-	0x9d, 0xc5, 0xc9, 0x2e, 0xc3, 0xbc, 0x1c, 0x0b, 0x2c, 0xfc, 0x15, 0xbe, 0xef, 0x6a, 0x1c, 0x45,
-	0x1a, 0x0b, 0xa8, 0x4b, 0x72, 0x8b, 0xe9, 0xa5, 0x6a, 0xcb, 0x6d, 0xbc, 0x1d, 0x8d, 0xe6, 0x0d,
-	0x3c, 0x35, 0x65, 0x27, 0x5a, 0x1f, 0x8b, 0xc0, 0xb4, 0xee, 0xe6, 0xfb, 0x07, 0x55, 0xb0, 0xa2,
-	0x76, 0x26, 0xd5, 0xd8, 0x3c, 0x7c, 0x0b, 0xcf, 0x2c, 0x55, 0x07, 0xda, 0x4f, 0x26, 0x6d, 0x2c,
-	0x18, 0xcb, 0xbe, 0x5b, 0x34, 0xbf, 0xe9, 0xf8, 0x19, 0xad, 0x8a, 0x28, 0x61, 0x28, 0x2e, 0x3b,
-	0x57, 0xf8, 0xd6, 0x0c, 0x87, 0xc4, 0x39, 0x52, 0xe3, 0x42, 0xc0, 0x11, 0x4f, 0x54, 0xf7, 0xbd,
-	0x87, 0x9b, 0x7d, 0x4b, 0x0e, 0xfc, 0x3b, 0x55, 0x73, 0xb5, 0x56, 0x8a, 0x79, 0x8e, 0x39, 0x3f,
-	0xec, 0x41, 0x53, 0x71, 0x96, 0xbc, 0x53, 0x1e, 0xaf, 0x0d, 0xbd, 0x7f, 0x29, 0x26, 0x03, 0x10,
-	0xdd, 0xa7, 0xad, 0xf0, 0x64, 0xc2, 0xe7, 0x0a, 0x97, 0xce, 0x04, 0x21, 0xec, 0x54, 0x79, 0xcf,
-	0x0c, 0x25, 0x4c, 0x1e, 0x70, 0x89, 0x06, 0x0e, 0x0b, 0xc0, 0x2b, 0xa9, 0xaa, 0xbc, 0x5f, 0xe0,
-	0xbb, 0x8e, 0xaa, 0x03, 0x31, 0xb5, 0x62, 0x92, 0x43, 0xe0, 0x30, 0xef, 0xe8, 0x11, 0x60, 0x27,
-	0xc3, 0x1c, 0x04, 0xce, 0xe9, 0x96, 0xa3, 0xcc, 0x35, 0xdd, 0x42, 0x78, 0x72, 0x69, 0xea, 0xab,
-	0xca, 0xb5, 0x34, 0xdd, 0xaf, 0xab, 0x2e, 0x47, 0xf4, 0xf9, 0x14, 0x8e, 0x5b, 0xab, 0xbf, 0xb2,
-	0xca, 0x67, 0x9d, 0x8d, 0x84, 0x63, 0x97, 0x4c, 0x2d, 0x7e, 0x0c, 0x65, 0x38, 0x98, 0x0e, 0xc5,
-	0x2d, 0x16, 0x3b, 0x67, 0xf9, 0x96, 0x4d, 0x3a, 0x1b, 0x25, 0x7f, 0x14, 0x67, 0xca, 0xd9, 0x28,
-	0x79, 0x07, 0xce, 0x6b, 0xab, 0x40, 0x2b, 0xce, 0x22, 0x31, 0x4d, 0xb5, 0xed, 0x06, 0x44, 0xaa,
-	0xbc, 0xde, 0xe9, 0xc2, 0xf7, 0xf0, 0xa2, 0x47, 0x41, 0x6f, 0x82, 0x5b, 0x73, 0x40, 0x88, 0x17,
-	0xbd, 0x18, 0x79, 0xb1, 0x91, 0x72, 0x33, 0x10, 0xcf, 0xeb, 0xee, 0x3d, 0x35, 0x45, 0x41, 0x76,
-	0xda, 0xb0, 0xc2, 0xbb, 0xde, 0xae, 0x99, 0xaa, 0x23, 0xb2, 0x3c, 0xac, 0xf3, 0xb6, 0xaf, 0x9c,
-	0x27, 0xaa, 0x8c, 0x53, 0xde, 0xf5, 0xd6, 0xd9, 0x54, 0x9d, 0x71, 0xce, 0x47, 0x75, 0xfb, 0x31,
-	0x4a, 0xf3, 0xbe, 0xa9, 0xad, 0x07, 0xb5, 0x67, 0x0d, 0xea, 0x7a, 0xd3, 0xfd, 0x43, 0x1d, 0x51,
-	0xab, 0xdb, 0xfb, 0xe1, 0xc6, 0x1c, 0xb7, 0x6e, 0xdd, 0xf0, 0xd2, 0xdc, 0xa1, 0x01, 0xce, 0x3a,
-	0x0d, 0x6f, 0x8a, 0x3b, 0xed, 0x74, 0x57, 0xad, 0x5a, 0xef, 0xf8, 0x6e, 0x11, 0x7f, 0xa9, 0xa6,
-	0x6d, 0x64, 0x47, 0xe2, 0x53, 0x99, 0x3d, 0xb1, 0x3e, 0xde, 0xe6, 0x7b, 0x6e, 0x9c, 0x76, 0xdd,
-	0xe8, 0x9d, 0xf1, 0xad, 0x1b, 0x9f, 0x4c, 0x37, 0x9a, 0x11, 0x7f, 0x6c, 0x9a, 0x2d, 0x72, 0xef,
-	0xb8, 0x3b, 0x40, 0x6e, 0xa6, 0xdd, 0xb1, 0xe4, 0xc2, 0x24, 0xf7, 0xee, 0x00, 0x07, 0x52, 0xd9,
-	0x2c, 0x04, 0x73, 0x6b, 0x21, 0x38, 0xb5, 0x16, 0x82, 0x33, 0x6b, 0x21, 0x38, 0x97, 0x0b, 0x81,
-	0x75, 0x80, 0xcd, 0x3e, 0xf0, 0x4d, 0x0e, 0x10, 0xa9, 0x6f, 0x03, 0x69, 0x7a, 0x9d, 0x68, 0x3b,
-	0x33, 0xbd, 0xc1, 0xe4, 0xdc, 0x71, 0xa1, 0x11, 0x86, 0x1f, 0x0b, 0xcc, 0xea, 0x78, 0x7c, 0x63,
-	0xa5, 0xf1, 0x56, 0x8b, 0xf0, 0x93, 0x5a, 0xab, 0x15, 0x46, 0xfb, 0x7f, 0x10, 0x35, 0xa1, 0xc5,
-	0xc4, 0x5d, 0x4f, 0xaa, 0xdc, 0x36, 0x67, 0xcd, 0x86, 0x7b, 0x33, 0x7d, 0x19, 0x27, 0x11, 0x2f,
-	0xeb, 0x43, 0xb3, 0xe5, 0x15, 0xdc, 0xd7, 0xca, 0x92, 0xdd, 0x4c, 0xbc, 0xb6, 0x79, 0xfa, 0x6e,
-	0xa2, 0xb6, 0xd6, 0xee, 0x3a, 0x55, 0x3e, 0x41, 0xfe, 0xe0, 0x7c, 0x68, 0xe5, 0x1f, 0x55, 0xf5,
-	0x65, 0x9c, 0xa4, 0x11, 0x26, 0x1b, 0x2a, 0xcf, 0xe1, 0x6b, 0xa1, 0x2f, 0x0a, 0x2b, 0x92, 0x05,
-	0xcc, 0x69, 0xd1, 0x54, 0xc2, 0x15, 0x9c, 0x91, 0xb8, 0xc4, 0x94, 0xa8, 0xbf, 0x99, 0x00, 0x3c,
-	0x5c, 0xb4, 0x1b, 0x68, 0x41, 0x59, 0x5d, 0x7e, 0xfe, 0xf2, 0x39, 0x5c, 0x26, 0x94, 0x10, 0x94,
-	0x08, 0x69, 0x5e, 0xff, 0xc9, 0x14, 0xfe, 0xa9, 0xc8, 0x1b, 0xf6, 0x45, 0x91, 0x15, 0xab, 0x06,
-	0xd7, 0xd6, 0xea, 0x0b, 0xf6, 0x06, 0x16, 0x1b, 0xca, 0xbe, 0xc4, 0x2c, 0x8d, 0xa4, 0xd5, 0x9a,
-	0x7f, 0x03, 0x8b, 0x75, 0x9c, 0xec, 0xc4, 0xa7, 0xbe, 0xfc, 0x55, 0x56, 0xe4, 0xff, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x41, 0xc0, 0xf3, 0xa7, 0xa9, 0x13, 0x00, 0x00,
+	// 1462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xd7, 0x7a, 0xbd, 0x9b, 0xe4, 0x75, 0x9d, 0x8f, 0x4d, 0x02, 0xe6, 0x16, 0x59, 0x08, 0x85,
+	0x42, 0xd3, 0x0f, 0xa0, 0x52, 0x29, 0x15, 0x12, 0x97, 0x22, 0xda, 0x22, 0x21, 0x01, 0xa2, 0x17,
+	0x56, 0x8e, 0x3d, 0xbb, 0x0c, 0x6b, 0x8f, 0xdd, 0xb1, 0x9d, 0x36, 0x12, 0x27, 0x0e, 0x9c, 0x39,
+	0xf1, 0xf7, 0xa2, 0xf9, 0xb0, 0x3d, 0x63, 0x3b, 0xde, 0xf1, 0x36, 0x47, 0xaf, 0xdf, 0xfb, 0xfd,
+	0xde, 0x7b, 0xf3, 0xbe, 0x3c, 0x0b, 0x10, 0xa3, 0x6c, 0x75, 0x91, 0xd2, 0x24, 0x4f, 0xbc, 0x25,
+	0xdc, 0x61, 0x4f, 0x8b, 0x84, 0x44, 0x98, 0xa0, 0xf9, 0x1d, 0x18, 0x17, 0x38, 0x74, 0x47, 0x67,
+	0xd6, 0xb9, 0xcd, 0x1e, 0x32, 0x1c, 0xba, 0x16, 0x7f, 0x70, 0x60, 0x92, 0x27, 0x6b, 0x44, 0xdc,
+	0xf1, 0x99, 0x75, 0xbe, 0xc7, 0xde, 0xf9, 0x69, 0xea, 0xda, 0xfc, 0xe1, 0x00, 0x76, 0xae, 0x10,
+	0xcd, 0x70, 0x42, 0xdc, 0x09, 0xff, 0xe1, 0x10, 0x76, 0x73, 0x44, 0x63, 0x4c, 0xfc, 0xc8, 0x9d,
+	0x9e, 0x8d, 0xce, 0x1d, 0xef, 0x9f, 0x11, 0x1c, 0x28, 0x44, 0x0b, 0x3f, 0x58, 0xf7, 0x90, 0xb1,
+	0x07, 0xf4, 0xc6, 0x1d, 0x97, 0x0f, 0x43, 0xa8, 0xe6, 0x33, 0xb0, 0x83, 0x24, 0x44, 0xee, 0xce,
+	0x99, 0x75, 0xee, 0xcc, 0xf7, 0x61, 0x8a, 0x28, 0x8d, 0xb3, 0x95, 0xbb, 0xcb, 0xe4, 0xbd, 0x8f,
+	0x61, 0x97, 0xdb, 0x91, 0x15, 0x97, 0x0c, 0x39, 0x88, 0x99, 0x01, 0x4c, 0x4d, 0x5a, 0x63, 0x9d,
+	0x8d, 0xce, 0x6d, 0xef, 0x09, 0xcc, 0x4a, 0xa9, 0xd2, 0x54, 0x21, 0x69, 0x29, 0x04, 0x56, 0x83,
+	0x80, 0x47, 0xc6, 0xfb, 0x44, 0xc4, 0x77, 0x51, 0x10, 0x8d, 0xc2, 0x6a, 0x52, 0x3c, 0x85, 0xfd,
+	0x5a, 0x6e, 0x28, 0xc9, 0x5d, 0x49, 0x82, 0x28, 0x4d, 0x68, 0x25, 0x3b, 0x6a, 0xc8, 0x5a, 0x5c,
+	0xf6, 0x05, 0xec, 0x09, 0x5f, 0xae, 0x49, 0xa0, 0xc7, 0xdc, 0x81, 0x49, 0x86, 0x49, 0x80, 0x84,
+	0x45, 0xec, 0x1d, 0x29, 0x62, 0x77, 0xcc, 0xc3, 0x71, 0x0a, 0x0e, 0x45, 0x01, 0xc2, 0x69, 0xbe,
+	0x10, 0x32, 0x36, 0xb7, 0xfa, 0xef, 0x11, 0x38, 0x15, 0x5a, 0xfb, 0x14, 0x7b, 0xad, 0x66, 0x6f,
+	0x09, 0x7a, 0x97, 0x0b, 0x28, 0xf6, 0x14, 0x27, 0x14, 0xb9, 0x13, 0xce, 0x77, 0x02, 0xb3, 0x92,
+	0x8f, 0xcb, 0x4c, 0xb9, 0x8c, 0xf2, 0x2b, 0x97, 0xdd, 0xe1, 0xc9, 0xf4, 0xa9, 0xf4, 0x68, 0x8d,
+	0x83, 0xf5, 0x06, 0xe7, 0xef, 0xca, 0x28, 0x07, 0x09, 0xb9, 0x5a, 0x44, 0x38, 0xcb, 0x5b, 0x59,
+	0xc7, 0x5c, 0xb6, 0x38, 0xec, 0x2b, 0x98, 0xeb, 0xb2, 0x9d, 0xfe, 0xb1, 0x17, 0x02, 0xbc, 0xa2,
+	0x16, 0x11, 0xab, 0xa9, 0x99, 0x7f, 0x7b, 0xde, 0xcf, 0x2a, 0x35, 0x45, 0x7e, 0xd8, 0x82, 0xca,
+	0xaf, 0xd3, 0x32, 0x54, 0x00, 0x16, 0x0e, 0xdd, 0xb1, 0x6a, 0x94, 0x5d, 0xa2, 0x16, 0x84, 0x69,
+	0x8b, 0x38, 0x79, 0x58, 0x35, 0x92, 0xfd, 0xde, 0x69, 0xe4, 0x0d, 0xc8, 0x35, 0x98, 0xad, 0x95,
+	0xca, 0xa4, 0xe1, 0xc0, 0x94, 0x3b, 0xf0, 0xb9, 0x5e, 0xb2, 0x24, 0x5f, 0xb6, 0x79, 0x70, 0x2c,
+	0x78, 0x6c, 0xef, 0x1e, 0x1c, 0x0a, 0xe9, 0xe5, 0xd2, 0x44, 0xfc, 0x2f, 0x79, 0x86, 0xc1, 0x1f,
+	0x7e, 0xce, 0x5e, 0x65, 0x9a, 0x60, 0x58, 0xa8, 0x8d, 0x27, 0x42, 0x57, 0x28, 0xe2, 0x2e, 0x38,
+	0x15, 0x8a, 0x5d, 0x61, 0xb2, 0x6c, 0x99, 0x94, 0xe7, 0x11, 0xfa, 0xb9, 0xcf, 0xcd, 0x9f, 0x95,
+	0x1d, 0x65, 0x87, 0x27, 0x92, 0x03, 0x93, 0x38, 0x5b, 0xe1, 0xd0, 0xdd, 0x65, 0x8f, 0xde, 0xaf,
+	0xe0, 0x54, 0xec, 0x3c, 0x80, 0x7d, 0x16, 0xd4, 0xc7, 0x6c, 0x35, 0x8e, 0x59, 0x76, 0x42, 0x46,
+	0x33, 0x91, 0x45, 0x2d, 0x42, 0xb6, 0xa4, 0x18, 0x91, 0x70, 0xe1, 0x87, 0xe1, 0x26, 0xe4, 0xd8,
+	0xa7, 0x6b, 0x59, 0xd4, 0x5f, 0xc0, 0x71, 0x43, 0xb9, 0x34, 0xad, 0x27, 0xc1, 0x9f, 0xe9, 0x8c,
+	0x21, 0x8a, 0x7a, 0x19, 0xf7, 0x61, 0x1a, 0x17, 0x79, 0xe1, 0x47, 0x22, 0x69, 0x9b, 0x9c, 0x21,
+	0x8a, 0x0c, 0x38, 0x1f, 0xc8, 0x1c, 0xbc, 0x8c, 0xfc, 0x60, 0x2d, 0x0a, 0xa5, 0xdf, 0x51, 0xef,
+	0x31, 0x7c, 0xd0, 0xd6, 0xd8, 0x8a, 0x69, 0x83, 0x83, 0x1d, 0x4c, 0x66, 0x3e, 0xdd, 0x95, 0x1d,
+	0x7f, 0xe5, 0xaf, 0x36, 0x7a, 0xf3, 0x00, 0x0e, 0x55, 0xd9, 0x81, 0xe8, 0x9b, 0x3c, 0x50, 0xd1,
+	0xcd, 0x6c, 0x7f, 0x2e, 0xb3, 0x99, 0xe5, 0xd2, 0x80, 0x9c, 0x93, 0x4d, 0x98, 0xf8, 0x31, 0x92,
+	0x2d, 0xeb, 0x21, 0x1c, 0x69, 0x40, 0x06, 0xdc, 0x9f, 0xa9, 0xdc, 0x9b, 0x5c, 0xd3, 0xf0, 0xcd,
+	0x7c, 0x7b, 0x06, 0x47, 0x6a, 0x82, 0x52, 0x94, 0x46, 0xd7, 0x9b, 0xfc, 0x4b, 0xfd, 0x2c, 0x13,
+	0xd5, 0xea, 0x7d, 0x05, 0xa7, 0x2d, 0x75, 0x03, 0xd6, 0x6f, 0xe0, 0x50, 0x55, 0xeb, 0x19, 0x1c,
+	0x52, 0x3b, 0x28, 0x68, 0x96, 0x50, 0x11, 0x54, 0x2f, 0x82, 0x93, 0xa6, 0xb6, 0xc1, 0x28, 0xe1,
+	0x63, 0x6f, 0x5c, 0x76, 0xab, 0x3c, 0xc9, 0xfd, 0xc8, 0xa8, 0x4d, 0xbf, 0x86, 0xa3, 0xba, 0x97,
+	0xc9, 0x69, 0xd9, 0x88, 0x50, 0x73, 0x93, 0x13, 0xad, 0x70, 0xac, 0x45, 0xcf, 0xd6, 0x9a, 0xb4,
+	0x68, 0x67, 0x0b, 0x38, 0x6d, 0x41, 0x77, 0xb4, 0xcb, 0x0d, 0xf0, 0xdc, 0x76, 0xbb, 0x11, 0x67,
+	0xde, 0xb1, 0xbd, 0xdf, 0xe0, 0x50, 0x23, 0xf0, 0xa3, 0xe8, 0x96, 0x4c, 0xff, 0x1d, 0x4e, 0x9a,
+	0xc8, 0xb7, 0x6a, 0xf9, 0xf7, 0x65, 0x95, 0xd2, 0xa4, 0x48, 0x17, 0x01, 0x45, 0x7e, 0x3b, 0x43,
+	0x56, 0x6a, 0x52, 0xf2, 0x32, 0xab, 0xf6, 0xa0, 0x10, 0x65, 0x81, 0x18, 0x20, 0xde, 0x97, 0x70,
+	0xd2, 0x44, 0x32, 0xc8, 0xd0, 0x0b, 0x98, 0x2b, 0x5a, 0x21, 0xce, 0x62, 0x9c, 0x65, 0x37, 0x5b,
+	0x50, 0xf5, 0x45, 0x4d, 0xde, 0xa8, 0xbe, 0x0f, 0x14, 0xbd, 0x3f, 0x13, 0x4c, 0x7a, 0x48, 0xca,
+	0x69, 0x52, 0x0b, 0x0f, 0x66, 0x78, 0x53, 0xe0, 0xdc, 0x98, 0x81, 0x09, 0x1b, 0x30, 0x3c, 0x81,
+	0x23, 0x45, 0x09, 0x93, 0x2b, 0x9c, 0xa3, 0x9e, 0xc3, 0x02, 0xb0, 0xf2, 0x44, 0x24, 0x41, 0xd5,
+	0x3f, 0x54, 0x55, 0x03, 0xc6, 0x7f, 0x47, 0x9a, 0x53, 0x7c, 0xc9, 0xb9, 0x99, 0x70, 0xeb, 0x15,
+	0xa7, 0xca, 0x58, 0xb1, 0xe4, 0x1c, 0xc0, 0x0e, 0x45, 0x57, 0xc9, 0x1a, 0x89, 0x35, 0x87, 0xef,
+	0x7e, 0x7e, 0xee, 0xee, 0xf1, 0x36, 0xf1, 0x1d, 0x1c, 0x37, 0x2c, 0xda, 0xec, 0x87, 0x5a, 0x12,
+	0xac, 0xa8, 0xf4, 0xa3, 0xe2, 0xeb, 0xb7, 0xe9, 0x51, 0x31, 0xe1, 0xc1, 0x69, 0x5d, 0x0e, 0x63,
+	0xd3, 0xb4, 0x36, 0x1f, 0xc8, 0x6d, 0x1e, 0x36, 0xbb, 0x86, 0xf0, 0x98, 0x8d, 0xaf, 0x7b, 0x5a,
+	0xea, 0x5d, 0x46, 0x1b, 0xdc, 0xd1, 0xd3, 0x4d, 0x88, 0x6f, 0xc3, 0xd2, 0xef, 0x4c, 0x8b, 0xc5,
+	0xcc, 0x17, 0x3d, 0x66, 0xf1, 0x8a, 0x0e, 0x3a, 0x1b, 0x29, 0xbf, 0x15, 0xcf, 0x90, 0xb3, 0x91,
+	0xf2, 0x06, 0x3c, 0xdf, 0x6a, 0x09, 0x5a, 0x64, 0xb4, 0x9a, 0xf3, 0x2b, 0xb3, 0x39, 0x9f, 0xc0,
+	0x87, 0x1d, 0x00, 0xe5, 0xa8, 0x5f, 0xdd, 0xfe, 0xa8, 0x7f, 0x01, 0xa7, 0xad, 0xfe, 0x5a, 0x84,
+	0x7d, 0x0d, 0x53, 0x6d, 0x66, 0xd5, 0x6a, 0xc4, 0x27, 0x9a, 0xf7, 0x04, 0x3e, 0xea, 0x04, 0x33,
+	0x88, 0xdc, 0x0f, 0x5a, 0xbe, 0xc9, 0xb9, 0xdd, 0xdb, 0xdf, 0x1a, 0x83, 0x55, 0xf6, 0x37, 0x16,
+	0xc4, 0x9f, 0xe0, 0xb4, 0x85, 0xd5, 0x0e, 0x61, 0x05, 0x61, 0xf0, 0x49, 0xe6, 0x3d, 0xd7, 0xda,
+	0x54, 0xfb, 0xde, 0xa3, 0x32, 0x6e, 0xa4, 0x5e, 0x82, 0x8c, 0xd5, 0x4b, 0x10, 0x7e, 0x1a, 0xde,
+	0x8f, 0x70, 0xdc, 0x00, 0x7a, 0xbf, 0x2b, 0x81, 0xfb, 0xed, 0xf9, 0xd8, 0xfa, 0x4c, 0xd6, 0x52,
+	0xfb, 0x7e, 0x7b, 0xdc, 0x0d, 0x51, 0xe0, 0x4d, 0xb7, 0x5f, 0xe1, 0x51, 0x67, 0x03, 0x1d, 0xaa,
+	0xc3, 0x0a, 0xae, 0x5f, 0xe7, 0x61, 0x57, 0x67, 0x1b, 0xa8, 0xb2, 0x99, 0xe5, 0x51, 0x67, 0xcb,
+	0x19, 0xaa, 0x33, 0x94, 0xa7, 0xdc, 0xa6, 0xfa, 0x75, 0xbe, 0x96, 0x59, 0x49, 0x93, 0x24, 0xee,
+	0x5a, 0x18, 0xcb, 0x1d, 0xd1, 0xd2, 0x76, 0x44, 0x71, 0x35, 0xf0, 0x0a, 0x8e, 0x1b, 0xba, 0x9d,
+	0x37, 0xa8, 0xd4, 0xb0, 0x40, 0xca, 0x79, 0xc1, 0xe1, 0x6e, 0xda, 0x1d, 0x69, 0x6b, 0x5e, 0xa8,
+	0xe2, 0x46, 0x1f, 0xbd, 0xfb, 0xb5, 0x5a, 0xe7, 0xe6, 0x58, 0x53, 0xbc, 0x86, 0xb9, 0x2e, 0xbb,
+	0xc1, 0x3f, 0x19, 0xd9, 0xb1, 0x76, 0x57, 0xda, 0xbd, 0xa9, 0x6b, 0x66, 0x74, 0xae, 0x97, 0xb5,
+	0x19, 0x2f, 0x61, 0xae, 0xcb, 0xbe, 0x57, 0x98, 0x35, 0xe6, 0x35, 0xee, 0x43, 0xd2, 0x99, 0xab,
+	0x65, 0x69, 0x5b, 0xe6, 0x54, 0x65, 0xee, 0xdc, 0x3e, 0x6f, 0x08, 0x65, 0xb5, 0x8a, 0xda, 0xda,
+	0x2a, 0x3a, 0xd1, 0x56, 0xd1, 0xa9, 0xb6, 0x8a, 0xb2, 0xdd, 0x73, 0xa6, 0x1f, 0x60, 0xb5, 0x5d,
+	0xde, 0xca, 0x01, 0x22, 0x98, 0xd5, 0xd0, 0x97, 0x41, 0x89, 0xd3, 0x39, 0x18, 0x7a, 0x57, 0x69,
+	0x06, 0xfc, 0x2e, 0xc5, 0x54, 0xf8, 0xe3, 0x28, 0xcb, 0xb4, 0x75, 0x3e, 0xf3, 0x5e, 0xc2, 0xa1,
+	0x4a, 0x53, 0xda, 0x4f, 0xb7, 0x9b, 0x41, 0x5a, 0x89, 0xb1, 0xd5, 0x80, 0x14, 0xb1, 0x0e, 0xa7,
+	0xae, 0x16, 0xde, 0x53, 0x35, 0x7c, 0x51, 0x46, 0x16, 0x59, 0xee, 0xe7, 0x6d, 0x79, 0x49, 0xee,
+	0xd4, 0x77, 0xf5, 0x4c, 0xf9, 0xa2, 0x55, 0x3c, 0x5d, 0x9d, 0xa8, 0xce, 0xb5, 0x8b, 0x56, 0x96,
+	0x0f, 0x90, 0xbf, 0x71, 0xa6, 0xd4, 0xf2, 0xff, 0x8d, 0x64, 0xfa, 0x45, 0x19, 0x09, 0x17, 0x98,
+	0x2c, 0x93, 0xea, 0x4e, 0xba, 0xfa, 0x23, 0xa4, 0x76, 0x65, 0x06, 0x76, 0x92, 0x56, 0xa9, 0xb0,
+	0x0f, 0x53, 0xe2, 0xe7, 0xec, 0xff, 0x1d, 0x1e, 0x47, 0x7e, 0x7d, 0x9d, 0xd6, 0x1f, 0x3f, 0x69,
+	0x42, 0x45, 0xfe, 0x39, 0xf3, 0x63, 0xb8, 0x13, 0x24, 0x84, 0xa0, 0x80, 0x49, 0x67, 0xf2, 0xef,
+	0x9e, 0x19, 0xd8, 0x38, 0xbd, 0x7a, 0xcc, 0xbf, 0x7f, 0xf6, 0x18, 0x18, 0x8b, 0x63, 0x91, 0xf1,
+	0x6f, 0x20, 0xc7, 0xfb, 0x45, 0xda, 0xb5, 0xa4, 0x6f, 0xa5, 0x5d, 0xd2, 0x92, 0x51, 0x75, 0x55,
+	0x9e, 0xca, 0xfe, 0x7b, 0x02, 0xb3, 0x65, 0x42, 0xdf, 0xfa, 0x34, 0x5c, 0x70, 0x4e, 0x61, 0xdd,
+	0x09, 0xcc, 0x2e, 0xfd, 0x60, 0x8d, 0x88, 0xfc, 0x95, 0x27, 0xec, 0xff, 0x03, 0x00, 0x9e, 0x74,
+	0xeb, 0x9e, 0x51, 0x1b, 0x00, 0x00,
 }